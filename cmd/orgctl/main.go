@@ -0,0 +1,147 @@
+// Command orgctl imports teams and their members from CSV or YAML files and
+// exports them in the same formats, against DATABASE_URL.
+//
+//	orgctl import -dry-run org.csv
+//	orgctl import org.yaml
+//	orgctl export -format yaml -output org.yaml
+//
+// The format of an import follows the file's extension unless -format is
+// given. The whole file is checked first and applied in one transaction.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"avito-pr-reviewer/internal/config"
+	"avito-pr-reviewer/internal/model"
+	"avito-pr-reviewer/internal/orgfile"
+	"avito-pr-reviewer/internal/service"
+	"avito-pr-reviewer/internal/store"
+)
+
+const usage = `usage:
+  orgctl import [-format csv|yaml] [-dry-run] FILE
+  orgctl export -format csv|yaml [-output FILE]
+`
+
+func main() {
+	log.SetFlags(0)
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	var err error
+	switch os.Args[1] {
+	case "import":
+		err = runImport(os.Args[2:])
+	case "export":
+		err = runExport(os.Args[2:])
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	var errs orgfile.Errors
+	if errors.As(err, &errs) {
+		for _, e := range errs {
+			fmt.Fprintln(os.Stderr, e)
+		}
+		os.Exit(1)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func runImport(args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	format := fs.String("format", "", "file format: csv or yaml; by default from the extension")
+	dryRun := fs.Bool("dry-run", false, "only print the changes")
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		return errors.New("import needs one file")
+	}
+	path := fs.Arg(0)
+	f := orgfile.Format(*format)
+	if f == "" {
+		f = formatOf(path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	// Whoever can reach the database is trusted to give roles.
+	ctx := service.Trusted(context.Background())
+	db, err := store.NewPostgresStore(ctx, config.Load().DBURL)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	res, err := service.New(db).ImportOrg(ctx, "", f, data, *dryRun)
+	if err != nil {
+		return err
+	}
+	return printChanges(os.Stdout, res)
+}
+
+func runExport(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	format := fs.String("format", string(orgfile.CSV), "file format: csv or yaml")
+	output := fs.String("output", "", "write to this file instead of stdout")
+	fs.Parse(args)
+
+	ctx := context.Background()
+	db, err := store.NewPostgresStore(ctx, config.Load().DBURL)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	data, err := service.New(db).ExportOrg(ctx, orgfile.Format(*format))
+	if err != nil {
+		return err
+	}
+	if *output == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(*output, data, 0o644)
+}
+
+func formatOf(path string) orgfile.Format {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return orgfile.YAML
+	default:
+		return orgfile.CSV
+	}
+}
+
+func printChanges(out io.Writer, res *model.OrgImport) error {
+	if len(res.Changes) == 0 {
+		_, err := fmt.Fprintln(out, "no changes")
+		return err
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "action\tteam\tuser\tfrom\tto")
+	for _, c := range res.Changes {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", c.Action, c.TeamName, c.UserID, c.From, c.To)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if res.DryRun {
+		_, err := fmt.Fprintf(out, "\n%d changes, none applied (dry run)\n", len(res.Changes))
+		return err
+	}
+	_, err := fmt.Fprintf(out, "\n%d changes applied\n", len(res.Changes))
+	return err
+}
@@ -2,40 +2,115 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"avito-pr-reviewer/internal/businesstime"
 	"avito-pr-reviewer/internal/config"
+	"avito-pr-reviewer/internal/events"
+	"avito-pr-reviewer/internal/grpcserver"
 	"avito-pr-reviewer/internal/handler"
+	"avito-pr-reviewer/internal/middleware"
+	"avito-pr-reviewer/internal/model"
+	"avito-pr-reviewer/internal/notifier"
+	"avito-pr-reviewer/internal/ratelimit"
 	"avito-pr-reviewer/internal/service"
 	"avito-pr-reviewer/internal/store"
 
 	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/chi/v5/middleware"
+	chimw "github.com/go-chi/chi/v5/middleware"
 	"github.com/rs/cors"
 )
 
 func main() {
 	cfg := config.Load()
 
-	ctx := context.Background()
+	ctx, stop := context.WithCancel(context.Background())
+	defer stop()
+
 	store, err := store.NewPostgresStore(ctx, cfg.DBURL)
 	if err != nil {
 		log.Fatal(err)
 	}
 	defer store.Close()
 
-	svc := service.New(store)
-	h := handler.New(svc)
+	limiter, rules, err := newRateLimiter(cfg, store)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	validator, err := middleware.NewOpenAPIValidator(ctx, cfg.OpenAPISpecPath, cfg.OpenAPIValidateResponses)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	bus := events.NewBus()
+	go bus.Run(ctx, store)
+	go events.Purge(ctx, store, cfg.EventRetention, time.Hour)
+
+	calc, schedule, err := newBusinessTime(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	strategy := model.AssignmentStrategy(cfg.AssignmentStrategy)
+	if !strategy.Valid() {
+		log.Fatalf("unknown assignment strategy %q", cfg.AssignmentStrategy)
+	}
+	opts := []service.Option{
+		service.WithBusinessTime(calc, schedule),
+		service.WithStrategy(strategy),
+		service.WithPairingWindow(cfg.PairingWindow),
+	}
+	if cfg.PreferWorkingHours {
+		opts = append(opts, service.WithWorkingHoursPreference())
+	}
+	if cfg.SlackToken != "" {
+		slack, err := notifier.NewSlack(notifier.SlackConfig{
+			BaseURL:    cfg.SlackBaseURL,
+			Token:      cfg.SlackToken,
+			MaxRetries: cfg.SlackMaxRetries,
+			Backoff:    cfg.SlackBackoff,
+		}, store)
+		if err != nil {
+			log.Fatal(err)
+		}
+		opts = append(opts, service.WithNotifier(slack))
+	}
+	if cfg.SMTPHost != "" {
+		mailer, err := notifier.NewSMTP(notifier.SMTPConfig{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+			From:     cfg.SMTPFrom,
+		}, store)
+		if err != nil {
+			log.Fatal(err)
+		}
+		opts = append(opts, service.WithNotifier(mailer))
+		go notifier.NewDigest(store, mailer, calc, schedule, cfg.DigestAt).Run(ctx)
+	}
+
+	svc := service.New(store, opts...)
+	go svc.MonitorSLA(ctx, cfg.SLACheckInterval)
+	go svc.MonitorStale(ctx, cfg.StaleCheckInterval)
+	h := handler.New(svc, bus, cfg.SSEHeartbeat, cfg.AdminToken)
 
 	r := chi.NewRouter()
-	r.Use(middleware.Logger)
-	r.Use(middleware.Recoverer)
+	r.Use(chimw.Logger)
+	r.Use(chimw.Recoverer)
 	r.Use(cors.Default().Handler)
+	r.Use(middleware.RateLimit(limiter, rules))
+	r.Use(middleware.Idempotency(store, cfg.IdempotencyTTL))
+	r.Use(validator.Handler)
+
+	go middleware.PurgeIdempotencyKeys(ctx, store, time.Hour)
 
 	r.Mount("/", h.Routes())
 
@@ -44,6 +119,8 @@ func main() {
 		Handler:      r,
 		ReadTimeout:  5 * time.Second,
 		WriteTimeout: 10 * time.Second,
+		// Request contexts end on shutdown so that event streams let go.
+		BaseContext: func(net.Listener) context.Context { return ctx },
 	}
 
 	go func() {
@@ -54,18 +131,74 @@ func main() {
 
 	log.Printf("Server started on port %s", cfg.ServerPort)
 
+	grpcSrv := grpcserver.NewGRPCServer(svc, cfg.AdminToken)
+	lis, err := net.Listen("tcp", ":"+cfg.GRPCPort)
+	if err != nil {
+		log.Fatalf("grpc listen: %s\n", err)
+	}
+	go func() {
+		if err := grpcSrv.Serve(lis); err != nil {
+			log.Fatalf("grpc serve: %s\n", err)
+		}
+	}()
+
+	log.Printf("gRPC server started on port %s", cfg.GRPCPort)
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
 	log.Println("Shutting down server...")
+	stop()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	grpcSrv.GracefulStop()
+
 	if err := srv.Shutdown(ctx); err != nil {
 		log.Fatal("Server forced to shutdown:", err)
 	}
 
 	log.Println("Server exited")
 }
+
+func newBusinessTime(cfg *config.Config) (*businesstime.Calculator, businesstime.Schedule, error) {
+	schedule, err := businesstime.Parse(model.WorkingHours{
+		Timezone: cfg.WorkTimezone,
+		Start:    cfg.WorkStart,
+		End:      cfg.WorkEnd,
+		Days:     businesstime.ParseDays(cfg.WorkDays),
+	})
+	if err != nil {
+		return nil, schedule, err
+	}
+	cal := businesstime.NewCalendar()
+	if cfg.HolidaysICal != "" {
+		if cal, err = businesstime.LoadICal(cfg.HolidaysICal); err != nil {
+			return nil, schedule, err
+		}
+		log.Printf("Loaded %d holidays from %s", cal.Len(), cfg.HolidaysICal)
+	}
+	return businesstime.NewCalculator(cal), schedule, nil
+}
+
+func newRateLimiter(cfg *config.Config, st *store.PostgresStore) (ratelimit.Limiter, ratelimit.Rules, error) {
+	routes, err := ratelimit.ParseRoutes(cfg.RateLimitRoutes)
+	if err != nil {
+		return nil, ratelimit.Rules{}, err
+	}
+	rules := ratelimit.Rules{
+		Default: ratelimit.Rule{Rate: cfg.RateLimitRPS, Burst: cfg.RateLimitBurst},
+		Routes:  routes,
+	}
+
+	switch cfg.RateLimitBackend {
+	case "memory":
+		return ratelimit.NewMemoryLimiter(), rules, nil
+	case "postgres":
+		return ratelimit.NewPostgresLimiter(st), rules, nil
+	default:
+		return nil, rules, fmt.Errorf("unknown rate limit backend %q", cfg.RateLimitBackend)
+	}
+}
@@ -0,0 +1,136 @@
+// Command simulate replays past pull requests through an assignment strategy
+// in memory and compares the resulting review load with what was recorded.
+// Nothing is written to the database.
+//
+//	simulate -strategy least_loaded -team backend
+//	simulate -export history.json
+//	simulate -input history.json -strategy skill -reviewers 1
+//
+// History is read from DATABASE_URL unless -input names a JSON export.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"avito-pr-reviewer/internal/config"
+	"avito-pr-reviewer/internal/model"
+	"avito-pr-reviewer/internal/simulate"
+	"avito-pr-reviewer/internal/store"
+)
+
+func main() {
+	strategy := flag.String("strategy", string(model.StrategyLeastLoaded), "assignment strategy to simulate: random, skill, least_loaded or knowledge_spread")
+	reviewers := flag.Int("reviewers", model.DefaultReviewerCount, "reviewers per pull request")
+	team := flag.String("team", "", "only replay pull requests by this team's members")
+	since := flag.Duration("since", 90*24*time.Hour, "replay pull requests created within this long")
+	pairingWindow := flag.Duration("pairing-window", 30*24*time.Hour, "how long a review of the same author counts under knowledge_spread")
+	seed := flag.Int64("seed", 1, "seed for random choices")
+	input := flag.String("input", "", "read history from this JSON export instead of the database")
+	export := flag.String("export", "", "write the history to this JSON file and exit")
+	asJSON := flag.Bool("json", false, "print the reports as JSON")
+	flag.Parse()
+
+	s := model.AssignmentStrategy(*strategy)
+	if !s.Valid() {
+		log.Fatalf("unknown assignment strategy %q", *strategy)
+	}
+	if *reviewers < 0 {
+		log.Fatal("-reviewers must not be negative")
+	}
+
+	h, err := loadHistory(*input, time.Now().Add(-*since))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *export != "" {
+		if err := writeJSON(*export, h); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	recorded := simulate.Recorded(h, *team)
+	simulated := simulate.Run(h, simulate.Options{
+		Strategy:      s,
+		Reviewers:     *reviewers,
+		Team:          *team,
+		PairingWindow: *pairingWindow,
+		Rand:          rand.New(rand.NewSource(*seed)),
+	})
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(map[string]*simulate.Report{"recorded": recorded, "simulated": simulated})
+	} else {
+		err = printReports(os.Stdout, recorded, simulated)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func loadHistory(path string, since time.Time) (*model.History, error) {
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var h model.History
+		if err := json.Unmarshal(data, &h); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		return &h, nil
+	}
+
+	ctx := context.Background()
+	db, err := store.NewPostgresStore(ctx, config.Load().DBURL)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	return db.GetHistory(ctx, since)
+}
+
+func writeJSON(path string, h *model.History) error {
+	data, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+func printReports(out io.Writer, recorded, simulated *simulate.Report) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "\trecorded\t%s\n", simulated.Strategy)
+	fmt.Fprintf(w, "pull requests\t%d\t%d\n", recorded.PullRequests, simulated.PullRequests)
+	fmt.Fprintf(w, "understaffed\t-\t%d\n", simulated.Understaffed)
+	fmt.Fprintf(w, "reassignments\t%d\t%d\n", recorded.Reassignments, simulated.Reassignments)
+	fmt.Fprintf(w, "max concurrent reviews\t%d\t%d\n", recorded.MaxConcurrent, simulated.MaxConcurrent)
+	fmt.Fprintf(w, "gini\t%.3f\t%.3f\n", recorded.Gini, simulated.Gini)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	before := make(map[string]simulate.ReviewerStats, len(recorded.Reviewers))
+	for _, r := range recorded.Reviewers {
+		before[r.UserID] = r
+	}
+	fmt.Fprintln(out)
+	w = tabwriter.NewWriter(out, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "reviewer\tteam\tassigned\t(was)\tmax open\t(was)\treassigned\t(was)\t")
+	for _, r := range simulated.Reviewers {
+		b := before[r.UserID]
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\t%d\t%d\t\n",
+			r.UserID, r.TeamName, r.Assigned, b.Assigned, r.MaxConcurrent, b.MaxConcurrent, r.Reassigned, b.Reassigned)
+	}
+	return w.Flush()
+}
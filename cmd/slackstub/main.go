@@ -0,0 +1,30 @@
+// Command slackstub runs a fake Slack Web API that logs delivered messages.
+// Point SLACK_BASE_URL at it to try notifications locally.
+package main
+
+import (
+	"log"
+	"net/http"
+	"os"
+
+	"avito-pr-reviewer/internal/notifier/slacktest"
+)
+
+func main() {
+	addr := os.Getenv("SLACK_STUB_ADDR")
+	if addr == "" {
+		addr = ":8090"
+	}
+	token := os.Getenv("SLACK_TOKEN")
+	if token == "" {
+		token = "xoxb-local"
+	}
+
+	fake := slacktest.NewFake(token)
+	fake.OnMessage = func(m slacktest.Message) {
+		log.Printf("-> %s: %s", m.Channel, m.Text)
+	}
+
+	log.Printf("Slack stub listening on %s", addr)
+	log.Fatal(http.ListenAndServe(addr, fake))
+}
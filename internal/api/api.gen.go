@@ -0,0 +1,8752 @@
+// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Defines values for AssignmentStrategy.
+const (
+	AssignmentStrategyKnowledgeSpread AssignmentStrategy = "knowledge_spread"
+	AssignmentStrategyLeastLoaded     AssignmentStrategy = "least_loaded"
+	AssignmentStrategyRandom          AssignmentStrategy = "random"
+	AssignmentStrategySkill           AssignmentStrategy = "skill"
+)
+
+// Defines values for ErrorCode.
+const (
+	ErrorCodeBADREQUEST             ErrorCode = "BAD_REQUEST"
+	ErrorCodeCOMPOSITIONUNSATISFIED ErrorCode = "COMPOSITION_UNSATISFIED"
+	ErrorCodeFORBIDDEN              ErrorCode = "FORBIDDEN"
+	ErrorCodeIDEMPOTENCYINPROGRESS  ErrorCode = "IDEMPOTENCY_IN_PROGRESS"
+	ErrorCodeIDEMPOTENCYKEYREUSED   ErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	ErrorCodeINTERNALERROR          ErrorCode = "INTERNAL_ERROR"
+	ErrorCodeINVALIDRESPONSE        ErrorCode = "INVALID_RESPONSE"
+	ErrorCodeMEMBERHASOPENREVIEWS   ErrorCode = "MEMBER_HAS_OPEN_REVIEWS"
+	ErrorCodeNOCANDIDATE            ErrorCode = "NO_CANDIDATE"
+	ErrorCodeNOOWNER                ErrorCode = "NO_OWNER"
+	ErrorCodeNOTASSIGNED            ErrorCode = "NOT_ASSIGNED"
+	ErrorCodeNOTFOUND               ErrorCode = "NOT_FOUND"
+	ErrorCodePREXISTS               ErrorCode = "PR_EXISTS"
+	ErrorCodePRMERGED               ErrorCode = "PR_MERGED"
+	ErrorCodeRATELIMITED            ErrorCode = "RATE_LIMITED"
+	ErrorCodeREPOEXISTS             ErrorCode = "REPO_EXISTS"
+	ErrorCodeREQUESTTOOLARGE        ErrorCode = "REQUEST_TOO_LARGE"
+	ErrorCodeTEAMCYCLE              ErrorCode = "TEAM_CYCLE"
+	ErrorCodeTEAMEXISTS             ErrorCode = "TEAM_EXISTS"
+	ErrorCodeTEAMHASOPENPRS         ErrorCode = "TEAM_HAS_OPEN_PRS"
+)
+
+// Defines values for ExplainedCandidateExcluded.
+const (
+	ExplainedCandidateExcludedAuthor           ExplainedCandidateExcluded = "author"
+	ExplainedCandidateExcludedInactive         ExplainedCandidateExcluded = "inactive"
+	ExplainedCandidateExcludedRepositoryPolicy ExplainedCandidateExcluded = "repository_policy"
+	ExplainedCandidateExcludedRule             ExplainedCandidateExcluded = "rule"
+	ExplainedCandidateExcludedTeamLead         ExplainedCandidateExcluded = "team_lead"
+)
+
+// Defines values for LeadAssignment.
+const (
+	LeadAssignmentExcluded   LeadAssignment = "excluded"
+	LeadAssignmentLastResort LeadAssignment = "last_resort"
+	LeadAssignmentNormal     LeadAssignment = "normal"
+)
+
+// Defines values for OpenReviewPolicy.
+const (
+	OpenReviewPolicyReassign OpenReviewPolicy = "reassign"
+	OpenReviewPolicyReject   OpenReviewPolicy = "reject"
+	OpenReviewPolicyUnassign OpenReviewPolicy = "unassign"
+)
+
+// Defines values for OrgChangeAction.
+const (
+	OrgChangeActionAddMember         OrgChangeAction = "add_member"
+	OrgChangeActionCreateTeam        OrgChangeAction = "create_team"
+	OrgChangeActionCreateUser        OrgChangeAction = "create_user"
+	OrgChangeActionRenameUser        OrgChangeAction = "rename_user"
+	OrgChangeActionSetActive         OrgChangeAction = "set_active"
+	OrgChangeActionSetLeadAssignment OrgChangeAction = "set_lead_assignment"
+	OrgChangeActionSetParent         OrgChangeAction = "set_parent"
+	OrgChangeActionSetPrimary        OrgChangeAction = "set_primary"
+	OrgChangeActionSetRole           OrgChangeAction = "set_role"
+	OrgChangeActionSetSeniority      OrgChangeAction = "set_seniority"
+)
+
+// Defines values for OrgFormat.
+const (
+	OrgFormatCsv  OrgFormat = "csv"
+	OrgFormatYaml OrgFormat = "yaml"
+)
+
+// Defines values for PullRequestStatus.
+const (
+	PullRequestStatusMERGED PullRequestStatus = "MERGED"
+	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
+)
+
+// Defines values for ReassignReason.
+const (
+	ReassignReasonManual     ReassignReason = "manual"
+	ReassignReasonSla        ReassignReason = "sla"
+	ReassignReasonStale      ReassignReason = "stale"
+	ReassignReasonTeamChange ReassignReason = "team_change"
+)
+
+// Defines values for Seniority.
+const (
+	SeniorityJunior Seniority = "junior"
+	SeniorityMiddle Seniority = "middle"
+	SenioritySenior Seniority = "senior"
+)
+
+// Defines values for StaleOutcome.
+const (
+	StaleOutcomeCapReached    StaleOutcome = "cap_reached"
+	StaleOutcomeNoCandidate   StaleOutcome = "no_candidate"
+	StaleOutcomeReassigned    StaleOutcome = "reassigned"
+	StaleOutcomeWouldReassign StaleOutcome = "would_reassign"
+)
+
+// Defines values for TeamRole.
+const (
+	TeamRoleLead       TeamRole = "lead"
+	TeamRoleMaintainer TeamRole = "maintainer"
+	TeamRoleMember     TeamRole = "member"
+)
+
+// Defines values for DeleteTeamParamsOpenPrs.
+const (
+	DeleteTeamParamsOpenPrsReject   DeleteTeamParamsOpenPrs = "reject"
+	DeleteTeamParamsOpenPrsUnassign DeleteTeamParamsOpenPrs = "unassign"
+)
+
+// AssignmentExplanation defines model for AssignmentExplanation.
+type AssignmentExplanation struct {
+	// Candidates Пул кандидатов — сначала подходящие в порядке ранжирования, затем исключённые
+	Candidates    []ExplainedCandidate `json:"candidates"`
+	ReviewerCount int                  `json:"reviewer_count"`
+
+	// Seed Зерно случайного выбора
+	Seed int64 `json:"seed"`
+
+	// Strategy random — случайный выбор; skill — совпадение тегов с метками PR с учётом загрузки; least_loaded — наименьшее число открытых ревью; knowledge_spread — реже назначать тех, кто недавно ревьюил того же автора (окно PAIRING_WINDOW)
+	Strategy AssignmentStrategy `json:"strategy"`
+}
+
+// AssignmentStrategy random — случайный выбор; skill — совпадение тегов с метками PR с учётом загрузки; least_loaded — наименьшее число открытых ревью; knowledge_spread — реже назначать тех, кто недавно ревьюил того же автора (окно PAIRING_WINDOW)
+type AssignmentStrategy string
+
+// Codeowners defines model for Codeowners.
+type Codeowners struct {
+	// Content Содержимое CODEOWNERS (шаблоны как в .gitignore, побеждает последнее совпадение). Владелец @user_id ищется среди всех пользователей, а @username и email — только среди участников команды PR и команд-владельцев.
+	Content string `json:"content"`
+
+	// RepositoryId Пустая строка — файл по умолчанию для всех PR команды
+	RepositoryId string     `json:"repository_id"`
+	TeamName     string     `json:"team_name"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	Code ErrorCode `json:"code"`
+
+	// Details Поля, не прошедшие валидацию
+	Details []FieldViolation `json:"details,omitempty"`
+	Message string           `json:"message"`
+}
+
+// ErrorCode defines model for ErrorCode.
+type ErrorCode string
+
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	Error Error `json:"error"`
+}
+
+// ExplainedCandidate defines model for ExplainedCandidate.
+type ExplainedCandidate struct {
+	// Excluded Почему кандидат исключён; отсутствует у подходящих. Других причин нет: лимита открытых ревью и отпусков в сервисе нет, у нового PR ещё нет назначенных ревьюверов, а нерабочие часы не исключают кандидата, только понижают его (working_now). Пул описан до назначения ревьюверов.
+	Excluded *ExplainedCandidateExcluded `json:"excluded,omitempty"`
+
+	// OpenReviews Открытые PR на ревью у кандидата
+	OpenReviews int `json:"open_reviews"`
+
+	// Owner Владелец изменённых файлов по CODEOWNERS
+	Owner bool `json:"owner"`
+
+	// Rank Место среди подходящих кандидатов команды после ранжирования, с 1
+	Rank *int `json:"rank,omitempty"`
+
+	// Required Выбран по правилу require
+	Required bool `json:"required"`
+
+	// Rule Исключившее кандидата правило команды
+	Rule *string `json:"rule,omitempty"`
+
+	// Score Оценка стратегии (skill, least_loaded, knowledge_spread); больше — лучше
+	Score *int `json:"score,omitempty"`
+
+	// Selected Назначен ревьювером
+	Selected bool `json:"selected"`
+
+	// Seniority Уровень пользователя. PR джуниора получает хотя бы одного senior-ревьювера,
+	// а двое и более джуниоров не могут быть единственными ревьюверами.
+	Seniority *Seniority `json:"seniority,omitempty"`
+	TeamName  string     `json:"team_name"`
+	UserId    string     `json:"user_id"`
+
+	// WorkingNow В рабочих ли часах кандидат (если они учитываются)
+	WorkingNow *bool `json:"working_now,omitempty"`
+}
+
+// ExplainedCandidateExcluded Почему кандидат исключён; отсутствует у подходящих. Других причин нет: лимита открытых ревью и отпусков в сервисе нет, у нового PR ещё нет назначенных ревьюверов, а нерабочие часы не исключают кандидата, только понижают его (working_now). Пул описан до назначения ревьюверов.
+type ExplainedCandidateExcluded string
+
+// FieldViolation defines model for FieldViolation.
+type FieldViolation struct {
+	Field string `json:"field,omitempty"`
+
+	// Line Строка файла импорта
+	Line    int    `json:"line,omitempty"`
+	Message string `json:"message"`
+}
+
+// LeadAssignment Как лиды команды участвуют в автоматическом назначении: normal — наравне со всеми, last_resort — только если больше некого, excluded — никогда. По умолчанию normal.
+type LeadAssignment string
+
+// OpenReviewPolicy Что делать с открытыми ревью, которые участник держит в покидаемой команде: reject — отказать (409), reassign — переназначить на других участников команды, unassign — снять ревьювера без замены.
+type OpenReviewPolicy string
+
+// OrgChange defines model for OrgChange.
+type OrgChange struct {
+	Action OrgChangeAction `json:"action"`
+
+	// From Прежнее значение, если есть
+	From     string `json:"from,omitempty"`
+	TeamName string `json:"team_name,omitempty"`
+
+	// To Новое значение, если есть
+	To     string `json:"to,omitempty"`
+	UserId string `json:"user_id,omitempty"`
+}
+
+// OrgChangeAction defines model for OrgChange.Action.
+type OrgChangeAction string
+
+// OrgFormat Формат файла команд. В CSV каждая строка — участник команды с колонками
+// team_name, parent_team_name, lead_assignment, user_id, username, is_active,
+// seniority, role, is_primary (обязательны team_name, user_id и username);
+// строка без user_id описывает только команду. В YAML — список teams,
+// у каждой команды свой список members с теми же полями.
+type OrgFormat string
+
+// PullRequest defines model for PullRequest.
+type PullRequest struct {
+	// AssignedReviewers user_id назначенных ревьюверов (по умолчанию 0..2)
+	AssignedReviewers []string `json:"assigned_reviewers"`
+
+	// AssignmentSeed Зерно, с которым выбирались ревьюверы; передайте его в seed запроса /rules/evaluate, чтобы повторить выбор
+	AssignmentSeed *int64 `json:"assignment_seed,omitempty"`
+	AuthorId       string `json:"author_id"`
+
+	// CreatedAt Время создания PR; по нему определялось, кто из кандидатов в рабочих часах. Передайте его вместе с assignment_seed в /rules/evaluate
+	CreatedAt       *time.Time        `json:"created_at"`
+	Labels          *[]string         `json:"labels,omitempty"`
+	MergedAt        *time.Time        `json:"merged_at"`
+	PullRequestId   string            `json:"pull_request_id"`
+	PullRequestName string            `json:"pull_request_name"`
+	RepositoryId    *string           `json:"repository_id,omitempty"`
+	Status          PullRequestStatus `json:"status"`
+
+	// TeamName Команда, из которой выбираются ревьюверы
+	TeamName *string `json:"team_name,omitempty"`
+}
+
+// PullRequestShort defines model for PullRequestShort.
+type PullRequestShort struct {
+	AuthorId        string            `json:"author_id"`
+	PullRequestId   string            `json:"pull_request_id"`
+	PullRequestName string            `json:"pull_request_name"`
+	Status          PullRequestStatus `json:"status"`
+}
+
+// PullRequestStatus defines model for PullRequestStatus.
+type PullRequestStatus string
+
+// ReassignReason defines model for ReassignReason.
+type ReassignReason string
+
+// Reassignment defines model for Reassignment.
+type Reassignment struct {
+	CreatedAt     time.Time      `json:"created_at"`
+	NewReviewerId string         `json:"new_reviewer_id"`
+	OldReviewerId string         `json:"old_reviewer_id"`
+	Reason        ReassignReason `json:"reason"`
+}
+
+// Repository defines model for Repository.
+type Repository struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// ExcludedUsers Пользователи, которых никогда не назначать на PR репозитория
+	ExcludedUsers []string `json:"excluded_users,omitempty"`
+	Name          string   `json:"name"`
+	RepositoryId  string   `json:"repository_id"`
+
+	// RequireOwner Не создавать PR, если нельзя назначить владельца кода из CODEOWNERS
+	RequireOwner bool `json:"require_owner,omitempty"`
+
+	// ReviewerCount Сколько ревьюверов назначать; по умолчанию 2
+	ReviewerCount *int `json:"reviewer_count,omitempty"`
+
+	// Strategy random — случайный выбор; skill — совпадение тегов с метками PR с учётом загрузки; least_loaded — наименьшее число открытых ревью; knowledge_spread — реже назначать тех, кто недавно ревьюил того же автора (окно PAIRING_WINDOW)
+	Strategy *AssignmentStrategy `json:"strategy,omitempty"`
+
+	// TeamName Команда-владелец
+	TeamName string `json:"team_name"`
+}
+
+// RuleEvaluation defines model for RuleEvaluation.
+type RuleEvaluation struct {
+	// Error Почему ревьюверов подобрать нельзя
+	Error    *string           `json:"error,omitempty"`
+	Excluded []RuleExclusion   `json:"excluded"`
+	Required []RuleRequirement `json:"required"`
+
+	// Reviewers Ревьюверы, которые были бы назначены
+	Reviewers []string     `json:"reviewers"`
+	Rules     []RuleResult `json:"rules"`
+
+	// Seed Зерно случайного выбора
+	Seed     int64  `json:"seed"`
+	TeamName string `json:"team_name"`
+}
+
+// RuleExclusion defines model for RuleExclusion.
+type RuleExclusion struct {
+	Line   int    `json:"line"`
+	Rule   string `json:"rule"`
+	UserId string `json:"user_id"`
+}
+
+// RuleRequirement defines model for RuleRequirement.
+type RuleRequirement struct {
+	Line    int      `json:"line"`
+	Rule    string   `json:"rule"`
+	UserIds []string `json:"user_ids"`
+}
+
+// RuleResult defines model for RuleResult.
+type RuleResult struct {
+	// Applied Выполнены ли условия правила для этого PR
+	Applied bool   `json:"applied"`
+	Line    int    `json:"line"`
+	Rule    string `json:"rule"`
+}
+
+// Seniority Уровень пользователя. PR джуниора получает хотя бы одного senior-ревьювера,
+// а двое и более джуниоров не могут быть единственными ревьюверами.
+type Seniority string
+
+// SlaBreach defines model for SlaBreach.
+type SlaBreach struct {
+	AssignedAt      time.Time `json:"assigned_at"`
+	DueAt           time.Time `json:"due_at"`
+	Escalated       bool      `json:"escalated"`
+	PullRequestId   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	ReviewerId      string    `json:"reviewer_id"`
+	TeamName        string    `json:"team_name"`
+}
+
+// StaleAction defines model for StaleAction.
+type StaleAction struct {
+	Outcome       StaleOutcome `json:"outcome"`
+	PullRequestId string       `json:"pull_request_id"`
+	ReplacedBy    *string      `json:"replaced_by,omitempty"`
+	ReviewerId    string       `json:"reviewer_id"`
+	TeamName      string       `json:"team_name"`
+}
+
+// StaleOutcome defines model for StaleOutcome.
+type StaleOutcome string
+
+// StalePolicy defines model for StalePolicy.
+type StalePolicy struct {
+	// DryRun Только сообщать, кого бы переназначили
+	DryRun bool `json:"dry_run"`
+
+	// InheritedFrom Вышестоящая команда, чьи настройки действуют, если своих у команды нет
+	InheritedFrom *string `json:"inherited_from,omitempty"`
+
+	// MaxReassignments Сколько раз один PR может быть автоматически переназначен
+	MaxReassignments int `json:"max_reassignments"`
+
+	// StaleAfterHours Через сколько рабочих часов без реакции ревьювер считается неактивным
+	StaleAfterHours int    `json:"stale_after_hours"`
+	TeamName        string `json:"team_name"`
+}
+
+// TagCount defines model for TagCount.
+type TagCount struct {
+	Tag string `json:"tag"`
+
+	// Users Сколько пользователей отмечены тегом
+	Users int `json:"users"`
+}
+
+// Team defines model for Team.
+type Team struct {
+	// LeadAssignment Как лиды команды участвуют в автоматическом назначении: normal — наравне со всеми, last_resort — только если больше некого, excluded — никогда. По умолчанию normal.
+	LeadAssignment LeadAssignment `json:"lead_assignment,omitempty"`
+	Members        []TeamMember   `json:"members"`
+
+	// ParentTeamName Вышестоящая команда (отдел). Её SLA, политика неактивных ревьюверов, правила и рабочее время действуют, пока у команды нет своих.
+	ParentTeamName *string `json:"parent_team_name,omitempty"`
+	TeamName       string  `json:"team_name"`
+}
+
+// TeamDeletion defines model for TeamDeletion.
+type TeamDeletion struct {
+	// Members Бывшие участники; у тех, для кого команда была основной, основной становится самая давняя из оставшихся
+	Members  []string `json:"members"`
+	TeamName string   `json:"team_name"`
+
+	// Unassigned PR, с которых сняты ревьюверы из удалённой команды
+	Unassigned []string `json:"unassigned"`
+}
+
+// TeamMember defines model for TeamMember.
+type TeamMember struct {
+	IsActive bool `json:"is_active"`
+
+	// IsPrimary Основная ли это команда участника. При добавлении true делает её основной; без него основной она становится, только если других команд у пользователя нет.
+	IsPrimary bool `json:"is_primary,omitempty"`
+
+	// Role Роль участника в команде; по умолчанию member. Лид переназначает ревьюверов, деактивирует участников, раздаёт роли и получает эскалации по просроченным ревью; мейнтейнер может переназначать ревьюверов. Роль в отделе действует и во всех его подкомандах.
+	Role TeamRole `json:"role,omitempty"`
+
+	// Seniority Уровень пользователя. PR джуниора получает хотя бы одного senior-ревьювера,
+	// а двое и более джуниоров не могут быть единственными ревьюверами.
+	Seniority *Seniority `json:"seniority,omitempty"`
+	UserId    string     `json:"user_id"`
+	Username  string     `json:"username"`
+}
+
+// TeamRole Роль участника в команде; по умолчанию member. Лид переназначает ревьюверов, деактивирует участников, раздаёт роли и получает эскалации по просроченным ревью; мейнтейнер может переназначать ревьюверов. Роль в отделе действует и во всех его подкомандах.
+type TeamRole string
+
+// TeamRules defines model for TeamRules.
+type TeamRules struct {
+	// InheritedFrom Вышестоящая команда, чьи настройки действуют, если своих у команды нет
+	InheritedFrom *string `json:"inherited_from,omitempty"`
+
+	// Rules Правила назначения, по одному в строке; текст после # игнорируется.
+	// Действия: exclude <селектор>, prefer <селектор>, require N from <селектор>.
+	// Селекторы: user=, tag=, team=, seniority=. Необязательные условия:
+	// when label=..., author=..., repository=..., объединённые через and.
+	Rules     string     `json:"rules"`
+	TeamName  string     `json:"team_name"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// TeamSla defines model for TeamSla.
+type TeamSla struct {
+	// AutoReassign Переназначать ревьювера, нарушившего SLA
+	AutoReassign bool `json:"auto_reassign"`
+
+	// FirstResponseHours За сколько рабочих часов ревьювер должен впервые отреагировать на PR
+	FirstResponseHours int `json:"first_response_hours"`
+
+	// InheritedFrom Вышестоящая команда, чьи настройки действуют, если своих у команды нет
+	InheritedFrom *string `json:"inherited_from,omitempty"`
+	TeamName      string  `json:"team_name"`
+}
+
+// TeamTree defines model for TeamTree.
+type TeamTree struct {
+	// Members Участники самой команды
+	Members int `json:"members"`
+
+	// OpenPrs Открытые PR самой команды
+	OpenPrs        int        `json:"open_prs"`
+	ParentTeamName *string    `json:"parent_team_name,omitempty"`
+	SubTeams       []TeamTree `json:"sub_teams"`
+	TeamName       string     `json:"team_name"`
+
+	// TotalMembers Участники команды и всех её подкоманд, каждый один раз
+	TotalMembers int `json:"total_members"`
+
+	// TotalOpenPrs Открытые PR команды и всех её подкоманд
+	TotalOpenPrs int `json:"total_open_prs"`
+}
+
+// TeamWorkingHours defines model for TeamWorkingHours.
+type TeamWorkingHours struct {
+	TeamName string `json:"team_name"`
+
+	// WorkingHours Рабочее время; SLA, неактивность ревьюверов и дайджесты считаются только в нём
+	WorkingHours *WorkingHours `json:"working_hours,omitempty"`
+}
+
+// User defines model for User.
+type User struct {
+	Email       *openapi_types.Email `json:"email,omitempty"`
+	EmailOptOut *bool                `json:"email_opt_out,omitempty"`
+	IsActive    bool                 `json:"is_active"`
+
+	// Seniority Уровень пользователя. PR джуниора получает хотя бы одного senior-ревьювера,
+	// а двое и более джуниоров не могут быть единственными ревьюверами.
+	Seniority *Seniority `json:"seniority,omitempty"`
+
+	// Tags Области экспертизы пользователя
+	Tags *[]string `json:"tags,omitempty"`
+
+	// TeamName Основная команда; пустая, если пользователь не состоит ни в одной
+	TeamName string `json:"team_name"`
+
+	// Teams Все команды пользователя, основная первой
+	Teams    *[]string `json:"teams,omitempty"`
+	UserId   string    `json:"user_id"`
+	Username string    `json:"username"`
+
+	// WorkingHours Рабочее время; SLA, неактивность ревьюверов и дайджесты считаются только в нём
+	WorkingHours *WorkingHours `json:"working_hours,omitempty"`
+}
+
+// UserSeniorityRequest defines model for UserSeniorityRequest.
+type UserSeniorityRequest struct {
+	// Seniority Уровень пользователя. PR джуниора получает хотя бы одного senior-ревьювера,
+	// а двое и более джуниоров не могут быть единственными ревьюверами.
+	Seniority Seniority `json:"seniority"`
+	UserId    string    `json:"user_id"`
+}
+
+// UserTagsRequest defines model for UserTagsRequest.
+type UserTagsRequest struct {
+	Tags   []string `json:"tags"`
+	UserId string   `json:"user_id"`
+}
+
+// WorkingHours Рабочее время; SLA, неактивность ревьюверов и дайджесты считаются только в нём
+type WorkingHours struct {
+	// Days Рабочие дни недели (mon, tue, wed, thu, fri, sat, sun)
+	Days  []string `json:"days"`
+	End   string   `json:"end"`
+	Start string   `json:"start"`
+
+	// Timezone Часовой пояс из базы IANA
+	Timezone string `json:"timezone"`
+}
+
+// ActorIdHeader defines model for ActorIdHeader.
+type ActorIdHeader = string
+
+// IdempotencyKeyHeader defines model for IdempotencyKeyHeader.
+type IdempotencyKeyHeader = string
+
+// LastEventIdHeader defines model for LastEventIdHeader.
+type LastEventIdHeader = int64
+
+// RepositoryIdQuery defines model for RepositoryIdQuery.
+type RepositoryIdQuery = string
+
+// TeamNameQuery defines model for TeamNameQuery.
+type TeamNameQuery = string
+
+// UserIdQuery defines model for UserIdQuery.
+type UserIdQuery = string
+
+// BadRequest defines model for BadRequest.
+type BadRequest = ErrorResponse
+
+// Forbidden defines model for Forbidden.
+type Forbidden = ErrorResponse
+
+// IdempotencyKeyReused defines model for IdempotencyKeyReused.
+type IdempotencyKeyReused = ErrorResponse
+
+// TooManyRequests defines model for TooManyRequests.
+type TooManyRequests = ErrorResponse
+
+// StreamUserEventsParams defines parameters for StreamUserEvents.
+type StreamUserEventsParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+
+	// LastEventID Идентификатор последнего полученного события. Сервер сначала досылает все более поздние события, затем продолжает поток.
+	LastEventID *LastEventIdHeader `json:"Last-Event-ID,omitempty"`
+}
+
+// StreamTeamEventsParams defines parameters for StreamTeamEvents.
+type StreamTeamEventsParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+
+	// LastEventID Идентификатор последнего полученного события. Сервер сначала досылает все более поздние события, затем продолжает поток.
+	LastEventID *LastEventIdHeader `json:"Last-Event-ID,omitempty"`
+}
+
+// ExportOrgParams defines parameters for ExportOrg.
+type ExportOrgParams struct {
+	Format OrgFormat `form:"format" json:"format"`
+}
+
+// ImportOrgJSONBody defines parameters for ImportOrg.
+type ImportOrgJSONBody struct {
+	Content string `json:"content"`
+	DryRun  bool   `json:"dry_run,omitempty"`
+
+	// Format Формат файла команд. В CSV каждая строка — участник команды с колонками
+	// team_name, parent_team_name, lead_assignment, user_id, username, is_active,
+	// seniority, role, is_primary (обязательны team_name, user_id и username);
+	// строка без user_id описывает только команду. В YAML — список teams,
+	// у каждой команды свой список members с теми же полями.
+	Format OrgFormat `json:"format"`
+}
+
+// ImportOrgParams defines parameters for ImportOrg.
+type ImportOrgParams struct {
+	// XActorId Пользователь, от имени которого выполняется запрос; если заголовок передан, действие проверяется по его ролям в командах. Заголовок только называет пользователя и не аутентифицирует его: это не контроль доступа, а защита от ошибок в клиентах за шлюзом, который сам проставляет X-Actor-Id. Без заголовка операции ведут себя как раньше; исключение — выдача ролей, которая без заголовка доступна только доверенным запросам с Authorization: Bearer и токеном администратора (ADMIN_TOKEN).
+	XActorId *ActorIdHeader `json:"X-Actor-Id,omitempty"`
+
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// CreatePullRequestJSONBody defines parameters for CreatePullRequest.
+type CreatePullRequestJSONBody struct {
+	AuthorId string `json:"author_id"`
+
+	// ChangedFiles Изменённые файлы; если у них есть владельцы, один из ревьюверов будет владельцем
+	ChangedFiles []string `json:"changed_files,omitempty"`
+
+	// Labels Метки PR; стратегия skill предпочитает ревьюверов с совпадающими тегами
+	Labels          []string `json:"labels,omitempty"`
+	PullRequestId   string   `json:"pull_request_id"`
+	PullRequestName string   `json:"pull_request_name"`
+
+	// RepositoryId Репозиторий PR; применяются его политика и CODEOWNERS
+	RepositoryId string `json:"repository_id,omitempty"`
+
+	// TeamName Команда, из которой выбираются ревьюверы и чьи правила и CODEOWNERS применяются; по умолчанию команда репозитория, иначе основная команда автора
+	TeamName string `json:"team_name,omitempty"`
+}
+
+// CreatePullRequestParams defines parameters for CreatePullRequest.
+type CreatePullRequestParams struct {
+	// Explain Вернуть объяснение выбора ревьюверов
+	Explain *bool `form:"explain,omitempty" json:"explain,omitempty"`
+
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// MergePullRequestJSONBody defines parameters for MergePullRequest.
+type MergePullRequestJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// MergePullRequestParams defines parameters for MergePullRequest.
+type MergePullRequestParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// ReassignReviewerJSONBody defines parameters for ReassignReviewer.
+type ReassignReviewerJSONBody struct {
+	OldReviewerId string `json:"old_reviewer_id"`
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// ReassignReviewerParams defines parameters for ReassignReviewer.
+type ReassignReviewerParams struct {
+	// XActorId Пользователь, от имени которого выполняется запрос; если заголовок передан, действие проверяется по его ролям в командах. Заголовок только называет пользователя и не аутентифицирует его: это не контроль доступа, а защита от ошибок в клиентах за шлюзом, который сам проставляет X-Actor-Id. Без заголовка операции ведут себя как раньше; исключение — выдача ролей, которая без заголовка доступна только доверенным запросам с Authorization: Bearer и токеном администратора (ADMIN_TOKEN).
+	XActorId *ActorIdHeader `json:"X-Actor-Id,omitempty"`
+
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// ReassignStaleJSONBody defines parameters for ReassignStale.
+type ReassignStaleJSONBody struct {
+	// DryRun Только сообщить, кого бы переназначили
+	DryRun bool `json:"dry_run,omitempty"`
+
+	// TeamName Ограничить запуск командой и её подкомандами
+	TeamName string `json:"team_name,omitempty"`
+}
+
+// ReassignStaleParams defines parameters for ReassignStale.
+type ReassignStaleParams struct {
+	// XActorId Пользователь, от имени которого выполняется запрос; если заголовок передан, действие проверяется по его ролям в командах. Заголовок только называет пользователя и не аутентифицирует его: это не контроль доступа, а защита от ошибок в клиентах за шлюзом, который сам проставляет X-Actor-Id. Без заголовка операции ведут себя как раньше; исключение — выдача ролей, которая без заголовка доступна только доверенным запросам с Authorization: Bearer и токеном администратора (ADMIN_TOKEN).
+	XActorId *ActorIdHeader `json:"X-Actor-Id,omitempty"`
+
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// ListReassignmentsParams defines parameters for ListReassignments.
+type ListReassignmentsParams struct {
+	PullRequestId string `form:"pull_request_id" json:"pull_request_id"`
+}
+
+// RespondToReviewJSONBody defines parameters for RespondToReview.
+type RespondToReviewJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+	ReviewerId    string `json:"reviewer_id"`
+}
+
+// RespondToReviewParams defines parameters for RespondToReview.
+type RespondToReviewParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// CreateRepositoryParams defines parameters for CreateRepository.
+type CreateRepositoryParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// DeleteRepositoryJSONBody defines parameters for DeleteRepository.
+type DeleteRepositoryJSONBody struct {
+	RepositoryId string `json:"repository_id"`
+}
+
+// DeleteRepositoryParams defines parameters for DeleteRepository.
+type DeleteRepositoryParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// GetRepositoryParams defines parameters for GetRepository.
+type GetRepositoryParams struct {
+	// RepositoryId Идентификатор репозитория
+	RepositoryId RepositoryIdQuery `form:"repository_id" json:"repository_id"`
+}
+
+// ListRepositoriesParams defines parameters for ListRepositories.
+type ListRepositoriesParams struct {
+	// TeamName Только репозитории команды
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
+// UpdateRepositoryParams defines parameters for UpdateRepository.
+type UpdateRepositoryParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// EvaluateRulesJSONBody defines parameters for EvaluateRules.
+type EvaluateRulesJSONBody struct {
+	AuthorId     string   `json:"author_id"`
+	ChangedFiles []string `json:"changed_files,omitempty"`
+
+	// CreatedAt Время, на которое проверяются рабочие часы кандидатов, например created_at созданного PR; по умолчанию текущее
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	Labels       []string   `json:"labels,omitempty"`
+	RepositoryId string     `json:"repository_id,omitempty"`
+
+	// Rules Проверить эти правила вместо сохранённых правил команды
+	Rules *string `json:"rules,omitempty"`
+
+	// Seed Зерно случайного выбора, например assignment_seed созданного PR; по умолчанию новое
+	Seed *int64 `json:"seed,omitempty"`
+
+	// TeamName Команда PR; по умолчанию команда репозитория или основная команда автора
+	TeamName string `json:"team_name,omitempty"`
+}
+
+// GetTeamRulesParams defines parameters for GetTeamRules.
+type GetTeamRulesParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// SetTeamRulesParams defines parameters for SetTeamRules.
+type SetTeamRulesParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// ListSlaBreachesParams defines parameters for ListSlaBreaches.
+type ListSlaBreachesParams struct {
+	// TeamName Ограничить выдачу командой и её подкомандами
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
+// GetReviewerStatsParams defines parameters for GetReviewerStats.
+type GetReviewerStatsParams struct {
+	// TeamName Считать только PR команды и её подкоманд
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
+// ListTagsParams defines parameters for ListTags.
+type ListTagsParams struct {
+	// TeamName Только теги участников команды
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
+// DeleteTeamParams defines parameters for DeleteTeam.
+type DeleteTeamParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+
+	// OpenPrs Что делать с открытыми PR, которые участники пишут или ревьюят: reject — отказать (409), unassign — снять участников с ревью, их собственные PR остаются открытыми
+	OpenPrs *DeleteTeamParamsOpenPrs `form:"open_prs,omitempty" json:"open_prs,omitempty"`
+
+	// XActorId Пользователь, от имени которого выполняется запрос; если заголовок передан, действие проверяется по его ролям в командах. Заголовок только называет пользователя и не аутентифицирует его: это не контроль доступа, а защита от ошибок в клиентах за шлюзом, который сам проставляет X-Actor-Id. Без заголовка операции ведут себя как раньше; исключение — выдача ролей, которая без заголовка доступна только доверенным запросам с Authorization: Bearer и токеном администратора (ADMIN_TOKEN).
+	XActorId *ActorIdHeader `json:"X-Actor-Id,omitempty"`
+}
+
+// DeleteTeamParamsOpenPrs defines parameters for DeleteTeam.
+type DeleteTeamParamsOpenPrs string
+
+// RenameTeamJSONBody defines parameters for RenameTeam.
+type RenameTeamJSONBody struct {
+	NewTeamName string `json:"new_team_name"`
+	TeamName    string `json:"team_name"`
+}
+
+// RenameTeamParams defines parameters for RenameTeam.
+type RenameTeamParams struct {
+	// XActorId Пользователь, от имени которого выполняется запрос; если заголовок передан, действие проверяется по его ролям в командах. Заголовок только называет пользователя и не аутентифицирует его: это не контроль доступа, а защита от ошибок в клиентах за шлюзом, который сам проставляет X-Actor-Id. Без заголовка операции ведут себя как раньше; исключение — выдача ролей, которая без заголовка доступна только доверенным запросам с Authorization: Bearer и токеном администратора (ADMIN_TOKEN).
+	XActorId *ActorIdHeader `json:"X-Actor-Id,omitempty"`
+}
+
+// CreateTeamParams defines parameters for CreateTeam.
+type CreateTeamParams struct {
+	// XActorId Пользователь, от имени которого выполняется запрос; если заголовок передан, действие проверяется по его ролям в командах. Заголовок только называет пользователя и не аутентифицирует его: это не контроль доступа, а защита от ошибок в клиентах за шлюзом, который сам проставляет X-Actor-Id. Без заголовка операции ведут себя как раньше; исключение — выдача ролей, которая без заголовка доступна только доверенным запросам с Authorization: Bearer и токеном администратора (ADMIN_TOKEN).
+	XActorId *ActorIdHeader `json:"X-Actor-Id,omitempty"`
+
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// AddTeamMemberJSONBody defines parameters for AddTeamMember.
+type AddTeamMemberJSONBody struct {
+	Member   TeamMember `json:"member"`
+	TeamName string     `json:"team_name"`
+}
+
+// AddTeamMemberParams defines parameters for AddTeamMember.
+type AddTeamMemberParams struct {
+	// XActorId Пользователь, от имени которого выполняется запрос; если заголовок передан, действие проверяется по его ролям в командах. Заголовок только называет пользователя и не аутентифицирует его: это не контроль доступа, а защита от ошибок в клиентах за шлюзом, который сам проставляет X-Actor-Id. Без заголовка операции ведут себя как раньше; исключение — выдача ролей, которая без заголовка доступна только доверенным запросам с Authorization: Bearer и токеном администратора (ADMIN_TOKEN).
+	XActorId *ActorIdHeader `json:"X-Actor-Id,omitempty"`
+
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// GetTeamParams defines parameters for GetTeam.
+type GetTeamParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetCodeownersParams defines parameters for GetCodeowners.
+type GetCodeownersParams struct {
+	// TeamName Уникальное имя команды
+	TeamName     TeamNameQuery `form:"team_name" json:"team_name"`
+	RepositoryId string        `form:"repository_id,omitempty" json:"repository_id,omitempty"`
+}
+
+// GetTeamSlaParams defines parameters for GetTeamSla.
+type GetTeamSlaParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetStalePolicyParams defines parameters for GetStalePolicy.
+type GetStalePolicyParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamWorkingHoursParams defines parameters for GetTeamWorkingHours.
+type GetTeamWorkingHoursParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// RemoveTeamMemberJSONBody defines parameters for RemoveTeamMember.
+type RemoveTeamMemberJSONBody struct {
+	// OpenReviews Что делать с открытыми ревью, которые участник держит в покидаемой команде: reject — отказать (409), reassign — переназначить на других участников команды, unassign — снять ревьювера без замены.
+	OpenReviews *OpenReviewPolicy `json:"open_reviews,omitempty"`
+	TeamName    string            `json:"team_name"`
+	UserId      string            `json:"user_id"`
+}
+
+// RemoveTeamMemberParams defines parameters for RemoveTeamMember.
+type RemoveTeamMemberParams struct {
+	// XActorId Пользователь, от имени которого выполняется запрос; если заголовок передан, действие проверяется по его ролям в командах. Заголовок только называет пользователя и не аутентифицирует его: это не контроль доступа, а защита от ошибок в клиентах за шлюзом, который сам проставляет X-Actor-Id. Без заголовка операции ведут себя как раньше; исключение — выдача ролей, которая без заголовка доступна только доверенным запросам с Authorization: Bearer и токеном администратора (ADMIN_TOKEN).
+	XActorId *ActorIdHeader `json:"X-Actor-Id,omitempty"`
+
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// SetCodeownersParams defines parameters for SetCodeowners.
+type SetCodeownersParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// SetTeamLeadAssignmentJSONBody defines parameters for SetTeamLeadAssignment.
+type SetTeamLeadAssignmentJSONBody struct {
+	// LeadAssignment Как лиды команды участвуют в автоматическом назначении: normal — наравне со всеми, last_resort — только если больше некого, excluded — никогда. По умолчанию normal.
+	LeadAssignment LeadAssignment `json:"lead_assignment"`
+	TeamName       string         `json:"team_name"`
+}
+
+// SetTeamLeadAssignmentParams defines parameters for SetTeamLeadAssignment.
+type SetTeamLeadAssignmentParams struct {
+	// XActorId Пользователь, от имени которого выполняется запрос; если заголовок передан, действие проверяется по его ролям в командах. Заголовок только называет пользователя и не аутентифицирует его: это не контроль доступа, а защита от ошибок в клиентах за шлюзом, который сам проставляет X-Actor-Id. Без заголовка операции ведут себя как раньше; исключение — выдача ролей, которая без заголовка доступна только доверенным запросам с Authorization: Bearer и токеном администратора (ADMIN_TOKEN).
+	XActorId *ActorIdHeader `json:"X-Actor-Id,omitempty"`
+
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// SetTeamMemberRoleJSONBody defines parameters for SetTeamMemberRole.
+type SetTeamMemberRoleJSONBody struct {
+	// Role Роль участника в команде; по умолчанию member. Лид переназначает ревьюверов, деактивирует участников, раздаёт роли и получает эскалации по просроченным ревью; мейнтейнер может переназначать ревьюверов. Роль в отделе действует и во всех его подкомандах.
+	Role     TeamRole `json:"role"`
+	TeamName string   `json:"team_name"`
+	UserId   string   `json:"user_id"`
+}
+
+// SetTeamMemberRoleParams defines parameters for SetTeamMemberRole.
+type SetTeamMemberRoleParams struct {
+	// XActorId Пользователь, от имени которого выполняется запрос; если заголовок передан, действие проверяется по его ролям в командах. Заголовок только называет пользователя и не аутентифицирует его: это не контроль доступа, а защита от ошибок в клиентах за шлюзом, который сам проставляет X-Actor-Id. Без заголовка операции ведут себя как раньше; исключение — выдача ролей, которая без заголовка доступна только доверенным запросам с Authorization: Bearer и токеном администратора (ADMIN_TOKEN).
+	XActorId *ActorIdHeader `json:"X-Actor-Id,omitempty"`
+
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// SetTeamParentJSONBody defines parameters for SetTeamParent.
+type SetTeamParentJSONBody struct {
+	// ParentTeamName Вышестоящая команда; пустая или отсутствующая делает команду самостоятельной
+	ParentTeamName string `json:"parent_team_name,omitempty"`
+	TeamName       string `json:"team_name"`
+}
+
+// SetTeamParentParams defines parameters for SetTeamParent.
+type SetTeamParentParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// SetTeamSlaParams defines parameters for SetTeamSla.
+type SetTeamSlaParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// SetStalePolicyParams defines parameters for SetStalePolicy.
+type SetStalePolicyParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// SetTeamWorkingHoursJSONBody defines parameters for SetTeamWorkingHours.
+type SetTeamWorkingHoursJSONBody struct {
+	TeamName string `json:"team_name"`
+
+	// WorkingHours Рабочее время; SLA, неактивность ревьюверов и дайджесты считаются только в нём
+	WorkingHours *WorkingHours `json:"working_hours,omitempty"`
+}
+
+// SetTeamWorkingHoursParams defines parameters for SetTeamWorkingHours.
+type SetTeamWorkingHoursParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// GetTeamTreeParams defines parameters for GetTeamTree.
+type GetTeamTreeParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// AddUserTagsParams defines parameters for AddUserTags.
+type AddUserTagsParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// GetUserReviewsParams defines parameters for GetUserReviews.
+type GetUserReviewsParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// MassDeactivateUsersJSONBody defines parameters for MassDeactivateUsers.
+type MassDeactivateUsersJSONBody struct {
+	TeamName string   `json:"team_name"`
+	UserIds  []string `json:"user_ids"`
+}
+
+// MassDeactivateUsersParams defines parameters for MassDeactivateUsers.
+type MassDeactivateUsersParams struct {
+	// XActorId Пользователь, от имени которого выполняется запрос; если заголовок передан, действие проверяется по его ролям в командах. Заголовок только называет пользователя и не аутентифицирует его: это не контроль доступа, а защита от ошибок в клиентах за шлюзом, который сам проставляет X-Actor-Id. Без заголовка операции ведут себя как раньше; исключение — выдача ролей, которая без заголовка доступна только доверенным запросам с Authorization: Bearer и токеном администратора (ADMIN_TOKEN).
+	XActorId *ActorIdHeader `json:"X-Actor-Id,omitempty"`
+
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// RemoveUserTagsParams defines parameters for RemoveUserTags.
+type RemoveUserTagsParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// SetUserEmailJSONBody defines parameters for SetUserEmail.
+type SetUserEmailJSONBody struct {
+	Email openapi_types.Email `json:"email"`
+
+	// EmailOptOut Не отправлять пользователю письма
+	EmailOptOut bool   `json:"email_opt_out,omitempty"`
+	UserId      string `json:"user_id"`
+}
+
+// SetUserEmailParams defines parameters for SetUserEmail.
+type SetUserEmailParams struct {
+	// XActorId Пользователь, от имени которого выполняется запрос; если заголовок передан, действие проверяется по его ролям в командах. Заголовок только называет пользователя и не аутентифицирует его: это не контроль доступа, а защита от ошибок в клиентах за шлюзом, который сам проставляет X-Actor-Id. Без заголовка операции ведут себя как раньше; исключение — выдача ролей, которая без заголовка доступна только доверенным запросам с Authorization: Bearer и токеном администратора (ADMIN_TOKEN).
+	XActorId *ActorIdHeader `json:"X-Actor-Id,omitempty"`
+
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// SetUserActiveJSONBody defines parameters for SetUserActive.
+type SetUserActiveJSONBody struct {
+	IsActive bool   `json:"is_active"`
+	UserId   string `json:"user_id"`
+}
+
+// SetUserActiveParams defines parameters for SetUserActive.
+type SetUserActiveParams struct {
+	// XActorId Пользователь, от имени которого выполняется запрос; если заголовок передан, действие проверяется по его ролям в командах. Заголовок только называет пользователя и не аутентифицирует его: это не контроль доступа, а защита от ошибок в клиентах за шлюзом, который сам проставляет X-Actor-Id. Без заголовка операции ведут себя как раньше; исключение — выдача ролей, которая без заголовка доступна только доверенным запросам с Authorization: Bearer и токеном администратора (ADMIN_TOKEN).
+	XActorId *ActorIdHeader `json:"X-Actor-Id,omitempty"`
+
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// SetUserSeniorityParams defines parameters for SetUserSeniority.
+type SetUserSeniorityParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// SetUserSlackIdJSONBody defines parameters for SetUserSlackId.
+type SetUserSlackIdJSONBody struct {
+	// SlackUserId Идентификатор участника Slack (U...)
+	SlackUserId string `json:"slack_user_id"`
+	UserId      string `json:"user_id"`
+}
+
+// SetUserSlackIdParams defines parameters for SetUserSlackId.
+type SetUserSlackIdParams struct {
+	// XActorId Пользователь, от имени которого выполняется запрос; если заголовок передан, действие проверяется по его ролям в командах. Заголовок только называет пользователя и не аутентифицирует его: это не контроль доступа, а защита от ошибок в клиентах за шлюзом, который сам проставляет X-Actor-Id. Без заголовка операции ведут себя как раньше; исключение — выдача ролей, которая без заголовка доступна только доверенным запросам с Authorization: Bearer и токеном администратора (ADMIN_TOKEN).
+	XActorId *ActorIdHeader `json:"X-Actor-Id,omitempty"`
+
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// SetUserTagsParams defines parameters for SetUserTags.
+type SetUserTagsParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// SetUserWorkingHoursJSONBody defines parameters for SetUserWorkingHours.
+type SetUserWorkingHoursJSONBody struct {
+	UserId string `json:"user_id"`
+
+	// WorkingHours Рабочее время; SLA, неактивность ревьюверов и дайджесты считаются только в нём
+	WorkingHours *WorkingHours `json:"working_hours,omitempty"`
+}
+
+// SetUserWorkingHoursParams defines parameters for SetUserWorkingHours.
+type SetUserWorkingHoursParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом и телом возвращает сохранённый ответ (с заголовком Idempotent-Replayed: true), тот же ключ с другим телом — 422. Тело запроса с ключом — не больше 1 МиБ, иначе 413.
+	IdempotencyKey *IdempotencyKeyHeader `json:"Idempotency-Key,omitempty"`
+}
+
+// ImportOrgJSONRequestBody defines body for ImportOrg for application/json ContentType.
+type ImportOrgJSONRequestBody ImportOrgJSONBody
+
+// CreatePullRequestJSONRequestBody defines body for CreatePullRequest for application/json ContentType.
+type CreatePullRequestJSONRequestBody CreatePullRequestJSONBody
+
+// MergePullRequestJSONRequestBody defines body for MergePullRequest for application/json ContentType.
+type MergePullRequestJSONRequestBody MergePullRequestJSONBody
+
+// ReassignReviewerJSONRequestBody defines body for ReassignReviewer for application/json ContentType.
+type ReassignReviewerJSONRequestBody ReassignReviewerJSONBody
+
+// ReassignStaleJSONRequestBody defines body for ReassignStale for application/json ContentType.
+type ReassignStaleJSONRequestBody ReassignStaleJSONBody
+
+// RespondToReviewJSONRequestBody defines body for RespondToReview for application/json ContentType.
+type RespondToReviewJSONRequestBody RespondToReviewJSONBody
+
+// CreateRepositoryJSONRequestBody defines body for CreateRepository for application/json ContentType.
+type CreateRepositoryJSONRequestBody = Repository
+
+// DeleteRepositoryJSONRequestBody defines body for DeleteRepository for application/json ContentType.
+type DeleteRepositoryJSONRequestBody DeleteRepositoryJSONBody
+
+// UpdateRepositoryJSONRequestBody defines body for UpdateRepository for application/json ContentType.
+type UpdateRepositoryJSONRequestBody = Repository
+
+// EvaluateRulesJSONRequestBody defines body for EvaluateRules for application/json ContentType.
+type EvaluateRulesJSONRequestBody EvaluateRulesJSONBody
+
+// SetTeamRulesJSONRequestBody defines body for SetTeamRules for application/json ContentType.
+type SetTeamRulesJSONRequestBody = TeamRules
+
+// RenameTeamJSONRequestBody defines body for RenameTeam for application/json ContentType.
+type RenameTeamJSONRequestBody RenameTeamJSONBody
+
+// CreateTeamJSONRequestBody defines body for CreateTeam for application/json ContentType.
+type CreateTeamJSONRequestBody = Team
+
+// AddTeamMemberJSONRequestBody defines body for AddTeamMember for application/json ContentType.
+type AddTeamMemberJSONRequestBody AddTeamMemberJSONBody
+
+// RemoveTeamMemberJSONRequestBody defines body for RemoveTeamMember for application/json ContentType.
+type RemoveTeamMemberJSONRequestBody RemoveTeamMemberJSONBody
+
+// SetCodeownersJSONRequestBody defines body for SetCodeowners for application/json ContentType.
+type SetCodeownersJSONRequestBody = Codeowners
+
+// SetTeamLeadAssignmentJSONRequestBody defines body for SetTeamLeadAssignment for application/json ContentType.
+type SetTeamLeadAssignmentJSONRequestBody SetTeamLeadAssignmentJSONBody
+
+// SetTeamMemberRoleJSONRequestBody defines body for SetTeamMemberRole for application/json ContentType.
+type SetTeamMemberRoleJSONRequestBody SetTeamMemberRoleJSONBody
+
+// SetTeamParentJSONRequestBody defines body for SetTeamParent for application/json ContentType.
+type SetTeamParentJSONRequestBody SetTeamParentJSONBody
+
+// SetTeamSlaJSONRequestBody defines body for SetTeamSla for application/json ContentType.
+type SetTeamSlaJSONRequestBody = TeamSla
+
+// SetStalePolicyJSONRequestBody defines body for SetStalePolicy for application/json ContentType.
+type SetStalePolicyJSONRequestBody = StalePolicy
+
+// SetTeamWorkingHoursJSONRequestBody defines body for SetTeamWorkingHours for application/json ContentType.
+type SetTeamWorkingHoursJSONRequestBody SetTeamWorkingHoursJSONBody
+
+// AddUserTagsJSONRequestBody defines body for AddUserTags for application/json ContentType.
+type AddUserTagsJSONRequestBody = UserTagsRequest
+
+// MassDeactivateUsersJSONRequestBody defines body for MassDeactivateUsers for application/json ContentType.
+type MassDeactivateUsersJSONRequestBody MassDeactivateUsersJSONBody
+
+// RemoveUserTagsJSONRequestBody defines body for RemoveUserTags for application/json ContentType.
+type RemoveUserTagsJSONRequestBody = UserTagsRequest
+
+// SetUserEmailJSONRequestBody defines body for SetUserEmail for application/json ContentType.
+type SetUserEmailJSONRequestBody SetUserEmailJSONBody
+
+// SetUserActiveJSONRequestBody defines body for SetUserActive for application/json ContentType.
+type SetUserActiveJSONRequestBody SetUserActiveJSONBody
+
+// SetUserSeniorityJSONRequestBody defines body for SetUserSeniority for application/json ContentType.
+type SetUserSeniorityJSONRequestBody = UserSeniorityRequest
+
+// SetUserSlackIdJSONRequestBody defines body for SetUserSlackId for application/json ContentType.
+type SetUserSlackIdJSONRequestBody SetUserSlackIdJSONBody
+
+// SetUserTagsJSONRequestBody defines body for SetUserTags for application/json ContentType.
+type SetUserTagsJSONRequestBody = UserTagsRequest
+
+// SetUserWorkingHoursJSONRequestBody defines body for SetUserWorkingHours for application/json ContentType.
+type SetUserWorkingHoursJSONRequestBody SetUserWorkingHoursJSONBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Поток событий о ревью пользователя (SSE)
+	// (GET /events/stream)
+	StreamUserEvents(w http.ResponseWriter, r *http.Request, params StreamUserEventsParams)
+	// Поток событий о ревью команды (SSE)
+	// (GET /events/stream/team)
+	StreamTeamEvents(w http.ResponseWriter, r *http.Request, params StreamTeamEventsParams)
+	// Проверка работоспособности
+	// (GET /health)
+	Health(w http.ResponseWriter, r *http.Request)
+	// Выгрузить все команды и участников в CSV или YAML
+	// (GET /org/export)
+	ExportOrg(w http.ResponseWriter, r *http.Request, params ExportOrgParams)
+	// Импортировать команды и участников из CSV или YAML
+	// (POST /org/import)
+	ImportOrg(w http.ResponseWriter, r *http.Request, params ImportOrgParams)
+	// Создать PR и автоматически назначить до 2 ревьюверов из команды PR (или сколько задано в репозитории)
+	// (POST /pullRequest/create)
+	CreatePullRequest(w http.ResponseWriter, r *http.Request, params CreatePullRequestParams)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	MergePullRequest(w http.ResponseWriter, r *http.Request, params MergePullRequestParams)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	ReassignReviewer(w http.ResponseWriter, r *http.Request, params ReassignReviewerParams)
+	// Немедленно применить политики переназначения неактивных ревьюверов
+	// (POST /pullRequest/reassignStale)
+	ReassignStale(w http.ResponseWriter, r *http.Request, params ReassignStaleParams)
+	// История переназначений ревьюверов PR
+	// (GET /pullRequest/reassignments)
+	ListReassignments(w http.ResponseWriter, r *http.Request, params ListReassignmentsParams)
+	// Отметить первую реакцию ревьювера на PR (останавливает отсчёт SLA)
+	// (POST /pullRequest/respond)
+	RespondToReview(w http.ResponseWriter, r *http.Request, params RespondToReviewParams)
+	// Создать репозиторий команды с политикой назначения ревьюверов
+	// (POST /repositories/create)
+	CreateRepository(w http.ResponseWriter, r *http.Request, params CreateRepositoryParams)
+	// Удалить репозиторий; его PR сохраняются с политикой по умолчанию
+	// (POST /repositories/delete)
+	DeleteRepository(w http.ResponseWriter, r *http.Request, params DeleteRepositoryParams)
+	// Получить репозиторий
+	// (GET /repositories/get)
+	GetRepository(w http.ResponseWriter, r *http.Request, params GetRepositoryParams)
+	// Список репозиториев
+	// (GET /repositories/list)
+	ListRepositories(w http.ResponseWriter, r *http.Request, params ListRepositoriesParams)
+	// Заменить название, команду-владельца и политику репозитория
+	// (POST /repositories/update)
+	UpdateRepository(w http.ResponseWriter, r *http.Request, params UpdateRepositoryParams)
+	// Объяснить, как были бы выбраны ревьюверы для гипотетического PR
+	// (POST /rules/evaluate)
+	EvaluateRules(w http.ResponseWriter, r *http.Request)
+	// Получить правила назначения команды
+	// (GET /rules/get)
+	GetTeamRules(w http.ResponseWriter, r *http.Request, params GetTeamRulesParams)
+	// Сохранить правила назначения ревьюверов команды
+	// (POST /rules/set)
+	SetTeamRules(w http.ResponseWriter, r *http.Request, params SetTeamRulesParams)
+	// Открытые PR, ревьюверы которых нарушают SLA
+	// (GET /sla/breaches)
+	ListSlaBreaches(w http.ResponseWriter, r *http.Request, params ListSlaBreachesParams)
+	// Количество открытых PR на каждого ревьювера
+	// (GET /stats/reviewers)
+	GetReviewerStats(w http.ResponseWriter, r *http.Request, params GetReviewerStatsParams)
+	// Теги пользователей с количеством носителей
+	// (GET /tags/list)
+	ListTags(w http.ResponseWriter, r *http.Request, params ListTagsParams)
+	// Удалить команду и её настройки; участники теряют членство в ней, подкоманды становятся самостоятельными
+	// (DELETE /team)
+	DeleteTeam(w http.ResponseWriter, r *http.Request, params DeleteTeamParams)
+	// Переименовать команду вместе с её настройками, участниками и событиями
+	// (PATCH /team)
+	RenameTeam(w http.ResponseWriter, r *http.Request, params RenameTeamParams)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	CreateTeam(w http.ResponseWriter, r *http.Request, params CreateTeamParams)
+	// Добавить участника в команду (создаёт/обновляет пользователя, прочие его команды сохраняются)
+	// (POST /team/addMember)
+	AddTeamMember(w http.ResponseWriter, r *http.Request, params AddTeamMemberParams)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeam(w http.ResponseWriter, r *http.Request, params GetTeamParams)
+	// Получить CODEOWNERS репозитория команды
+	// (GET /team/getCodeowners)
+	GetCodeowners(w http.ResponseWriter, r *http.Request, params GetCodeownersParams)
+	// Получить SLA ревью команды
+	// (GET /team/getSla)
+	GetTeamSla(w http.ResponseWriter, r *http.Request, params GetTeamSlaParams)
+	// Получить политику переназначения неактивных ревьюверов
+	// (GET /team/getStalePolicy)
+	GetStalePolicy(w http.ResponseWriter, r *http.Request, params GetStalePolicyParams)
+	// Получить рабочее время команды
+	// (GET /team/getWorkingHours)
+	GetTeamWorkingHours(w http.ResponseWriter, r *http.Request, params GetTeamWorkingHoursParams)
+	// Исключить участника из команды; если она была основной, основной становится самая давняя из оставшихся
+	// (POST /team/removeMember)
+	RemoveTeamMember(w http.ResponseWriter, r *http.Request, params RemoveTeamMemberParams)
+	// Зарегистрировать CODEOWNERS для репозитория команды
+	// (POST /team/setCodeowners)
+	SetCodeowners(w http.ResponseWriter, r *http.Request, params SetCodeownersParams)
+	// Настроить участие лидов команды в автоматическом назначении
+	// (POST /team/setLeadAssignment)
+	SetTeamLeadAssignment(w http.ResponseWriter, r *http.Request, params SetTeamLeadAssignmentParams)
+	// Назначить участнику роль в команде
+	// (POST /team/setMemberRole)
+	SetTeamMemberRole(w http.ResponseWriter, r *http.Request, params SetTeamMemberRoleParams)
+	// Включить команду в отдел или сделать её самостоятельной
+	// (POST /team/setParent)
+	SetTeamParent(w http.ResponseWriter, r *http.Request, params SetTeamParentParams)
+	// Задать SLA ревью для команды
+	// (POST /team/setSla)
+	SetTeamSla(w http.ResponseWriter, r *http.Request, params SetTeamSlaParams)
+	// Включить автоматическое переназначение неактивных ревьюверов
+	// (POST /team/setStalePolicy)
+	SetStalePolicy(w http.ResponseWriter, r *http.Request, params SetStalePolicyParams)
+	// Задать рабочее время команды
+	// (POST /team/setWorkingHours)
+	SetTeamWorkingHours(w http.ResponseWriter, r *http.Request, params SetTeamWorkingHoursParams)
+	// Получить команду со всеми подкомандами и их численностью
+	// (GET /team/tree)
+	GetTeamTree(w http.ResponseWriter, r *http.Request, params GetTeamTreeParams)
+	// Добавить теги пользователю
+	// (POST /users/addTags)
+	AddUserTags(w http.ResponseWriter, r *http.Request, params AddUserTagsParams)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUserReviews(w http.ResponseWriter, r *http.Request, params GetUserReviewsParams)
+	// Массово деактивировать пользователей
+	// (POST /users/massDeactivate)
+	MassDeactivateUsers(w http.ResponseWriter, r *http.Request, params MassDeactivateUsersParams)
+	// Удалить теги пользователя
+	// (POST /users/removeTags)
+	RemoveUserTags(w http.ResponseWriter, r *http.Request, params RemoveUserTagsParams)
+	// Задать email для уведомлений о ревью и ежедневного дайджеста
+	// (POST /users/setEmail)
+	SetUserEmail(w http.ResponseWriter, r *http.Request, params SetUserEmailParams)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	SetUserActive(w http.ResponseWriter, r *http.Request, params SetUserActiveParams)
+	// Установить уровень пользователя
+	// (POST /users/setSeniority)
+	SetUserSeniority(w http.ResponseWriter, r *http.Request, params SetUserSeniorityParams)
+	// Привязать пользователя к аккаунту Slack для уведомлений о ревью
+	// (POST /users/setSlackId)
+	SetUserSlackId(w http.ResponseWriter, r *http.Request, params SetUserSlackIdParams)
+	// Заменить теги пользователя
+	// (POST /users/setTags)
+	SetUserTags(w http.ResponseWriter, r *http.Request, params SetUserTagsParams)
+	// Задать личное рабочее время пользователя
+	// (POST /users/setWorkingHours)
+	SetUserWorkingHours(w http.ResponseWriter, r *http.Request, params SetUserWorkingHoursParams)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Поток событий о ревью пользователя (SSE)
+// (GET /events/stream)
+func (_ Unimplemented) StreamUserEvents(w http.ResponseWriter, r *http.Request, params StreamUserEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Поток событий о ревью команды (SSE)
+// (GET /events/stream/team)
+func (_ Unimplemented) StreamTeamEvents(w http.ResponseWriter, r *http.Request, params StreamTeamEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Проверка работоспособности
+// (GET /health)
+func (_ Unimplemented) Health(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выгрузить все команды и участников в CSV или YAML
+// (GET /org/export)
+func (_ Unimplemented) ExportOrg(w http.ResponseWriter, r *http.Request, params ExportOrgParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Импортировать команды и участников из CSV или YAML
+// (POST /org/import)
+func (_ Unimplemented) ImportOrg(w http.ResponseWriter, r *http.Request, params ImportOrgParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать PR и автоматически назначить до 2 ревьюверов из команды PR (или сколько задано в репозитории)
+// (POST /pullRequest/create)
+func (_ Unimplemented) CreatePullRequest(w http.ResponseWriter, r *http.Request, params CreatePullRequestParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Пометить PR как MERGED (идемпотентная операция)
+// (POST /pullRequest/merge)
+func (_ Unimplemented) MergePullRequest(w http.ResponseWriter, r *http.Request, params MergePullRequestParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переназначить конкретного ревьювера на другого из его команды
+// (POST /pullRequest/reassign)
+func (_ Unimplemented) ReassignReviewer(w http.ResponseWriter, r *http.Request, params ReassignReviewerParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Немедленно применить политики переназначения неактивных ревьюверов
+// (POST /pullRequest/reassignStale)
+func (_ Unimplemented) ReassignStale(w http.ResponseWriter, r *http.Request, params ReassignStaleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// История переназначений ревьюверов PR
+// (GET /pullRequest/reassignments)
+func (_ Unimplemented) ListReassignments(w http.ResponseWriter, r *http.Request, params ListReassignmentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отметить первую реакцию ревьювера на PR (останавливает отсчёт SLA)
+// (POST /pullRequest/respond)
+func (_ Unimplemented) RespondToReview(w http.ResponseWriter, r *http.Request, params RespondToReviewParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать репозиторий команды с политикой назначения ревьюверов
+// (POST /repositories/create)
+func (_ Unimplemented) CreateRepository(w http.ResponseWriter, r *http.Request, params CreateRepositoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить репозиторий; его PR сохраняются с политикой по умолчанию
+// (POST /repositories/delete)
+func (_ Unimplemented) DeleteRepository(w http.ResponseWriter, r *http.Request, params DeleteRepositoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить репозиторий
+// (GET /repositories/get)
+func (_ Unimplemented) GetRepository(w http.ResponseWriter, r *http.Request, params GetRepositoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Список репозиториев
+// (GET /repositories/list)
+func (_ Unimplemented) ListRepositories(w http.ResponseWriter, r *http.Request, params ListRepositoriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Заменить название, команду-владельца и политику репозитория
+// (POST /repositories/update)
+func (_ Unimplemented) UpdateRepository(w http.ResponseWriter, r *http.Request, params UpdateRepositoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Объяснить, как были бы выбраны ревьюверы для гипотетического PR
+// (POST /rules/evaluate)
+func (_ Unimplemented) EvaluateRules(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить правила назначения команды
+// (GET /rules/get)
+func (_ Unimplemented) GetTeamRules(w http.ResponseWriter, r *http.Request, params GetTeamRulesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Сохранить правила назначения ревьюверов команды
+// (POST /rules/set)
+func (_ Unimplemented) SetTeamRules(w http.ResponseWriter, r *http.Request, params SetTeamRulesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Открытые PR, ревьюверы которых нарушают SLA
+// (GET /sla/breaches)
+func (_ Unimplemented) ListSlaBreaches(w http.ResponseWriter, r *http.Request, params ListSlaBreachesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Количество открытых PR на каждого ревьювера
+// (GET /stats/reviewers)
+func (_ Unimplemented) GetReviewerStats(w http.ResponseWriter, r *http.Request, params GetReviewerStatsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Теги пользователей с количеством носителей
+// (GET /tags/list)
+func (_ Unimplemented) ListTags(w http.ResponseWriter, r *http.Request, params ListTagsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить команду и её настройки; участники теряют членство в ней, подкоманды становятся самостоятельными
+// (DELETE /team)
+func (_ Unimplemented) DeleteTeam(w http.ResponseWriter, r *http.Request, params DeleteTeamParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переименовать команду вместе с её настройками, участниками и событиями
+// (PATCH /team)
+func (_ Unimplemented) RenameTeam(w http.ResponseWriter, r *http.Request, params RenameTeamParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать команду с участниками (создаёт/обновляет пользователей)
+// (POST /team/add)
+func (_ Unimplemented) CreateTeam(w http.ResponseWriter, r *http.Request, params CreateTeamParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавить участника в команду (создаёт/обновляет пользователя, прочие его команды сохраняются)
+// (POST /team/addMember)
+func (_ Unimplemented) AddTeamMember(w http.ResponseWriter, r *http.Request, params AddTeamMemberParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить команду с участниками
+// (GET /team/get)
+func (_ Unimplemented) GetTeam(w http.ResponseWriter, r *http.Request, params GetTeamParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить CODEOWNERS репозитория команды
+// (GET /team/getCodeowners)
+func (_ Unimplemented) GetCodeowners(w http.ResponseWriter, r *http.Request, params GetCodeownersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить SLA ревью команды
+// (GET /team/getSla)
+func (_ Unimplemented) GetTeamSla(w http.ResponseWriter, r *http.Request, params GetTeamSlaParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить политику переназначения неактивных ревьюверов
+// (GET /team/getStalePolicy)
+func (_ Unimplemented) GetStalePolicy(w http.ResponseWriter, r *http.Request, params GetStalePolicyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить рабочее время команды
+// (GET /team/getWorkingHours)
+func (_ Unimplemented) GetTeamWorkingHours(w http.ResponseWriter, r *http.Request, params GetTeamWorkingHoursParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Исключить участника из команды; если она была основной, основной становится самая давняя из оставшихся
+// (POST /team/removeMember)
+func (_ Unimplemented) RemoveTeamMember(w http.ResponseWriter, r *http.Request, params RemoveTeamMemberParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Зарегистрировать CODEOWNERS для репозитория команды
+// (POST /team/setCodeowners)
+func (_ Unimplemented) SetCodeowners(w http.ResponseWriter, r *http.Request, params SetCodeownersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Настроить участие лидов команды в автоматическом назначении
+// (POST /team/setLeadAssignment)
+func (_ Unimplemented) SetTeamLeadAssignment(w http.ResponseWriter, r *http.Request, params SetTeamLeadAssignmentParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Назначить участнику роль в команде
+// (POST /team/setMemberRole)
+func (_ Unimplemented) SetTeamMemberRole(w http.ResponseWriter, r *http.Request, params SetTeamMemberRoleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Включить команду в отдел или сделать её самостоятельной
+// (POST /team/setParent)
+func (_ Unimplemented) SetTeamParent(w http.ResponseWriter, r *http.Request, params SetTeamParentParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать SLA ревью для команды
+// (POST /team/setSla)
+func (_ Unimplemented) SetTeamSla(w http.ResponseWriter, r *http.Request, params SetTeamSlaParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Включить автоматическое переназначение неактивных ревьюверов
+// (POST /team/setStalePolicy)
+func (_ Unimplemented) SetStalePolicy(w http.ResponseWriter, r *http.Request, params SetStalePolicyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать рабочее время команды
+// (POST /team/setWorkingHours)
+func (_ Unimplemented) SetTeamWorkingHours(w http.ResponseWriter, r *http.Request, params SetTeamWorkingHoursParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить команду со всеми подкомандами и их численностью
+// (GET /team/tree)
+func (_ Unimplemented) GetTeamTree(w http.ResponseWriter, r *http.Request, params GetTeamTreeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавить теги пользователю
+// (POST /users/addTags)
+func (_ Unimplemented) AddUserTags(w http.ResponseWriter, r *http.Request, params AddUserTagsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить PR'ы, где пользователь назначен ревьювером
+// (GET /users/getReview)
+func (_ Unimplemented) GetUserReviews(w http.ResponseWriter, r *http.Request, params GetUserReviewsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Массово деактивировать пользователей
+// (POST /users/massDeactivate)
+func (_ Unimplemented) MassDeactivateUsers(w http.ResponseWriter, r *http.Request, params MassDeactivateUsersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить теги пользователя
+// (POST /users/removeTags)
+func (_ Unimplemented) RemoveUserTags(w http.ResponseWriter, r *http.Request, params RemoveUserTagsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать email для уведомлений о ревью и ежедневного дайджеста
+// (POST /users/setEmail)
+func (_ Unimplemented) SetUserEmail(w http.ResponseWriter, r *http.Request, params SetUserEmailParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить флаг активности пользователя
+// (POST /users/setIsActive)
+func (_ Unimplemented) SetUserActive(w http.ResponseWriter, r *http.Request, params SetUserActiveParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить уровень пользователя
+// (POST /users/setSeniority)
+func (_ Unimplemented) SetUserSeniority(w http.ResponseWriter, r *http.Request, params SetUserSeniorityParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Привязать пользователя к аккаунту Slack для уведомлений о ревью
+// (POST /users/setSlackId)
+func (_ Unimplemented) SetUserSlackId(w http.ResponseWriter, r *http.Request, params SetUserSlackIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Заменить теги пользователя
+// (POST /users/setTags)
+func (_ Unimplemented) SetUserTags(w http.ResponseWriter, r *http.Request, params SetUserTagsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать личное рабочее время пользователя
+// (POST /users/setWorkingHours)
+func (_ Unimplemented) SetUserWorkingHours(w http.ResponseWriter, r *http.Request, params SetUserWorkingHoursParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// StreamUserEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamUserEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamUserEventsParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID LastEventIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamUserEvents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StreamTeamEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamTeamEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamTeamEventsParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID LastEventIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamTeamEvents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Health operation middleware
+func (siw *ServerInterfaceWrapper) Health(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Health(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportOrg operation middleware
+func (siw *ServerInterfaceWrapper) ExportOrg(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportOrgParams
+
+	// ------------- Required query parameter "format" -------------
+
+	if paramValue := r.URL.Query().Get("format"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportOrg(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportOrg operation middleware
+func (siw *ServerInterfaceWrapper) ImportOrg(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportOrgParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Id")]; found {
+		var XActorId ActorIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Id", valueList[0], &XActorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Id", Err: err})
+			return
+		}
+
+		params.XActorId = &XActorId
+
+	}
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportOrg(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreatePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) CreatePullRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreatePullRequestParams
+
+	// ------------- Optional query parameter "explain" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "explain", r.URL.Query(), &params.Explain)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "explain", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePullRequest(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MergePullRequest operation middleware
+func (siw *ServerInterfaceWrapper) MergePullRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MergePullRequestParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MergePullRequest(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReassignReviewer operation middleware
+func (siw *ServerInterfaceWrapper) ReassignReviewer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReassignReviewerParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Id")]; found {
+		var XActorId ActorIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Id", valueList[0], &XActorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Id", Err: err})
+			return
+		}
+
+		params.XActorId = &XActorId
+
+	}
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReassignReviewer(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReassignStale operation middleware
+func (siw *ServerInterfaceWrapper) ReassignStale(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReassignStaleParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Id")]; found {
+		var XActorId ActorIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Id", valueList[0], &XActorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Id", Err: err})
+			return
+		}
+
+		params.XActorId = &XActorId
+
+	}
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReassignStale(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListReassignments operation middleware
+func (siw *ServerInterfaceWrapper) ListReassignments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListReassignmentsParams
+
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	if paramValue := r.URL.Query().Get("pull_request_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", r.URL.Query(), &params.PullRequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListReassignments(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RespondToReview operation middleware
+func (siw *ServerInterfaceWrapper) RespondToReview(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RespondToReviewParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RespondToReview(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateRepository operation middleware
+func (siw *ServerInterfaceWrapper) CreateRepository(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateRepositoryParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateRepository(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteRepository operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRepository(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteRepositoryParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRepository(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRepository operation middleware
+func (siw *ServerInterfaceWrapper) GetRepository(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRepositoryParams
+
+	// ------------- Required query parameter "repository_id" -------------
+
+	if paramValue := r.URL.Query().Get("repository_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "repository_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "repository_id", r.URL.Query(), &params.RepositoryId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRepository(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRepositories operation middleware
+func (siw *ServerInterfaceWrapper) ListRepositories(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRepositoriesParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRepositories(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateRepository operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRepository(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateRepositoryParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateRepository(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EvaluateRules operation middleware
+func (siw *ServerInterfaceWrapper) EvaluateRules(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EvaluateRules(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamRules operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamRules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamRulesParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamRules(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetTeamRules operation middleware
+func (siw *ServerInterfaceWrapper) SetTeamRules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetTeamRulesParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetTeamRules(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListSlaBreaches operation middleware
+func (siw *ServerInterfaceWrapper) ListSlaBreaches(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSlaBreachesParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSlaBreaches(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReviewerStats operation middleware
+func (siw *ServerInterfaceWrapper) GetReviewerStats(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReviewerStatsParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReviewerStats(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTags operation middleware
+func (siw *ServerInterfaceWrapper) ListTags(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTagsParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTags(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTeam operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTeamParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "open_prs" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "open_prs", r.URL.Query(), &params.OpenPrs)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "open_prs", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Id")]; found {
+		var XActorId ActorIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Id", valueList[0], &XActorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Id", Err: err})
+			return
+		}
+
+		params.XActorId = &XActorId
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTeam(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RenameTeam operation middleware
+func (siw *ServerInterfaceWrapper) RenameTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RenameTeamParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Id")]; found {
+		var XActorId ActorIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Id", valueList[0], &XActorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Id", Err: err})
+			return
+		}
+
+		params.XActorId = &XActorId
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RenameTeam(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTeam operation middleware
+func (siw *ServerInterfaceWrapper) CreateTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateTeamParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Id")]; found {
+		var XActorId ActorIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Id", valueList[0], &XActorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Id", Err: err})
+			return
+		}
+
+		params.XActorId = &XActorId
+
+	}
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTeam(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddTeamMember operation middleware
+func (siw *ServerInterfaceWrapper) AddTeamMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AddTeamMemberParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Id")]; found {
+		var XActorId ActorIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Id", valueList[0], &XActorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Id", Err: err})
+			return
+		}
+
+		params.XActorId = &XActorId
+
+	}
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddTeamMember(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeam operation middleware
+func (siw *ServerInterfaceWrapper) GetTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeam(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCodeowners operation middleware
+func (siw *ServerInterfaceWrapper) GetCodeowners(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCodeownersParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "repository_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "repository_id", r.URL.Query(), &params.RepositoryId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCodeowners(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamSla operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamSla(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamSlaParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamSla(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStalePolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetStalePolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStalePolicyParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStalePolicy(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamWorkingHours operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamWorkingHours(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamWorkingHoursParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamWorkingHours(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveTeamMember operation middleware
+func (siw *ServerInterfaceWrapper) RemoveTeamMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RemoveTeamMemberParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Id")]; found {
+		var XActorId ActorIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Id", valueList[0], &XActorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Id", Err: err})
+			return
+		}
+
+		params.XActorId = &XActorId
+
+	}
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveTeamMember(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetCodeowners operation middleware
+func (siw *ServerInterfaceWrapper) SetCodeowners(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetCodeownersParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetCodeowners(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetTeamLeadAssignment operation middleware
+func (siw *ServerInterfaceWrapper) SetTeamLeadAssignment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetTeamLeadAssignmentParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Id")]; found {
+		var XActorId ActorIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Id", valueList[0], &XActorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Id", Err: err})
+			return
+		}
+
+		params.XActorId = &XActorId
+
+	}
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetTeamLeadAssignment(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetTeamMemberRole operation middleware
+func (siw *ServerInterfaceWrapper) SetTeamMemberRole(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetTeamMemberRoleParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Id")]; found {
+		var XActorId ActorIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Id", valueList[0], &XActorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Id", Err: err})
+			return
+		}
+
+		params.XActorId = &XActorId
+
+	}
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetTeamMemberRole(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetTeamParent operation middleware
+func (siw *ServerInterfaceWrapper) SetTeamParent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetTeamParentParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetTeamParent(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetTeamSla operation middleware
+func (siw *ServerInterfaceWrapper) SetTeamSla(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetTeamSlaParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetTeamSla(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetStalePolicy operation middleware
+func (siw *ServerInterfaceWrapper) SetStalePolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetStalePolicyParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetStalePolicy(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetTeamWorkingHours operation middleware
+func (siw *ServerInterfaceWrapper) SetTeamWorkingHours(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetTeamWorkingHoursParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetTeamWorkingHours(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamTree operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamTree(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamTreeParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamTree(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddUserTags operation middleware
+func (siw *ServerInterfaceWrapper) AddUserTags(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AddUserTagsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddUserTags(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserReviews operation middleware
+func (siw *ServerInterfaceWrapper) GetUserReviews(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserReviewsParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserReviews(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MassDeactivateUsers operation middleware
+func (siw *ServerInterfaceWrapper) MassDeactivateUsers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MassDeactivateUsersParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Id")]; found {
+		var XActorId ActorIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Id", valueList[0], &XActorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Id", Err: err})
+			return
+		}
+
+		params.XActorId = &XActorId
+
+	}
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MassDeactivateUsers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveUserTags operation middleware
+func (siw *ServerInterfaceWrapper) RemoveUserTags(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RemoveUserTagsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveUserTags(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetUserEmail operation middleware
+func (siw *ServerInterfaceWrapper) SetUserEmail(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetUserEmailParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Id")]; found {
+		var XActorId ActorIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Id", valueList[0], &XActorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Id", Err: err})
+			return
+		}
+
+		params.XActorId = &XActorId
+
+	}
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetUserEmail(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetUserActive operation middleware
+func (siw *ServerInterfaceWrapper) SetUserActive(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetUserActiveParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Id")]; found {
+		var XActorId ActorIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Id", valueList[0], &XActorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Id", Err: err})
+			return
+		}
+
+		params.XActorId = &XActorId
+
+	}
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetUserActive(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetUserSeniority operation middleware
+func (siw *ServerInterfaceWrapper) SetUserSeniority(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetUserSeniorityParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetUserSeniority(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetUserSlackId operation middleware
+func (siw *ServerInterfaceWrapper) SetUserSlackId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetUserSlackIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Id")]; found {
+		var XActorId ActorIdHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Id", valueList[0], &XActorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Id", Err: err})
+			return
+		}
+
+		params.XActorId = &XActorId
+
+	}
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetUserSlackId(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetUserTags operation middleware
+func (siw *ServerInterfaceWrapper) SetUserTags(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetUserTagsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetUserTags(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetUserWorkingHours operation middleware
+func (siw *ServerInterfaceWrapper) SetUserWorkingHours(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetUserWorkingHoursParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKeyHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetUserWorkingHours(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/events/stream", wrapper.StreamUserEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/events/stream/team", wrapper.StreamTeamEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health", wrapper.Health)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/org/export", wrapper.ExportOrg)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/org/import", wrapper.ImportOrg)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/create", wrapper.CreatePullRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.MergePullRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.ReassignReviewer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/reassignStale", wrapper.ReassignStale)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/reassignments", wrapper.ListReassignments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/respond", wrapper.RespondToReview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repositories/create", wrapper.CreateRepository)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repositories/delete", wrapper.DeleteRepository)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repositories/get", wrapper.GetRepository)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repositories/list", wrapper.ListRepositories)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repositories/update", wrapper.UpdateRepository)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/rules/evaluate", wrapper.EvaluateRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/rules/get", wrapper.GetTeamRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/rules/set", wrapper.SetTeamRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/sla/breaches", wrapper.ListSlaBreaches)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/reviewers", wrapper.GetReviewerStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/tags/list", wrapper.ListTags)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/team", wrapper.DeleteTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/team", wrapper.RenameTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/add", wrapper.CreateTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/addMember", wrapper.AddTeamMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/get", wrapper.GetTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/getCodeowners", wrapper.GetCodeowners)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/getSla", wrapper.GetTeamSla)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/getStalePolicy", wrapper.GetStalePolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/getWorkingHours", wrapper.GetTeamWorkingHours)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/removeMember", wrapper.RemoveTeamMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setCodeowners", wrapper.SetCodeowners)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setLeadAssignment", wrapper.SetTeamLeadAssignment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setMemberRole", wrapper.SetTeamMemberRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setParent", wrapper.SetTeamParent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setSla", wrapper.SetTeamSla)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setStalePolicy", wrapper.SetStalePolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setWorkingHours", wrapper.SetTeamWorkingHours)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/tree", wrapper.GetTeamTree)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/addTags", wrapper.AddUserTags)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUserReviews)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/massDeactivate", wrapper.MassDeactivateUsers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/removeTags", wrapper.RemoveUserTags)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setEmail", wrapper.SetUserEmail)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setIsActive", wrapper.SetUserActive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setSeniority", wrapper.SetUserSeniority)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setSlackId", wrapper.SetUserSlackId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setTags", wrapper.SetUserTags)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setWorkingHours", wrapper.SetUserWorkingHours)
+	})
+
+	return r
+}
+
+type BadRequestJSONResponse ErrorResponse
+
+type EventStreamTexteventStreamResponse struct {
+	Body io.Reader
+
+	ContentLength int64
+}
+
+type ForbiddenJSONResponse ErrorResponse
+
+type IdempotencyKeyReusedJSONResponse ErrorResponse
+
+type TooManyRequestsResponseHeaders struct {
+	RateLimitLimit     int
+	RateLimitRemaining int
+	RateLimitReset     int
+	RetryAfter         int
+}
+type TooManyRequestsJSONResponse struct {
+	Body ErrorResponse
+
+	Headers TooManyRequestsResponseHeaders
+}
+
+type StreamUserEventsRequestObject struct {
+	Params StreamUserEventsParams
+}
+
+type StreamUserEventsResponseObject interface {
+	VisitStreamUserEventsResponse(w http.ResponseWriter) error
+}
+
+type StreamUserEvents200TexteventStreamResponse struct {
+	EventStreamTexteventStreamResponse
+}
+
+func (response StreamUserEvents200TexteventStreamResponse) VisitStreamUserEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type StreamUserEvents400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response StreamUserEvents400JSONResponse) VisitStreamUserEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamUserEvents404JSONResponse ErrorResponse
+
+func (response StreamUserEvents404JSONResponse) VisitStreamUserEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamUserEvents429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response StreamUserEvents429JSONResponse) VisitStreamUserEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type StreamTeamEventsRequestObject struct {
+	Params StreamTeamEventsParams
+}
+
+type StreamTeamEventsResponseObject interface {
+	VisitStreamTeamEventsResponse(w http.ResponseWriter) error
+}
+
+type StreamTeamEvents200TexteventStreamResponse struct {
+	EventStreamTexteventStreamResponse
+}
+
+func (response StreamTeamEvents200TexteventStreamResponse) VisitStreamTeamEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type StreamTeamEvents400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response StreamTeamEvents400JSONResponse) VisitStreamTeamEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamTeamEvents404JSONResponse ErrorResponse
+
+func (response StreamTeamEvents404JSONResponse) VisitStreamTeamEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamTeamEvents429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response StreamTeamEvents429JSONResponse) VisitStreamTeamEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type HealthRequestObject struct {
+}
+
+type HealthResponseObject interface {
+	VisitHealthResponse(w http.ResponseWriter) error
+}
+
+type Health200JSONResponse struct {
+	Status string `json:"status"`
+}
+
+func (response Health200JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportOrgRequestObject struct {
+	Params ExportOrgParams
+}
+
+type ExportOrgResponseObject interface {
+	VisitExportOrgResponse(w http.ResponseWriter) error
+}
+
+type ExportOrg200JSONResponse struct {
+	Content string `json:"content"`
+
+	// Format Формат файла команд. В CSV каждая строка — участник команды с колонками
+	// team_name, parent_team_name, lead_assignment, user_id, username, is_active,
+	// seniority, role, is_primary (обязательны team_name, user_id и username);
+	// строка без user_id описывает только команду. В YAML — список teams,
+	// у каждой команды свой список members с теми же полями.
+	Format OrgFormat `json:"format"`
+}
+
+func (response ExportOrg200JSONResponse) VisitExportOrgResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportOrg400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ExportOrg400JSONResponse) VisitExportOrgResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportOrg429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ExportOrg429JSONResponse) VisitExportOrgResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ImportOrgRequestObject struct {
+	Params ImportOrgParams
+	Body   *ImportOrgJSONRequestBody
+}
+
+type ImportOrgResponseObject interface {
+	VisitImportOrgResponse(w http.ResponseWriter) error
+}
+
+type ImportOrg200JSONResponse struct {
+	Changes []OrgChange `json:"changes"`
+	DryRun  bool        `json:"dry_run"`
+}
+
+func (response ImportOrg200JSONResponse) VisitImportOrgResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportOrg400JSONResponse ErrorResponse
+
+func (response ImportOrg400JSONResponse) VisitImportOrgResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportOrg403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ImportOrg403JSONResponse) VisitImportOrgResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportOrg422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response ImportOrg422JSONResponse) VisitImportOrgResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportOrg429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ImportOrg429JSONResponse) VisitImportOrgResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreatePullRequestRequestObject struct {
+	Params CreatePullRequestParams
+	Body   *CreatePullRequestJSONRequestBody
+}
+
+type CreatePullRequestResponseObject interface {
+	VisitCreatePullRequestResponse(w http.ResponseWriter) error
+}
+
+type CreatePullRequest201JSONResponse struct {
+	Explanation *AssignmentExplanation `json:"explanation,omitempty"`
+	Pr          PullRequest            `json:"pr"`
+}
+
+func (response CreatePullRequest201JSONResponse) VisitCreatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePullRequest400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreatePullRequest400JSONResponse) VisitCreatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePullRequest404JSONResponse ErrorResponse
+
+func (response CreatePullRequest404JSONResponse) VisitCreatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePullRequest409JSONResponse ErrorResponse
+
+func (response CreatePullRequest409JSONResponse) VisitCreatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePullRequest422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response CreatePullRequest422JSONResponse) VisitCreatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePullRequest429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response CreatePullRequest429JSONResponse) VisitCreatePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type MergePullRequestRequestObject struct {
+	Params MergePullRequestParams
+	Body   *MergePullRequestJSONRequestBody
+}
+
+type MergePullRequestResponseObject interface {
+	VisitMergePullRequestResponse(w http.ResponseWriter) error
+}
+
+type MergePullRequest200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+func (response MergePullRequest200JSONResponse) VisitMergePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePullRequest400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response MergePullRequest400JSONResponse) VisitMergePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePullRequest404JSONResponse ErrorResponse
+
+func (response MergePullRequest404JSONResponse) VisitMergePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePullRequest422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response MergePullRequest422JSONResponse) VisitMergePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergePullRequest429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response MergePullRequest429JSONResponse) VisitMergePullRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReassignReviewerRequestObject struct {
+	Params ReassignReviewerParams
+	Body   *ReassignReviewerJSONRequestBody
+}
+
+type ReassignReviewerResponseObject interface {
+	VisitReassignReviewerResponse(w http.ResponseWriter) error
+}
+
+type ReassignReviewer200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+
+	// ReplacedBy user_id нового ревьювера
+	ReplacedBy string `json:"replaced_by"`
+}
+
+func (response ReassignReviewer200JSONResponse) VisitReassignReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignReviewer400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ReassignReviewer400JSONResponse) VisitReassignReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignReviewer403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ReassignReviewer403JSONResponse) VisitReassignReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignReviewer404JSONResponse ErrorResponse
+
+func (response ReassignReviewer404JSONResponse) VisitReassignReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignReviewer409JSONResponse ErrorResponse
+
+func (response ReassignReviewer409JSONResponse) VisitReassignReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignReviewer422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response ReassignReviewer422JSONResponse) VisitReassignReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignReviewer429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ReassignReviewer429JSONResponse) VisitReassignReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReassignStaleRequestObject struct {
+	Params ReassignStaleParams
+	Body   *ReassignStaleJSONRequestBody
+}
+
+type ReassignStaleResponseObject interface {
+	VisitReassignStaleResponse(w http.ResponseWriter) error
+}
+
+type ReassignStale200JSONResponse struct {
+	Actions []StaleAction `json:"actions"`
+}
+
+func (response ReassignStale200JSONResponse) VisitReassignStaleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignStale400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ReassignStale400JSONResponse) VisitReassignStaleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignStale403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ReassignStale403JSONResponse) VisitReassignStaleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignStale422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response ReassignStale422JSONResponse) VisitReassignStaleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReassignStale429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ReassignStale429JSONResponse) VisitReassignStaleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListReassignmentsRequestObject struct {
+	Params ListReassignmentsParams
+}
+
+type ListReassignmentsResponseObject interface {
+	VisitListReassignmentsResponse(w http.ResponseWriter) error
+}
+
+type ListReassignments200JSONResponse struct {
+	PullRequestId string         `json:"pull_request_id"`
+	Reassignments []Reassignment `json:"reassignments"`
+}
+
+func (response ListReassignments200JSONResponse) VisitListReassignmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListReassignments400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListReassignments400JSONResponse) VisitListReassignmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListReassignments404JSONResponse ErrorResponse
+
+func (response ListReassignments404JSONResponse) VisitListReassignmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListReassignments429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ListReassignments429JSONResponse) VisitListReassignmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RespondToReviewRequestObject struct {
+	Params RespondToReviewParams
+	Body   *RespondToReviewJSONRequestBody
+}
+
+type RespondToReviewResponseObject interface {
+	VisitRespondToReviewResponse(w http.ResponseWriter) error
+}
+
+type RespondToReview200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+func (response RespondToReview200JSONResponse) VisitRespondToReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RespondToReview400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RespondToReview400JSONResponse) VisitRespondToReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RespondToReview404JSONResponse ErrorResponse
+
+func (response RespondToReview404JSONResponse) VisitRespondToReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RespondToReview409JSONResponse ErrorResponse
+
+func (response RespondToReview409JSONResponse) VisitRespondToReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RespondToReview422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response RespondToReview422JSONResponse) VisitRespondToReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RespondToReview429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response RespondToReview429JSONResponse) VisitRespondToReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateRepositoryRequestObject struct {
+	Params CreateRepositoryParams
+	Body   *CreateRepositoryJSONRequestBody
+}
+
+type CreateRepositoryResponseObject interface {
+	VisitCreateRepositoryResponse(w http.ResponseWriter) error
+}
+
+type CreateRepository201JSONResponse struct {
+	Repository Repository `json:"repository"`
+}
+
+func (response CreateRepository201JSONResponse) VisitCreateRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRepository400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateRepository400JSONResponse) VisitCreateRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRepository404JSONResponse ErrorResponse
+
+func (response CreateRepository404JSONResponse) VisitCreateRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRepository409JSONResponse ErrorResponse
+
+func (response CreateRepository409JSONResponse) VisitCreateRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRepository422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response CreateRepository422JSONResponse) VisitCreateRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRepository429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response CreateRepository429JSONResponse) VisitCreateRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteRepositoryRequestObject struct {
+	Params DeleteRepositoryParams
+	Body   *DeleteRepositoryJSONRequestBody
+}
+
+type DeleteRepositoryResponseObject interface {
+	VisitDeleteRepositoryResponse(w http.ResponseWriter) error
+}
+
+type DeleteRepository204Response struct {
+}
+
+func (response DeleteRepository204Response) VisitDeleteRepositoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteRepository400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteRepository400JSONResponse) VisitDeleteRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRepository404JSONResponse ErrorResponse
+
+func (response DeleteRepository404JSONResponse) VisitDeleteRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRepository422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response DeleteRepository422JSONResponse) VisitDeleteRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRepository429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response DeleteRepository429JSONResponse) VisitDeleteRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetRepositoryRequestObject struct {
+	Params GetRepositoryParams
+}
+
+type GetRepositoryResponseObject interface {
+	VisitGetRepositoryResponse(w http.ResponseWriter) error
+}
+
+type GetRepository200JSONResponse struct {
+	Repository Repository `json:"repository"`
+}
+
+func (response GetRepository200JSONResponse) VisitGetRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepository400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetRepository400JSONResponse) VisitGetRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepository404JSONResponse ErrorResponse
+
+func (response GetRepository404JSONResponse) VisitGetRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepository429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetRepository429JSONResponse) VisitGetRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListRepositoriesRequestObject struct {
+	Params ListRepositoriesParams
+}
+
+type ListRepositoriesResponseObject interface {
+	VisitListRepositoriesResponse(w http.ResponseWriter) error
+}
+
+type ListRepositories200JSONResponse struct {
+	Repositories []Repository `json:"repositories"`
+}
+
+func (response ListRepositories200JSONResponse) VisitListRepositoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRepositories400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListRepositories400JSONResponse) VisitListRepositoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRepositories404JSONResponse ErrorResponse
+
+func (response ListRepositories404JSONResponse) VisitListRepositoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRepositories429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ListRepositories429JSONResponse) VisitListRepositoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateRepositoryRequestObject struct {
+	Params UpdateRepositoryParams
+	Body   *UpdateRepositoryJSONRequestBody
+}
+
+type UpdateRepositoryResponseObject interface {
+	VisitUpdateRepositoryResponse(w http.ResponseWriter) error
+}
+
+type UpdateRepository200JSONResponse struct {
+	Repository Repository `json:"repository"`
+}
+
+func (response UpdateRepository200JSONResponse) VisitUpdateRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRepository400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateRepository400JSONResponse) VisitUpdateRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRepository404JSONResponse ErrorResponse
+
+func (response UpdateRepository404JSONResponse) VisitUpdateRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRepository422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response UpdateRepository422JSONResponse) VisitUpdateRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRepository429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response UpdateRepository429JSONResponse) VisitUpdateRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type EvaluateRulesRequestObject struct {
+	Body *EvaluateRulesJSONRequestBody
+}
+
+type EvaluateRulesResponseObject interface {
+	VisitEvaluateRulesResponse(w http.ResponseWriter) error
+}
+
+type EvaluateRules200JSONResponse struct {
+	Evaluation RuleEvaluation `json:"evaluation"`
+}
+
+func (response EvaluateRules200JSONResponse) VisitEvaluateRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EvaluateRules400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response EvaluateRules400JSONResponse) VisitEvaluateRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EvaluateRules404JSONResponse ErrorResponse
+
+func (response EvaluateRules404JSONResponse) VisitEvaluateRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EvaluateRules429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response EvaluateRules429JSONResponse) VisitEvaluateRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTeamRulesRequestObject struct {
+	Params GetTeamRulesParams
+}
+
+type GetTeamRulesResponseObject interface {
+	VisitGetTeamRulesResponse(w http.ResponseWriter) error
+}
+
+type GetTeamRules200JSONResponse struct {
+	Rules TeamRules `json:"rules"`
+}
+
+func (response GetTeamRules200JSONResponse) VisitGetTeamRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamRules400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetTeamRules400JSONResponse) VisitGetTeamRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamRules404JSONResponse ErrorResponse
+
+func (response GetTeamRules404JSONResponse) VisitGetTeamRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamRules429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetTeamRules429JSONResponse) VisitGetTeamRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetTeamRulesRequestObject struct {
+	Params SetTeamRulesParams
+	Body   *SetTeamRulesJSONRequestBody
+}
+
+type SetTeamRulesResponseObject interface {
+	VisitSetTeamRulesResponse(w http.ResponseWriter) error
+}
+
+type SetTeamRules200JSONResponse struct {
+	Rules TeamRules `json:"rules"`
+}
+
+func (response SetTeamRules200JSONResponse) VisitSetTeamRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamRules400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetTeamRules400JSONResponse) VisitSetTeamRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamRules404JSONResponse ErrorResponse
+
+func (response SetTeamRules404JSONResponse) VisitSetTeamRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamRules422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response SetTeamRules422JSONResponse) VisitSetTeamRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamRules429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response SetTeamRules429JSONResponse) VisitSetTeamRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListSlaBreachesRequestObject struct {
+	Params ListSlaBreachesParams
+}
+
+type ListSlaBreachesResponseObject interface {
+	VisitListSlaBreachesResponse(w http.ResponseWriter) error
+}
+
+type ListSlaBreaches200JSONResponse struct {
+	Breaches []SlaBreach `json:"breaches"`
+}
+
+func (response ListSlaBreaches200JSONResponse) VisitListSlaBreachesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSlaBreaches400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListSlaBreaches400JSONResponse) VisitListSlaBreachesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSlaBreaches429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ListSlaBreaches429JSONResponse) VisitListSlaBreachesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetReviewerStatsRequestObject struct {
+	Params GetReviewerStatsParams
+}
+
+type GetReviewerStatsResponseObject interface {
+	VisitGetReviewerStatsResponse(w http.ResponseWriter) error
+}
+
+type GetReviewerStats200JSONResponse struct {
+	Stats map[string]int64 `json:"stats"`
+}
+
+func (response GetReviewerStats200JSONResponse) VisitGetReviewerStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReviewerStats404JSONResponse ErrorResponse
+
+func (response GetReviewerStats404JSONResponse) VisitGetReviewerStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReviewerStats429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetReviewerStats429JSONResponse) VisitGetReviewerStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListTagsRequestObject struct {
+	Params ListTagsParams
+}
+
+type ListTagsResponseObject interface {
+	VisitListTagsResponse(w http.ResponseWriter) error
+}
+
+type ListTags200JSONResponse struct {
+	Tags []TagCount `json:"tags"`
+}
+
+func (response ListTags200JSONResponse) VisitListTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTags400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListTags400JSONResponse) VisitListTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTags404JSONResponse ErrorResponse
+
+func (response ListTags404JSONResponse) VisitListTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTags429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ListTags429JSONResponse) VisitListTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteTeamRequestObject struct {
+	Params DeleteTeamParams
+}
+
+type DeleteTeamResponseObject interface {
+	VisitDeleteTeamResponse(w http.ResponseWriter) error
+}
+
+type DeleteTeam200JSONResponse struct {
+	Deletion TeamDeletion `json:"deletion"`
+}
+
+func (response DeleteTeam200JSONResponse) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeam400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteTeam400JSONResponse) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeam403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteTeam403JSONResponse) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeam404JSONResponse ErrorResponse
+
+func (response DeleteTeam404JSONResponse) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeam409JSONResponse ErrorResponse
+
+func (response DeleteTeam409JSONResponse) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeam429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response DeleteTeam429JSONResponse) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RenameTeamRequestObject struct {
+	Params RenameTeamParams
+	Body   *RenameTeamJSONRequestBody
+}
+
+type RenameTeamResponseObject interface {
+	VisitRenameTeamResponse(w http.ResponseWriter) error
+}
+
+type RenameTeam200JSONResponse struct {
+	Team Team `json:"team"`
+}
+
+func (response RenameTeam200JSONResponse) VisitRenameTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenameTeam400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RenameTeam400JSONResponse) VisitRenameTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenameTeam403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RenameTeam403JSONResponse) VisitRenameTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenameTeam404JSONResponse ErrorResponse
+
+func (response RenameTeam404JSONResponse) VisitRenameTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenameTeam409JSONResponse ErrorResponse
+
+func (response RenameTeam409JSONResponse) VisitRenameTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenameTeam429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response RenameTeam429JSONResponse) VisitRenameTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateTeamRequestObject struct {
+	Params CreateTeamParams
+	Body   *CreateTeamJSONRequestBody
+}
+
+type CreateTeamResponseObject interface {
+	VisitCreateTeamResponse(w http.ResponseWriter) error
+}
+
+type CreateTeam201JSONResponse struct {
+	Team Team `json:"team"`
+}
+
+func (response CreateTeam201JSONResponse) VisitCreateTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTeam400JSONResponse ErrorResponse
+
+func (response CreateTeam400JSONResponse) VisitCreateTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTeam403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateTeam403JSONResponse) VisitCreateTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTeam404JSONResponse ErrorResponse
+
+func (response CreateTeam404JSONResponse) VisitCreateTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTeam422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response CreateTeam422JSONResponse) VisitCreateTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTeam429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response CreateTeam429JSONResponse) VisitCreateTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AddTeamMemberRequestObject struct {
+	Params AddTeamMemberParams
+	Body   *AddTeamMemberJSONRequestBody
+}
+
+type AddTeamMemberResponseObject interface {
+	VisitAddTeamMemberResponse(w http.ResponseWriter) error
+}
+
+type AddTeamMember200JSONResponse struct {
+	Team Team `json:"team"`
+}
+
+func (response AddTeamMember200JSONResponse) VisitAddTeamMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTeamMember400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AddTeamMember400JSONResponse) VisitAddTeamMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTeamMember403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response AddTeamMember403JSONResponse) VisitAddTeamMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTeamMember404JSONResponse ErrorResponse
+
+func (response AddTeamMember404JSONResponse) VisitAddTeamMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTeamMember422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response AddTeamMember422JSONResponse) VisitAddTeamMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTeamMember429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response AddTeamMember429JSONResponse) VisitAddTeamMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTeamRequestObject struct {
+	Params GetTeamParams
+}
+
+type GetTeamResponseObject interface {
+	VisitGetTeamResponse(w http.ResponseWriter) error
+}
+
+type GetTeam200JSONResponse struct {
+	Team Team `json:"team"`
+}
+
+func (response GetTeam200JSONResponse) VisitGetTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeam400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetTeam400JSONResponse) VisitGetTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeam404JSONResponse ErrorResponse
+
+func (response GetTeam404JSONResponse) VisitGetTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeam429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetTeam429JSONResponse) VisitGetTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCodeownersRequestObject struct {
+	Params GetCodeownersParams
+}
+
+type GetCodeownersResponseObject interface {
+	VisitGetCodeownersResponse(w http.ResponseWriter) error
+}
+
+type GetCodeowners200JSONResponse struct {
+	Codeowners Codeowners `json:"codeowners"`
+}
+
+func (response GetCodeowners200JSONResponse) VisitGetCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCodeowners400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetCodeowners400JSONResponse) VisitGetCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCodeowners404JSONResponse ErrorResponse
+
+func (response GetCodeowners404JSONResponse) VisitGetCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCodeowners429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetCodeowners429JSONResponse) VisitGetCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTeamSlaRequestObject struct {
+	Params GetTeamSlaParams
+}
+
+type GetTeamSlaResponseObject interface {
+	VisitGetTeamSlaResponse(w http.ResponseWriter) error
+}
+
+type GetTeamSla200JSONResponse struct {
+	Sla TeamSla `json:"sla"`
+}
+
+func (response GetTeamSla200JSONResponse) VisitGetTeamSlaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSla400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetTeamSla400JSONResponse) VisitGetTeamSlaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSla404JSONResponse ErrorResponse
+
+func (response GetTeamSla404JSONResponse) VisitGetTeamSlaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSla429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetTeamSla429JSONResponse) VisitGetTeamSlaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetStalePolicyRequestObject struct {
+	Params GetStalePolicyParams
+}
+
+type GetStalePolicyResponseObject interface {
+	VisitGetStalePolicyResponse(w http.ResponseWriter) error
+}
+
+type GetStalePolicy200JSONResponse struct {
+	Policy StalePolicy `json:"policy"`
+}
+
+func (response GetStalePolicy200JSONResponse) VisitGetStalePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStalePolicy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetStalePolicy400JSONResponse) VisitGetStalePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStalePolicy404JSONResponse ErrorResponse
+
+func (response GetStalePolicy404JSONResponse) VisitGetStalePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStalePolicy429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetStalePolicy429JSONResponse) VisitGetStalePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTeamWorkingHoursRequestObject struct {
+	Params GetTeamWorkingHoursParams
+}
+
+type GetTeamWorkingHoursResponseObject interface {
+	VisitGetTeamWorkingHoursResponse(w http.ResponseWriter) error
+}
+
+type GetTeamWorkingHours200JSONResponse TeamWorkingHours
+
+func (response GetTeamWorkingHours200JSONResponse) VisitGetTeamWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamWorkingHours400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetTeamWorkingHours400JSONResponse) VisitGetTeamWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamWorkingHours404JSONResponse ErrorResponse
+
+func (response GetTeamWorkingHours404JSONResponse) VisitGetTeamWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamWorkingHours429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetTeamWorkingHours429JSONResponse) VisitGetTeamWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RemoveTeamMemberRequestObject struct {
+	Params RemoveTeamMemberParams
+	Body   *RemoveTeamMemberJSONRequestBody
+}
+
+type RemoveTeamMemberResponseObject interface {
+	VisitRemoveTeamMemberResponse(w http.ResponseWriter) error
+}
+
+type RemoveTeamMember200JSONResponse struct {
+	Team Team `json:"team"`
+}
+
+func (response RemoveTeamMember200JSONResponse) VisitRemoveTeamMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveTeamMember400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RemoveTeamMember400JSONResponse) VisitRemoveTeamMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveTeamMember403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RemoveTeamMember403JSONResponse) VisitRemoveTeamMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveTeamMember404JSONResponse ErrorResponse
+
+func (response RemoveTeamMember404JSONResponse) VisitRemoveTeamMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveTeamMember409JSONResponse ErrorResponse
+
+func (response RemoveTeamMember409JSONResponse) VisitRemoveTeamMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveTeamMember422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response RemoveTeamMember422JSONResponse) VisitRemoveTeamMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveTeamMember429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response RemoveTeamMember429JSONResponse) VisitRemoveTeamMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetCodeownersRequestObject struct {
+	Params SetCodeownersParams
+	Body   *SetCodeownersJSONRequestBody
+}
+
+type SetCodeownersResponseObject interface {
+	VisitSetCodeownersResponse(w http.ResponseWriter) error
+}
+
+type SetCodeowners200JSONResponse struct {
+	Codeowners Codeowners `json:"codeowners"`
+}
+
+func (response SetCodeowners200JSONResponse) VisitSetCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCodeowners400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetCodeowners400JSONResponse) VisitSetCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCodeowners404JSONResponse ErrorResponse
+
+func (response SetCodeowners404JSONResponse) VisitSetCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCodeowners422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response SetCodeowners422JSONResponse) VisitSetCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCodeowners429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response SetCodeowners429JSONResponse) VisitSetCodeownersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetTeamLeadAssignmentRequestObject struct {
+	Params SetTeamLeadAssignmentParams
+	Body   *SetTeamLeadAssignmentJSONRequestBody
+}
+
+type SetTeamLeadAssignmentResponseObject interface {
+	VisitSetTeamLeadAssignmentResponse(w http.ResponseWriter) error
+}
+
+type SetTeamLeadAssignment200JSONResponse struct {
+	Team Team `json:"team"`
+}
+
+func (response SetTeamLeadAssignment200JSONResponse) VisitSetTeamLeadAssignmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamLeadAssignment400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetTeamLeadAssignment400JSONResponse) VisitSetTeamLeadAssignmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamLeadAssignment403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response SetTeamLeadAssignment403JSONResponse) VisitSetTeamLeadAssignmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamLeadAssignment404JSONResponse ErrorResponse
+
+func (response SetTeamLeadAssignment404JSONResponse) VisitSetTeamLeadAssignmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamLeadAssignment422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response SetTeamLeadAssignment422JSONResponse) VisitSetTeamLeadAssignmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamLeadAssignment429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response SetTeamLeadAssignment429JSONResponse) VisitSetTeamLeadAssignmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetTeamMemberRoleRequestObject struct {
+	Params SetTeamMemberRoleParams
+	Body   *SetTeamMemberRoleJSONRequestBody
+}
+
+type SetTeamMemberRoleResponseObject interface {
+	VisitSetTeamMemberRoleResponse(w http.ResponseWriter) error
+}
+
+type SetTeamMemberRole200JSONResponse struct {
+	Team Team `json:"team"`
+}
+
+func (response SetTeamMemberRole200JSONResponse) VisitSetTeamMemberRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamMemberRole400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetTeamMemberRole400JSONResponse) VisitSetTeamMemberRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamMemberRole403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response SetTeamMemberRole403JSONResponse) VisitSetTeamMemberRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamMemberRole404JSONResponse ErrorResponse
+
+func (response SetTeamMemberRole404JSONResponse) VisitSetTeamMemberRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamMemberRole422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response SetTeamMemberRole422JSONResponse) VisitSetTeamMemberRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamMemberRole429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response SetTeamMemberRole429JSONResponse) VisitSetTeamMemberRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetTeamParentRequestObject struct {
+	Params SetTeamParentParams
+	Body   *SetTeamParentJSONRequestBody
+}
+
+type SetTeamParentResponseObject interface {
+	VisitSetTeamParentResponse(w http.ResponseWriter) error
+}
+
+type SetTeamParent200JSONResponse struct {
+	Team Team `json:"team"`
+}
+
+func (response SetTeamParent200JSONResponse) VisitSetTeamParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamParent400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetTeamParent400JSONResponse) VisitSetTeamParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamParent404JSONResponse ErrorResponse
+
+func (response SetTeamParent404JSONResponse) VisitSetTeamParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamParent409JSONResponse ErrorResponse
+
+func (response SetTeamParent409JSONResponse) VisitSetTeamParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamParent422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response SetTeamParent422JSONResponse) VisitSetTeamParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamParent429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response SetTeamParent429JSONResponse) VisitSetTeamParentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetTeamSlaRequestObject struct {
+	Params SetTeamSlaParams
+	Body   *SetTeamSlaJSONRequestBody
+}
+
+type SetTeamSlaResponseObject interface {
+	VisitSetTeamSlaResponse(w http.ResponseWriter) error
+}
+
+type SetTeamSla200JSONResponse struct {
+	Sla TeamSla `json:"sla"`
+}
+
+func (response SetTeamSla200JSONResponse) VisitSetTeamSlaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamSla400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetTeamSla400JSONResponse) VisitSetTeamSlaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamSla404JSONResponse ErrorResponse
+
+func (response SetTeamSla404JSONResponse) VisitSetTeamSlaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamSla422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response SetTeamSla422JSONResponse) VisitSetTeamSlaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamSla429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response SetTeamSla429JSONResponse) VisitSetTeamSlaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetStalePolicyRequestObject struct {
+	Params SetStalePolicyParams
+	Body   *SetStalePolicyJSONRequestBody
+}
+
+type SetStalePolicyResponseObject interface {
+	VisitSetStalePolicyResponse(w http.ResponseWriter) error
+}
+
+type SetStalePolicy200JSONResponse struct {
+	Policy StalePolicy `json:"policy"`
+}
+
+func (response SetStalePolicy200JSONResponse) VisitSetStalePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetStalePolicy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetStalePolicy400JSONResponse) VisitSetStalePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetStalePolicy404JSONResponse ErrorResponse
+
+func (response SetStalePolicy404JSONResponse) VisitSetStalePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetStalePolicy422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response SetStalePolicy422JSONResponse) VisitSetStalePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetStalePolicy429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response SetStalePolicy429JSONResponse) VisitSetStalePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetTeamWorkingHoursRequestObject struct {
+	Params SetTeamWorkingHoursParams
+	Body   *SetTeamWorkingHoursJSONRequestBody
+}
+
+type SetTeamWorkingHoursResponseObject interface {
+	VisitSetTeamWorkingHoursResponse(w http.ResponseWriter) error
+}
+
+type SetTeamWorkingHours200JSONResponse TeamWorkingHours
+
+func (response SetTeamWorkingHours200JSONResponse) VisitSetTeamWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamWorkingHours400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetTeamWorkingHours400JSONResponse) VisitSetTeamWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamWorkingHours404JSONResponse ErrorResponse
+
+func (response SetTeamWorkingHours404JSONResponse) VisitSetTeamWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamWorkingHours422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response SetTeamWorkingHours422JSONResponse) VisitSetTeamWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTeamWorkingHours429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response SetTeamWorkingHours429JSONResponse) VisitSetTeamWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTeamTreeRequestObject struct {
+	Params GetTeamTreeParams
+}
+
+type GetTeamTreeResponseObject interface {
+	VisitGetTeamTreeResponse(w http.ResponseWriter) error
+}
+
+type GetTeamTree200JSONResponse struct {
+	Tree TeamTree `json:"tree"`
+}
+
+func (response GetTeamTree200JSONResponse) VisitGetTeamTreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamTree400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetTeamTree400JSONResponse) VisitGetTeamTreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamTree404JSONResponse ErrorResponse
+
+func (response GetTeamTree404JSONResponse) VisitGetTeamTreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamTree429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetTeamTree429JSONResponse) VisitGetTeamTreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AddUserTagsRequestObject struct {
+	Params AddUserTagsParams
+	Body   *AddUserTagsJSONRequestBody
+}
+
+type AddUserTagsResponseObject interface {
+	VisitAddUserTagsResponse(w http.ResponseWriter) error
+}
+
+type AddUserTags200JSONResponse struct {
+	User User `json:"user"`
+}
+
+func (response AddUserTags200JSONResponse) VisitAddUserTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddUserTags400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AddUserTags400JSONResponse) VisitAddUserTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddUserTags404JSONResponse ErrorResponse
+
+func (response AddUserTags404JSONResponse) VisitAddUserTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddUserTags422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response AddUserTags422JSONResponse) VisitAddUserTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddUserTags429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response AddUserTags429JSONResponse) VisitAddUserTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetUserReviewsRequestObject struct {
+	Params GetUserReviewsParams
+}
+
+type GetUserReviewsResponseObject interface {
+	VisitGetUserReviewsResponse(w http.ResponseWriter) error
+}
+
+type GetUserReviews200JSONResponse struct {
+	PullRequests []PullRequestShort `json:"pull_requests"`
+	UserId       string             `json:"user_id"`
+}
+
+func (response GetUserReviews200JSONResponse) VisitGetUserReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserReviews400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetUserReviews400JSONResponse) VisitGetUserReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserReviews404JSONResponse ErrorResponse
+
+func (response GetUserReviews404JSONResponse) VisitGetUserReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserReviews429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetUserReviews429JSONResponse) VisitGetUserReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type MassDeactivateUsersRequestObject struct {
+	Params MassDeactivateUsersParams
+	Body   *MassDeactivateUsersJSONRequestBody
+}
+
+type MassDeactivateUsersResponseObject interface {
+	VisitMassDeactivateUsersResponse(w http.ResponseWriter) error
+}
+
+type MassDeactivateUsers200JSONResponse struct {
+	DeactivatedCount int    `json:"deactivated_count"`
+	Message          string `json:"message"`
+}
+
+func (response MassDeactivateUsers200JSONResponse) VisitMassDeactivateUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MassDeactivateUsers400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response MassDeactivateUsers400JSONResponse) VisitMassDeactivateUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MassDeactivateUsers403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response MassDeactivateUsers403JSONResponse) VisitMassDeactivateUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MassDeactivateUsers422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response MassDeactivateUsers422JSONResponse) VisitMassDeactivateUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MassDeactivateUsers429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response MassDeactivateUsers429JSONResponse) VisitMassDeactivateUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RemoveUserTagsRequestObject struct {
+	Params RemoveUserTagsParams
+	Body   *RemoveUserTagsJSONRequestBody
+}
+
+type RemoveUserTagsResponseObject interface {
+	VisitRemoveUserTagsResponse(w http.ResponseWriter) error
+}
+
+type RemoveUserTags200JSONResponse struct {
+	User User `json:"user"`
+}
+
+func (response RemoveUserTags200JSONResponse) VisitRemoveUserTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveUserTags400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RemoveUserTags400JSONResponse) VisitRemoveUserTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveUserTags404JSONResponse ErrorResponse
+
+func (response RemoveUserTags404JSONResponse) VisitRemoveUserTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveUserTags422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response RemoveUserTags422JSONResponse) VisitRemoveUserTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveUserTags429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response RemoveUserTags429JSONResponse) VisitRemoveUserTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetUserEmailRequestObject struct {
+	Params SetUserEmailParams
+	Body   *SetUserEmailJSONRequestBody
+}
+
+type SetUserEmailResponseObject interface {
+	VisitSetUserEmailResponse(w http.ResponseWriter) error
+}
+
+type SetUserEmail200JSONResponse struct {
+	User User `json:"user"`
+}
+
+func (response SetUserEmail200JSONResponse) VisitSetUserEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserEmail400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetUserEmail400JSONResponse) VisitSetUserEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserEmail403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response SetUserEmail403JSONResponse) VisitSetUserEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserEmail404JSONResponse ErrorResponse
+
+func (response SetUserEmail404JSONResponse) VisitSetUserEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserEmail422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response SetUserEmail422JSONResponse) VisitSetUserEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserEmail429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response SetUserEmail429JSONResponse) VisitSetUserEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetUserActiveRequestObject struct {
+	Params SetUserActiveParams
+	Body   *SetUserActiveJSONRequestBody
+}
+
+type SetUserActiveResponseObject interface {
+	VisitSetUserActiveResponse(w http.ResponseWriter) error
+}
+
+type SetUserActive200JSONResponse struct {
+	User User `json:"user"`
+}
+
+func (response SetUserActive200JSONResponse) VisitSetUserActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserActive400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetUserActive400JSONResponse) VisitSetUserActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserActive403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response SetUserActive403JSONResponse) VisitSetUserActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserActive404JSONResponse ErrorResponse
+
+func (response SetUserActive404JSONResponse) VisitSetUserActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserActive422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response SetUserActive422JSONResponse) VisitSetUserActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserActive429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response SetUserActive429JSONResponse) VisitSetUserActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetUserSeniorityRequestObject struct {
+	Params SetUserSeniorityParams
+	Body   *SetUserSeniorityJSONRequestBody
+}
+
+type SetUserSeniorityResponseObject interface {
+	VisitSetUserSeniorityResponse(w http.ResponseWriter) error
+}
+
+type SetUserSeniority200JSONResponse struct {
+	User User `json:"user"`
+}
+
+func (response SetUserSeniority200JSONResponse) VisitSetUserSeniorityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserSeniority400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetUserSeniority400JSONResponse) VisitSetUserSeniorityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserSeniority404JSONResponse ErrorResponse
+
+func (response SetUserSeniority404JSONResponse) VisitSetUserSeniorityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserSeniority422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response SetUserSeniority422JSONResponse) VisitSetUserSeniorityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserSeniority429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response SetUserSeniority429JSONResponse) VisitSetUserSeniorityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetUserSlackIdRequestObject struct {
+	Params SetUserSlackIdParams
+	Body   *SetUserSlackIdJSONRequestBody
+}
+
+type SetUserSlackIdResponseObject interface {
+	VisitSetUserSlackIdResponse(w http.ResponseWriter) error
+}
+
+type SetUserSlackId200JSONResponse struct {
+	User User `json:"user"`
+}
+
+func (response SetUserSlackId200JSONResponse) VisitSetUserSlackIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserSlackId400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetUserSlackId400JSONResponse) VisitSetUserSlackIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserSlackId403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response SetUserSlackId403JSONResponse) VisitSetUserSlackIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserSlackId404JSONResponse ErrorResponse
+
+func (response SetUserSlackId404JSONResponse) VisitSetUserSlackIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserSlackId422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response SetUserSlackId422JSONResponse) VisitSetUserSlackIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserSlackId429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response SetUserSlackId429JSONResponse) VisitSetUserSlackIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetUserTagsRequestObject struct {
+	Params SetUserTagsParams
+	Body   *SetUserTagsJSONRequestBody
+}
+
+type SetUserTagsResponseObject interface {
+	VisitSetUserTagsResponse(w http.ResponseWriter) error
+}
+
+type SetUserTags200JSONResponse struct {
+	User User `json:"user"`
+}
+
+func (response SetUserTags200JSONResponse) VisitSetUserTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserTags400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetUserTags400JSONResponse) VisitSetUserTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserTags404JSONResponse ErrorResponse
+
+func (response SetUserTags404JSONResponse) VisitSetUserTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserTags422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response SetUserTags422JSONResponse) VisitSetUserTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserTags429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response SetUserTags429JSONResponse) VisitSetUserTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetUserWorkingHoursRequestObject struct {
+	Params SetUserWorkingHoursParams
+	Body   *SetUserWorkingHoursJSONRequestBody
+}
+
+type SetUserWorkingHoursResponseObject interface {
+	VisitSetUserWorkingHoursResponse(w http.ResponseWriter) error
+}
+
+type SetUserWorkingHours200JSONResponse struct {
+	User User `json:"user"`
+}
+
+func (response SetUserWorkingHours200JSONResponse) VisitSetUserWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserWorkingHours400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetUserWorkingHours400JSONResponse) VisitSetUserWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserWorkingHours404JSONResponse ErrorResponse
+
+func (response SetUserWorkingHours404JSONResponse) VisitSetUserWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserWorkingHours422JSONResponse struct {
+	IdempotencyKeyReusedJSONResponse
+}
+
+func (response SetUserWorkingHours422JSONResponse) VisitSetUserWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserWorkingHours429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response SetUserWorkingHours429JSONResponse) VisitSetUserWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Поток событий о ревью пользователя (SSE)
+	// (GET /events/stream)
+	StreamUserEvents(ctx context.Context, request StreamUserEventsRequestObject) (StreamUserEventsResponseObject, error)
+	// Поток событий о ревью команды (SSE)
+	// (GET /events/stream/team)
+	StreamTeamEvents(ctx context.Context, request StreamTeamEventsRequestObject) (StreamTeamEventsResponseObject, error)
+	// Проверка работоспособности
+	// (GET /health)
+	Health(ctx context.Context, request HealthRequestObject) (HealthResponseObject, error)
+	// Выгрузить все команды и участников в CSV или YAML
+	// (GET /org/export)
+	ExportOrg(ctx context.Context, request ExportOrgRequestObject) (ExportOrgResponseObject, error)
+	// Импортировать команды и участников из CSV или YAML
+	// (POST /org/import)
+	ImportOrg(ctx context.Context, request ImportOrgRequestObject) (ImportOrgResponseObject, error)
+	// Создать PR и автоматически назначить до 2 ревьюверов из команды PR (или сколько задано в репозитории)
+	// (POST /pullRequest/create)
+	CreatePullRequest(ctx context.Context, request CreatePullRequestRequestObject) (CreatePullRequestResponseObject, error)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	MergePullRequest(ctx context.Context, request MergePullRequestRequestObject) (MergePullRequestResponseObject, error)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	ReassignReviewer(ctx context.Context, request ReassignReviewerRequestObject) (ReassignReviewerResponseObject, error)
+	// Немедленно применить политики переназначения неактивных ревьюверов
+	// (POST /pullRequest/reassignStale)
+	ReassignStale(ctx context.Context, request ReassignStaleRequestObject) (ReassignStaleResponseObject, error)
+	// История переназначений ревьюверов PR
+	// (GET /pullRequest/reassignments)
+	ListReassignments(ctx context.Context, request ListReassignmentsRequestObject) (ListReassignmentsResponseObject, error)
+	// Отметить первую реакцию ревьювера на PR (останавливает отсчёт SLA)
+	// (POST /pullRequest/respond)
+	RespondToReview(ctx context.Context, request RespondToReviewRequestObject) (RespondToReviewResponseObject, error)
+	// Создать репозиторий команды с политикой назначения ревьюверов
+	// (POST /repositories/create)
+	CreateRepository(ctx context.Context, request CreateRepositoryRequestObject) (CreateRepositoryResponseObject, error)
+	// Удалить репозиторий; его PR сохраняются с политикой по умолчанию
+	// (POST /repositories/delete)
+	DeleteRepository(ctx context.Context, request DeleteRepositoryRequestObject) (DeleteRepositoryResponseObject, error)
+	// Получить репозиторий
+	// (GET /repositories/get)
+	GetRepository(ctx context.Context, request GetRepositoryRequestObject) (GetRepositoryResponseObject, error)
+	// Список репозиториев
+	// (GET /repositories/list)
+	ListRepositories(ctx context.Context, request ListRepositoriesRequestObject) (ListRepositoriesResponseObject, error)
+	// Заменить название, команду-владельца и политику репозитория
+	// (POST /repositories/update)
+	UpdateRepository(ctx context.Context, request UpdateRepositoryRequestObject) (UpdateRepositoryResponseObject, error)
+	// Объяснить, как были бы выбраны ревьюверы для гипотетического PR
+	// (POST /rules/evaluate)
+	EvaluateRules(ctx context.Context, request EvaluateRulesRequestObject) (EvaluateRulesResponseObject, error)
+	// Получить правила назначения команды
+	// (GET /rules/get)
+	GetTeamRules(ctx context.Context, request GetTeamRulesRequestObject) (GetTeamRulesResponseObject, error)
+	// Сохранить правила назначения ревьюверов команды
+	// (POST /rules/set)
+	SetTeamRules(ctx context.Context, request SetTeamRulesRequestObject) (SetTeamRulesResponseObject, error)
+	// Открытые PR, ревьюверы которых нарушают SLA
+	// (GET /sla/breaches)
+	ListSlaBreaches(ctx context.Context, request ListSlaBreachesRequestObject) (ListSlaBreachesResponseObject, error)
+	// Количество открытых PR на каждого ревьювера
+	// (GET /stats/reviewers)
+	GetReviewerStats(ctx context.Context, request GetReviewerStatsRequestObject) (GetReviewerStatsResponseObject, error)
+	// Теги пользователей с количеством носителей
+	// (GET /tags/list)
+	ListTags(ctx context.Context, request ListTagsRequestObject) (ListTagsResponseObject, error)
+	// Удалить команду и её настройки; участники теряют членство в ней, подкоманды становятся самостоятельными
+	// (DELETE /team)
+	DeleteTeam(ctx context.Context, request DeleteTeamRequestObject) (DeleteTeamResponseObject, error)
+	// Переименовать команду вместе с её настройками, участниками и событиями
+	// (PATCH /team)
+	RenameTeam(ctx context.Context, request RenameTeamRequestObject) (RenameTeamResponseObject, error)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	CreateTeam(ctx context.Context, request CreateTeamRequestObject) (CreateTeamResponseObject, error)
+	// Добавить участника в команду (создаёт/обновляет пользователя, прочие его команды сохраняются)
+	// (POST /team/addMember)
+	AddTeamMember(ctx context.Context, request AddTeamMemberRequestObject) (AddTeamMemberResponseObject, error)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeam(ctx context.Context, request GetTeamRequestObject) (GetTeamResponseObject, error)
+	// Получить CODEOWNERS репозитория команды
+	// (GET /team/getCodeowners)
+	GetCodeowners(ctx context.Context, request GetCodeownersRequestObject) (GetCodeownersResponseObject, error)
+	// Получить SLA ревью команды
+	// (GET /team/getSla)
+	GetTeamSla(ctx context.Context, request GetTeamSlaRequestObject) (GetTeamSlaResponseObject, error)
+	// Получить политику переназначения неактивных ревьюверов
+	// (GET /team/getStalePolicy)
+	GetStalePolicy(ctx context.Context, request GetStalePolicyRequestObject) (GetStalePolicyResponseObject, error)
+	// Получить рабочее время команды
+	// (GET /team/getWorkingHours)
+	GetTeamWorkingHours(ctx context.Context, request GetTeamWorkingHoursRequestObject) (GetTeamWorkingHoursResponseObject, error)
+	// Исключить участника из команды; если она была основной, основной становится самая давняя из оставшихся
+	// (POST /team/removeMember)
+	RemoveTeamMember(ctx context.Context, request RemoveTeamMemberRequestObject) (RemoveTeamMemberResponseObject, error)
+	// Зарегистрировать CODEOWNERS для репозитория команды
+	// (POST /team/setCodeowners)
+	SetCodeowners(ctx context.Context, request SetCodeownersRequestObject) (SetCodeownersResponseObject, error)
+	// Настроить участие лидов команды в автоматическом назначении
+	// (POST /team/setLeadAssignment)
+	SetTeamLeadAssignment(ctx context.Context, request SetTeamLeadAssignmentRequestObject) (SetTeamLeadAssignmentResponseObject, error)
+	// Назначить участнику роль в команде
+	// (POST /team/setMemberRole)
+	SetTeamMemberRole(ctx context.Context, request SetTeamMemberRoleRequestObject) (SetTeamMemberRoleResponseObject, error)
+	// Включить команду в отдел или сделать её самостоятельной
+	// (POST /team/setParent)
+	SetTeamParent(ctx context.Context, request SetTeamParentRequestObject) (SetTeamParentResponseObject, error)
+	// Задать SLA ревью для команды
+	// (POST /team/setSla)
+	SetTeamSla(ctx context.Context, request SetTeamSlaRequestObject) (SetTeamSlaResponseObject, error)
+	// Включить автоматическое переназначение неактивных ревьюверов
+	// (POST /team/setStalePolicy)
+	SetStalePolicy(ctx context.Context, request SetStalePolicyRequestObject) (SetStalePolicyResponseObject, error)
+	// Задать рабочее время команды
+	// (POST /team/setWorkingHours)
+	SetTeamWorkingHours(ctx context.Context, request SetTeamWorkingHoursRequestObject) (SetTeamWorkingHoursResponseObject, error)
+	// Получить команду со всеми подкомандами и их численностью
+	// (GET /team/tree)
+	GetTeamTree(ctx context.Context, request GetTeamTreeRequestObject) (GetTeamTreeResponseObject, error)
+	// Добавить теги пользователю
+	// (POST /users/addTags)
+	AddUserTags(ctx context.Context, request AddUserTagsRequestObject) (AddUserTagsResponseObject, error)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUserReviews(ctx context.Context, request GetUserReviewsRequestObject) (GetUserReviewsResponseObject, error)
+	// Массово деактивировать пользователей
+	// (POST /users/massDeactivate)
+	MassDeactivateUsers(ctx context.Context, request MassDeactivateUsersRequestObject) (MassDeactivateUsersResponseObject, error)
+	// Удалить теги пользователя
+	// (POST /users/removeTags)
+	RemoveUserTags(ctx context.Context, request RemoveUserTagsRequestObject) (RemoveUserTagsResponseObject, error)
+	// Задать email для уведомлений о ревью и ежедневного дайджеста
+	// (POST /users/setEmail)
+	SetUserEmail(ctx context.Context, request SetUserEmailRequestObject) (SetUserEmailResponseObject, error)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	SetUserActive(ctx context.Context, request SetUserActiveRequestObject) (SetUserActiveResponseObject, error)
+	// Установить уровень пользователя
+	// (POST /users/setSeniority)
+	SetUserSeniority(ctx context.Context, request SetUserSeniorityRequestObject) (SetUserSeniorityResponseObject, error)
+	// Привязать пользователя к аккаунту Slack для уведомлений о ревью
+	// (POST /users/setSlackId)
+	SetUserSlackId(ctx context.Context, request SetUserSlackIdRequestObject) (SetUserSlackIdResponseObject, error)
+	// Заменить теги пользователя
+	// (POST /users/setTags)
+	SetUserTags(ctx context.Context, request SetUserTagsRequestObject) (SetUserTagsResponseObject, error)
+	// Задать личное рабочее время пользователя
+	// (POST /users/setWorkingHours)
+	SetUserWorkingHours(ctx context.Context, request SetUserWorkingHoursRequestObject) (SetUserWorkingHoursResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// StreamUserEvents operation middleware
+func (sh *strictHandler) StreamUserEvents(w http.ResponseWriter, r *http.Request, params StreamUserEventsParams) {
+	var request StreamUserEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamUserEvents(ctx, request.(StreamUserEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamUserEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StreamUserEventsResponseObject); ok {
+		if err := validResponse.VisitStreamUserEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StreamTeamEvents operation middleware
+func (sh *strictHandler) StreamTeamEvents(w http.ResponseWriter, r *http.Request, params StreamTeamEventsParams) {
+	var request StreamTeamEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamTeamEvents(ctx, request.(StreamTeamEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamTeamEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StreamTeamEventsResponseObject); ok {
+		if err := validResponse.VisitStreamTeamEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Health operation middleware
+func (sh *strictHandler) Health(w http.ResponseWriter, r *http.Request) {
+	var request HealthRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Health(ctx, request.(HealthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Health")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(HealthResponseObject); ok {
+		if err := validResponse.VisitHealthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExportOrg operation middleware
+func (sh *strictHandler) ExportOrg(w http.ResponseWriter, r *http.Request, params ExportOrgParams) {
+	var request ExportOrgRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportOrg(ctx, request.(ExportOrgRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportOrg")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportOrgResponseObject); ok {
+		if err := validResponse.VisitExportOrgResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ImportOrg operation middleware
+func (sh *strictHandler) ImportOrg(w http.ResponseWriter, r *http.Request, params ImportOrgParams) {
+	var request ImportOrgRequestObject
+
+	request.Params = params
+
+	var body ImportOrgJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportOrg(ctx, request.(ImportOrgRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportOrg")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ImportOrgResponseObject); ok {
+		if err := validResponse.VisitImportOrgResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreatePullRequest operation middleware
+func (sh *strictHandler) CreatePullRequest(w http.ResponseWriter, r *http.Request, params CreatePullRequestParams) {
+	var request CreatePullRequestRequestObject
+
+	request.Params = params
+
+	var body CreatePullRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePullRequest(ctx, request.(CreatePullRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePullRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreatePullRequestResponseObject); ok {
+		if err := validResponse.VisitCreatePullRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MergePullRequest operation middleware
+func (sh *strictHandler) MergePullRequest(w http.ResponseWriter, r *http.Request, params MergePullRequestParams) {
+	var request MergePullRequestRequestObject
+
+	request.Params = params
+
+	var body MergePullRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.MergePullRequest(ctx, request.(MergePullRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MergePullRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(MergePullRequestResponseObject); ok {
+		if err := validResponse.VisitMergePullRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReassignReviewer operation middleware
+func (sh *strictHandler) ReassignReviewer(w http.ResponseWriter, r *http.Request, params ReassignReviewerParams) {
+	var request ReassignReviewerRequestObject
+
+	request.Params = params
+
+	var body ReassignReviewerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReassignReviewer(ctx, request.(ReassignReviewerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReassignReviewer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReassignReviewerResponseObject); ok {
+		if err := validResponse.VisitReassignReviewerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReassignStale operation middleware
+func (sh *strictHandler) ReassignStale(w http.ResponseWriter, r *http.Request, params ReassignStaleParams) {
+	var request ReassignStaleRequestObject
+
+	request.Params = params
+
+	var body ReassignStaleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReassignStale(ctx, request.(ReassignStaleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReassignStale")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReassignStaleResponseObject); ok {
+		if err := validResponse.VisitReassignStaleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListReassignments operation middleware
+func (sh *strictHandler) ListReassignments(w http.ResponseWriter, r *http.Request, params ListReassignmentsParams) {
+	var request ListReassignmentsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListReassignments(ctx, request.(ListReassignmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListReassignments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListReassignmentsResponseObject); ok {
+		if err := validResponse.VisitListReassignmentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RespondToReview operation middleware
+func (sh *strictHandler) RespondToReview(w http.ResponseWriter, r *http.Request, params RespondToReviewParams) {
+	var request RespondToReviewRequestObject
+
+	request.Params = params
+
+	var body RespondToReviewJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RespondToReview(ctx, request.(RespondToReviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RespondToReview")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RespondToReviewResponseObject); ok {
+		if err := validResponse.VisitRespondToReviewResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateRepository operation middleware
+func (sh *strictHandler) CreateRepository(w http.ResponseWriter, r *http.Request, params CreateRepositoryParams) {
+	var request CreateRepositoryRequestObject
+
+	request.Params = params
+
+	var body CreateRepositoryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateRepository(ctx, request.(CreateRepositoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateRepository")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateRepositoryResponseObject); ok {
+		if err := validResponse.VisitCreateRepositoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteRepository operation middleware
+func (sh *strictHandler) DeleteRepository(w http.ResponseWriter, r *http.Request, params DeleteRepositoryParams) {
+	var request DeleteRepositoryRequestObject
+
+	request.Params = params
+
+	var body DeleteRepositoryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteRepository(ctx, request.(DeleteRepositoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteRepository")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteRepositoryResponseObject); ok {
+		if err := validResponse.VisitDeleteRepositoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRepository operation middleware
+func (sh *strictHandler) GetRepository(w http.ResponseWriter, r *http.Request, params GetRepositoryParams) {
+	var request GetRepositoryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRepository(ctx, request.(GetRepositoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRepository")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRepositoryResponseObject); ok {
+		if err := validResponse.VisitGetRepositoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListRepositories operation middleware
+func (sh *strictHandler) ListRepositories(w http.ResponseWriter, r *http.Request, params ListRepositoriesParams) {
+	var request ListRepositoriesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRepositories(ctx, request.(ListRepositoriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRepositories")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListRepositoriesResponseObject); ok {
+		if err := validResponse.VisitListRepositoriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateRepository operation middleware
+func (sh *strictHandler) UpdateRepository(w http.ResponseWriter, r *http.Request, params UpdateRepositoryParams) {
+	var request UpdateRepositoryRequestObject
+
+	request.Params = params
+
+	var body UpdateRepositoryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateRepository(ctx, request.(UpdateRepositoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateRepository")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateRepositoryResponseObject); ok {
+		if err := validResponse.VisitUpdateRepositoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// EvaluateRules operation middleware
+func (sh *strictHandler) EvaluateRules(w http.ResponseWriter, r *http.Request) {
+	var request EvaluateRulesRequestObject
+
+	var body EvaluateRulesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.EvaluateRules(ctx, request.(EvaluateRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EvaluateRules")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(EvaluateRulesResponseObject); ok {
+		if err := validResponse.VisitEvaluateRulesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamRules operation middleware
+func (sh *strictHandler) GetTeamRules(w http.ResponseWriter, r *http.Request, params GetTeamRulesParams) {
+	var request GetTeamRulesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamRules(ctx, request.(GetTeamRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamRules")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamRulesResponseObject); ok {
+		if err := validResponse.VisitGetTeamRulesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetTeamRules operation middleware
+func (sh *strictHandler) SetTeamRules(w http.ResponseWriter, r *http.Request, params SetTeamRulesParams) {
+	var request SetTeamRulesRequestObject
+
+	request.Params = params
+
+	var body SetTeamRulesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetTeamRules(ctx, request.(SetTeamRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetTeamRules")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetTeamRulesResponseObject); ok {
+		if err := validResponse.VisitSetTeamRulesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListSlaBreaches operation middleware
+func (sh *strictHandler) ListSlaBreaches(w http.ResponseWriter, r *http.Request, params ListSlaBreachesParams) {
+	var request ListSlaBreachesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListSlaBreaches(ctx, request.(ListSlaBreachesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListSlaBreaches")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListSlaBreachesResponseObject); ok {
+		if err := validResponse.VisitListSlaBreachesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetReviewerStats operation middleware
+func (sh *strictHandler) GetReviewerStats(w http.ResponseWriter, r *http.Request, params GetReviewerStatsParams) {
+	var request GetReviewerStatsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReviewerStats(ctx, request.(GetReviewerStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReviewerStats")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetReviewerStatsResponseObject); ok {
+		if err := validResponse.VisitGetReviewerStatsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListTags operation middleware
+func (sh *strictHandler) ListTags(w http.ResponseWriter, r *http.Request, params ListTagsParams) {
+	var request ListTagsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTags(ctx, request.(ListTagsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTags")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTagsResponseObject); ok {
+		if err := validResponse.VisitListTagsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteTeam operation middleware
+func (sh *strictHandler) DeleteTeam(w http.ResponseWriter, r *http.Request, params DeleteTeamParams) {
+	var request DeleteTeamRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTeam(ctx, request.(DeleteTeamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTeam")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteTeamResponseObject); ok {
+		if err := validResponse.VisitDeleteTeamResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RenameTeam operation middleware
+func (sh *strictHandler) RenameTeam(w http.ResponseWriter, r *http.Request, params RenameTeamParams) {
+	var request RenameTeamRequestObject
+
+	request.Params = params
+
+	var body RenameTeamJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RenameTeam(ctx, request.(RenameTeamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RenameTeam")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RenameTeamResponseObject); ok {
+		if err := validResponse.VisitRenameTeamResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateTeam operation middleware
+func (sh *strictHandler) CreateTeam(w http.ResponseWriter, r *http.Request, params CreateTeamParams) {
+	var request CreateTeamRequestObject
+
+	request.Params = params
+
+	var body CreateTeamJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateTeam(ctx, request.(CreateTeamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateTeam")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateTeamResponseObject); ok {
+		if err := validResponse.VisitCreateTeamResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddTeamMember operation middleware
+func (sh *strictHandler) AddTeamMember(w http.ResponseWriter, r *http.Request, params AddTeamMemberParams) {
+	var request AddTeamMemberRequestObject
+
+	request.Params = params
+
+	var body AddTeamMemberJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddTeamMember(ctx, request.(AddTeamMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddTeamMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddTeamMemberResponseObject); ok {
+		if err := validResponse.VisitAddTeamMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeam operation middleware
+func (sh *strictHandler) GetTeam(w http.ResponseWriter, r *http.Request, params GetTeamParams) {
+	var request GetTeamRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeam(ctx, request.(GetTeamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeam")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamResponseObject); ok {
+		if err := validResponse.VisitGetTeamResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCodeowners operation middleware
+func (sh *strictHandler) GetCodeowners(w http.ResponseWriter, r *http.Request, params GetCodeownersParams) {
+	var request GetCodeownersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCodeowners(ctx, request.(GetCodeownersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCodeowners")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCodeownersResponseObject); ok {
+		if err := validResponse.VisitGetCodeownersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamSla operation middleware
+func (sh *strictHandler) GetTeamSla(w http.ResponseWriter, r *http.Request, params GetTeamSlaParams) {
+	var request GetTeamSlaRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamSla(ctx, request.(GetTeamSlaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamSla")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamSlaResponseObject); ok {
+		if err := validResponse.VisitGetTeamSlaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStalePolicy operation middleware
+func (sh *strictHandler) GetStalePolicy(w http.ResponseWriter, r *http.Request, params GetStalePolicyParams) {
+	var request GetStalePolicyRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStalePolicy(ctx, request.(GetStalePolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStalePolicy")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStalePolicyResponseObject); ok {
+		if err := validResponse.VisitGetStalePolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamWorkingHours operation middleware
+func (sh *strictHandler) GetTeamWorkingHours(w http.ResponseWriter, r *http.Request, params GetTeamWorkingHoursParams) {
+	var request GetTeamWorkingHoursRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamWorkingHours(ctx, request.(GetTeamWorkingHoursRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamWorkingHours")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamWorkingHoursResponseObject); ok {
+		if err := validResponse.VisitGetTeamWorkingHoursResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RemoveTeamMember operation middleware
+func (sh *strictHandler) RemoveTeamMember(w http.ResponseWriter, r *http.Request, params RemoveTeamMemberParams) {
+	var request RemoveTeamMemberRequestObject
+
+	request.Params = params
+
+	var body RemoveTeamMemberJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveTeamMember(ctx, request.(RemoveTeamMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveTeamMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RemoveTeamMemberResponseObject); ok {
+		if err := validResponse.VisitRemoveTeamMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetCodeowners operation middleware
+func (sh *strictHandler) SetCodeowners(w http.ResponseWriter, r *http.Request, params SetCodeownersParams) {
+	var request SetCodeownersRequestObject
+
+	request.Params = params
+
+	var body SetCodeownersJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetCodeowners(ctx, request.(SetCodeownersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetCodeowners")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetCodeownersResponseObject); ok {
+		if err := validResponse.VisitSetCodeownersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetTeamLeadAssignment operation middleware
+func (sh *strictHandler) SetTeamLeadAssignment(w http.ResponseWriter, r *http.Request, params SetTeamLeadAssignmentParams) {
+	var request SetTeamLeadAssignmentRequestObject
+
+	request.Params = params
+
+	var body SetTeamLeadAssignmentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetTeamLeadAssignment(ctx, request.(SetTeamLeadAssignmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetTeamLeadAssignment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetTeamLeadAssignmentResponseObject); ok {
+		if err := validResponse.VisitSetTeamLeadAssignmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetTeamMemberRole operation middleware
+func (sh *strictHandler) SetTeamMemberRole(w http.ResponseWriter, r *http.Request, params SetTeamMemberRoleParams) {
+	var request SetTeamMemberRoleRequestObject
+
+	request.Params = params
+
+	var body SetTeamMemberRoleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetTeamMemberRole(ctx, request.(SetTeamMemberRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetTeamMemberRole")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetTeamMemberRoleResponseObject); ok {
+		if err := validResponse.VisitSetTeamMemberRoleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetTeamParent operation middleware
+func (sh *strictHandler) SetTeamParent(w http.ResponseWriter, r *http.Request, params SetTeamParentParams) {
+	var request SetTeamParentRequestObject
+
+	request.Params = params
+
+	var body SetTeamParentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetTeamParent(ctx, request.(SetTeamParentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetTeamParent")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetTeamParentResponseObject); ok {
+		if err := validResponse.VisitSetTeamParentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetTeamSla operation middleware
+func (sh *strictHandler) SetTeamSla(w http.ResponseWriter, r *http.Request, params SetTeamSlaParams) {
+	var request SetTeamSlaRequestObject
+
+	request.Params = params
+
+	var body SetTeamSlaJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetTeamSla(ctx, request.(SetTeamSlaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetTeamSla")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetTeamSlaResponseObject); ok {
+		if err := validResponse.VisitSetTeamSlaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetStalePolicy operation middleware
+func (sh *strictHandler) SetStalePolicy(w http.ResponseWriter, r *http.Request, params SetStalePolicyParams) {
+	var request SetStalePolicyRequestObject
+
+	request.Params = params
+
+	var body SetStalePolicyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetStalePolicy(ctx, request.(SetStalePolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetStalePolicy")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetStalePolicyResponseObject); ok {
+		if err := validResponse.VisitSetStalePolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetTeamWorkingHours operation middleware
+func (sh *strictHandler) SetTeamWorkingHours(w http.ResponseWriter, r *http.Request, params SetTeamWorkingHoursParams) {
+	var request SetTeamWorkingHoursRequestObject
+
+	request.Params = params
+
+	var body SetTeamWorkingHoursJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetTeamWorkingHours(ctx, request.(SetTeamWorkingHoursRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetTeamWorkingHours")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetTeamWorkingHoursResponseObject); ok {
+		if err := validResponse.VisitSetTeamWorkingHoursResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamTree operation middleware
+func (sh *strictHandler) GetTeamTree(w http.ResponseWriter, r *http.Request, params GetTeamTreeParams) {
+	var request GetTeamTreeRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamTree(ctx, request.(GetTeamTreeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamTree")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamTreeResponseObject); ok {
+		if err := validResponse.VisitGetTeamTreeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddUserTags operation middleware
+func (sh *strictHandler) AddUserTags(w http.ResponseWriter, r *http.Request, params AddUserTagsParams) {
+	var request AddUserTagsRequestObject
+
+	request.Params = params
+
+	var body AddUserTagsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddUserTags(ctx, request.(AddUserTagsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddUserTags")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddUserTagsResponseObject); ok {
+		if err := validResponse.VisitAddUserTagsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUserReviews operation middleware
+func (sh *strictHandler) GetUserReviews(w http.ResponseWriter, r *http.Request, params GetUserReviewsParams) {
+	var request GetUserReviewsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserReviews(ctx, request.(GetUserReviewsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserReviews")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUserReviewsResponseObject); ok {
+		if err := validResponse.VisitGetUserReviewsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MassDeactivateUsers operation middleware
+func (sh *strictHandler) MassDeactivateUsers(w http.ResponseWriter, r *http.Request, params MassDeactivateUsersParams) {
+	var request MassDeactivateUsersRequestObject
+
+	request.Params = params
+
+	var body MassDeactivateUsersJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.MassDeactivateUsers(ctx, request.(MassDeactivateUsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MassDeactivateUsers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(MassDeactivateUsersResponseObject); ok {
+		if err := validResponse.VisitMassDeactivateUsersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RemoveUserTags operation middleware
+func (sh *strictHandler) RemoveUserTags(w http.ResponseWriter, r *http.Request, params RemoveUserTagsParams) {
+	var request RemoveUserTagsRequestObject
+
+	request.Params = params
+
+	var body RemoveUserTagsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveUserTags(ctx, request.(RemoveUserTagsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveUserTags")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RemoveUserTagsResponseObject); ok {
+		if err := validResponse.VisitRemoveUserTagsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetUserEmail operation middleware
+func (sh *strictHandler) SetUserEmail(w http.ResponseWriter, r *http.Request, params SetUserEmailParams) {
+	var request SetUserEmailRequestObject
+
+	request.Params = params
+
+	var body SetUserEmailJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetUserEmail(ctx, request.(SetUserEmailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetUserEmail")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetUserEmailResponseObject); ok {
+		if err := validResponse.VisitSetUserEmailResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetUserActive operation middleware
+func (sh *strictHandler) SetUserActive(w http.ResponseWriter, r *http.Request, params SetUserActiveParams) {
+	var request SetUserActiveRequestObject
+
+	request.Params = params
+
+	var body SetUserActiveJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetUserActive(ctx, request.(SetUserActiveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetUserActive")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetUserActiveResponseObject); ok {
+		if err := validResponse.VisitSetUserActiveResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetUserSeniority operation middleware
+func (sh *strictHandler) SetUserSeniority(w http.ResponseWriter, r *http.Request, params SetUserSeniorityParams) {
+	var request SetUserSeniorityRequestObject
+
+	request.Params = params
+
+	var body SetUserSeniorityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetUserSeniority(ctx, request.(SetUserSeniorityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetUserSeniority")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetUserSeniorityResponseObject); ok {
+		if err := validResponse.VisitSetUserSeniorityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetUserSlackId operation middleware
+func (sh *strictHandler) SetUserSlackId(w http.ResponseWriter, r *http.Request, params SetUserSlackIdParams) {
+	var request SetUserSlackIdRequestObject
+
+	request.Params = params
+
+	var body SetUserSlackIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetUserSlackId(ctx, request.(SetUserSlackIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetUserSlackId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetUserSlackIdResponseObject); ok {
+		if err := validResponse.VisitSetUserSlackIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetUserTags operation middleware
+func (sh *strictHandler) SetUserTags(w http.ResponseWriter, r *http.Request, params SetUserTagsParams) {
+	var request SetUserTagsRequestObject
+
+	request.Params = params
+
+	var body SetUserTagsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetUserTags(ctx, request.(SetUserTagsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetUserTags")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetUserTagsResponseObject); ok {
+		if err := validResponse.VisitSetUserTagsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetUserWorkingHours operation middleware
+func (sh *strictHandler) SetUserWorkingHours(w http.ResponseWriter, r *http.Request, params SetUserWorkingHoursParams) {
+	var request SetUserWorkingHoursRequestObject
+
+	request.Params = params
+
+	var body SetUserWorkingHoursJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetUserWorkingHours(ctx, request.(SetUserWorkingHoursRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetUserWorkingHours")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetUserWorkingHoursResponseObject); ok {
+		if err := validResponse.VisitSetUserWorkingHoursResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
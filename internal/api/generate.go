@@ -0,0 +1,4 @@
+// Package api contains the HTTP server interface and models generated from openapi.yaml.
+package api
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.4.1 --config=../../oapi-codegen.yaml ../../openapi.yaml
@@ -0,0 +1,118 @@
+package businesstime_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"avito-pr-reviewer/internal/businesstime"
+	"avito-pr-reviewer/internal/model"
+)
+
+const ical = `BEGIN:VCALENDAR
+VERSION:2.0
+BEGIN:VEVENT
+DTSTART;VALUE=DATE:20251103
+DTEND;VALUE=DATE:20251105
+SUMMARY:Long
+  weekend
+END:VEVENT
+BEGIN:VEVENT
+DTSTART;VALUE=DATE:20251231
+SUMMARY:New Year's Eve
+END:VEVENT
+END:VCALENDAR
+`
+
+func mustSchedule(t *testing.T, wh model.WorkingHours) businesstime.Schedule {
+	t.Helper()
+	s, err := businesstime.Parse(wh)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestParseICal(t *testing.T) {
+	cal, err := businesstime.ParseICal(strings.NewReader(ical))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cal.Len() != 3 {
+		t.Fatalf("expected 3 holidays, got %d", cal.Len())
+	}
+	for _, d := range []string{"2025-11-03", "2025-11-04", "2025-12-31"} {
+		day, _ := time.Parse(time.DateOnly, d)
+		if !cal.IsHoliday(day) {
+			t.Errorf("%s should be a holiday", d)
+		}
+	}
+	day, _ := time.Parse(time.DateOnly, "2025-11-05")
+	if cal.IsHoliday(day) {
+		t.Error("DTEND is exclusive")
+	}
+}
+
+func TestParse(t *testing.T) {
+	for _, wh := range []model.WorkingHours{
+		{Timezone: "Mars/Olympus", Start: "09:00", End: "18:00", Days: []string{"mon"}},
+		{Timezone: "UTC", Start: "18:00", End: "09:00", Days: []string{"mon"}},
+		{Timezone: "UTC", Start: "9am", End: "18:00", Days: []string{"mon"}},
+		{Timezone: "UTC", Start: "09:00", End: "18:00", Days: []string{"funday"}},
+		{Timezone: "UTC", Start: "09:00", End: "18:00"},
+	} {
+		if _, err := businesstime.Parse(wh); err == nil {
+			t.Errorf("expected error for %+v", wh)
+		}
+	}
+}
+
+func TestCalculator(t *testing.T) {
+	cal, err := businesstime.ParseICal(strings.NewReader(ical))
+	if err != nil {
+		t.Fatal(err)
+	}
+	calc := businesstime.NewCalculator(cal)
+	moscow := mustSchedule(t, model.WorkingHours{
+		Timezone: "Europe/Moscow",
+		Start:    "10:00",
+		End:      "19:00",
+		Days:     []string{"mon", "tue", "wed", "thu", "fri"},
+	})
+	msk := moscow.Location
+
+	// Friday 17:00 + 24 working hours: 2h on Friday, Monday and Tuesday are
+	// holidays, 9h on Wed, 9h on Thu, 4h on Fri.
+	start := time.Date(2025, 10, 31, 17, 0, 0, 0, msk)
+	want := time.Date(2025, 11, 7, 14, 0, 0, 0, msk)
+	if got := calc.Add(start, 24*time.Hour, moscow); !got.Equal(want) {
+		t.Fatalf("Add: want %s, got %s", want, got.In(msk))
+	}
+	if got := calc.Between(start, want, moscow); got != 24*time.Hour {
+		t.Fatalf("Between: want 24h, got %s", got)
+	}
+	if got := calc.WorkingDays(start, want, moscow); got != 2 {
+		t.Fatalf("WorkingDays: want 2, got %d", got)
+	}
+
+	// Saturday night counts from Monday morning.
+	sat := time.Date(2025, 10, 25, 23, 0, 0, 0, msk)
+	if got, want := calc.Add(sat, time.Hour, moscow), time.Date(2025, 10, 27, 11, 0, 0, 0, msk); !got.Equal(want) {
+		t.Fatalf("Add from weekend: want %s, got %s", want, got.In(msk))
+	}
+
+	for _, c := range []struct {
+		at   time.Time
+		want bool
+	}{
+		{time.Date(2025, 10, 27, 10, 0, 0, 0, msk), true},
+		{time.Date(2025, 10, 27, 19, 0, 0, 0, msk), false},
+		{time.Date(2025, 10, 27, 7, 30, 0, 0, time.UTC), true}, // 10:30 in Moscow
+		{time.Date(2025, 10, 25, 12, 0, 0, 0, msk), false},     // Saturday
+		{time.Date(2025, 11, 3, 12, 0, 0, 0, msk), false},      // holiday
+	} {
+		if got := calc.InWorkingHours(c.at, moscow); got != c.want {
+			t.Errorf("InWorkingHours(%s) = %v, want %v", c.at, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package businesstime
+
+import "time"
+
+// maxDays bounds every search so a schedule without working time cannot loop forever.
+const maxDays = 3660
+
+// Calculator does working-time arithmetic for schedules, skipping holidays.
+type Calculator struct {
+	Calendar *Calendar
+}
+
+func NewCalculator(cal *Calendar) *Calculator {
+	return &Calculator{Calendar: cal}
+}
+
+// IsWorkingDay reports whether the local date of t is a working day.
+func (c *Calculator) IsWorkingDay(t time.Time, s Schedule) bool {
+	local := t.In(s.Location)
+	return s.Days[local.Weekday()] && !c.Calendar.IsHoliday(local)
+}
+
+// InWorkingHours reports whether t falls inside working time.
+func (c *Calculator) InWorkingHours(t time.Time, s Schedule) bool {
+	if !c.IsWorkingDay(t, s) {
+		return false
+	}
+	start, end := s.window(t)
+	return !t.Before(start) && t.Before(end)
+}
+
+// Add returns the moment d of working time after t.
+func (c *Calculator) Add(t time.Time, d time.Duration, s Schedule) time.Time {
+	cursor := t
+	for i := 0; i < maxDays; i++ {
+		if c.IsWorkingDay(cursor, s) {
+			start, end := s.window(cursor)
+			if cursor.Before(start) {
+				cursor = start
+			}
+			if cursor.Before(end) {
+				avail := end.Sub(cursor)
+				if d <= avail {
+					return cursor.Add(d)
+				}
+				d -= avail
+			}
+		}
+		cursor = s.nextDay(cursor)
+	}
+	// No working time in sight: fall back to wall-clock time.
+	return t.Add(d)
+}
+
+// Between returns the working time elapsed from from to to.
+func (c *Calculator) Between(from, to time.Time, s Schedule) time.Duration {
+	var total time.Duration
+	cursor := from
+	for i := 0; i < maxDays && cursor.Before(to); i++ {
+		if c.IsWorkingDay(cursor, s) {
+			start, end := s.window(cursor)
+			if cursor.After(start) {
+				start = cursor
+			}
+			if to.Before(end) {
+				end = to
+			}
+			if end.After(start) {
+				total += end.Sub(start)
+			}
+		}
+		cursor = s.nextDay(cursor)
+	}
+	return total
+}
+
+// WorkingDays returns how many full working days fit into the working time
+// between from and to.
+func (c *Calculator) WorkingDays(from, to time.Time, s Schedule) int {
+	return int(c.Between(from, to, s) / (s.End - s.Start))
+}
@@ -0,0 +1,130 @@
+package businesstime
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// Calendar holds public holidays. A nil Calendar has none.
+type Calendar struct {
+	holidays map[string]string
+}
+
+func NewCalendar() *Calendar {
+	return &Calendar{holidays: make(map[string]string)}
+}
+
+// Add marks the local date of day as a holiday.
+func (c *Calendar) Add(day time.Time, name string) {
+	c.holidays[day.Format(time.DateOnly)] = name
+}
+
+// IsHoliday reports whether the date of t, in t's location, is a holiday.
+func (c *Calendar) IsHoliday(t time.Time) bool {
+	if c == nil {
+		return false
+	}
+	_, ok := c.holidays[t.Format(time.DateOnly)]
+	return ok
+}
+
+func (c *Calendar) Len() int {
+	if c == nil {
+		return 0
+	}
+	return len(c.holidays)
+}
+
+// LoadICal reads holidays from an iCalendar (.ics) file.
+func LoadICal(path string) (*Calendar, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseICal(f)
+}
+
+// ParseICal reads all-day VEVENTs as holidays. An event covers DTSTART up to,
+// but not including, DTEND; without DTEND it lasts one day.
+func ParseICal(r io.Reader) (*Calendar, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	c := NewCalendar()
+	var inEvent bool
+	var start, end time.Time
+	var summary string
+	for i, line := range lines {
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		name, _, _ = strings.Cut(name, ";")
+		switch strings.ToUpper(name) {
+		case "BEGIN":
+			if strings.EqualFold(value, "VEVENT") {
+				inEvent, start, end, summary = true, time.Time{}, time.Time{}, ""
+			}
+		case "DTSTART", "DTEND":
+			if !inEvent {
+				continue
+			}
+			day, err := parseICalDate(value)
+			if err != nil {
+				return nil, fmt.Errorf("ical line %d: %w", i+1, err)
+			}
+			if strings.EqualFold(name, "DTSTART") {
+				start = day
+			} else {
+				end = day
+			}
+		case "SUMMARY":
+			summary = value
+		case "END":
+			if !strings.EqualFold(value, "VEVENT") || !inEvent {
+				continue
+			}
+			inEvent = false
+			if start.IsZero() {
+				continue
+			}
+			if end.IsZero() || !end.After(start) {
+				end = start.AddDate(0, 0, 1)
+			}
+			for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+				c.Add(d, summary)
+			}
+		}
+	}
+	return c, nil
+}
+
+// unfold joins continuation lines, which start with a space or a tab.
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimRight(sc.Text(), "\r")
+		if len(line) > 0 && (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, sc.Err()
+}
+
+// parseICalDate accepts DATE (20250101) and DATE-TIME values; only the date is kept.
+func parseICalDate(v string) (time.Time, error) {
+	if len(v) < 8 {
+		return time.Time{}, fmt.Errorf("invalid date %q", v)
+	}
+	return time.Parse("20060102", v[:8])
+}
@@ -0,0 +1,108 @@
+// Package businesstime does date arithmetic that only counts working hours:
+// weekdays in a schedule, inside its daily window and outside holidays.
+package businesstime
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"avito-pr-reviewer/internal/model"
+)
+
+// Schedule is a weekly working window in a time zone.
+type Schedule struct {
+	Location *time.Location
+	// Start and End are offsets from local midnight.
+	Start, End time.Duration
+	Days       [7]bool
+}
+
+// Default is 09:00-18:00 UTC, Monday to Friday.
+var Default = Schedule{
+	Location: time.UTC,
+	Start:    9 * time.Hour,
+	End:      18 * time.Hour,
+	Days:     [7]bool{time.Monday: true, time.Tuesday: true, time.Wednesday: true, time.Thursday: true, time.Friday: true},
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// Parse builds a schedule from a time zone name, "HH:MM" bounds and day
+// abbreviations (mon, tue, ...).
+func Parse(wh model.WorkingHours) (Schedule, error) {
+	loc, err := time.LoadLocation(wh.Timezone)
+	if err != nil {
+		return Schedule{}, fmt.Errorf("%w: timezone %q", model.ErrInvalidWorkingHours, wh.Timezone)
+	}
+	start, err := parseClock(wh.Start)
+	if err != nil {
+		return Schedule{}, err
+	}
+	end, err := parseClock(wh.End)
+	if err != nil {
+		return Schedule{}, err
+	}
+	if start >= end {
+		return Schedule{}, fmt.Errorf("%w: start must be before end", model.ErrInvalidWorkingHours)
+	}
+
+	s := Schedule{Location: loc, Start: start, End: end}
+	for _, d := range wh.Days {
+		wd, ok := weekdays[strings.ToLower(d)]
+		if !ok {
+			return Schedule{}, fmt.Errorf("%w: day %q", model.ErrInvalidWorkingHours, d)
+		}
+		s.Days[wd] = true
+	}
+	if s.Days == [7]bool{} {
+		return Schedule{}, fmt.Errorf("%w: no working days", model.ErrInvalidWorkingHours)
+	}
+	return s, nil
+}
+
+// ParseDays splits a comma separated list such as "mon,tue,wed".
+func ParseDays(s string) []string {
+	var res []string
+	for _, d := range strings.Split(s, ",") {
+		if d = strings.TrimSpace(d); d != "" {
+			res = append(res, d)
+		}
+	}
+	return res
+}
+
+func parseClock(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		if s == "24:00" {
+			return 24 * time.Hour, nil
+		}
+		return 0, fmt.Errorf("%w: time %q, want HH:MM", model.ErrInvalidWorkingHours, s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// window returns the working window of the local day containing t.
+func (s Schedule) window(t time.Time) (start, end time.Time) {
+	y, m, d := t.In(s.Location).Date()
+	return s.at(y, m, d, s.Start), s.at(y, m, d, s.End)
+}
+
+func (s Schedule) at(y int, m time.Month, d int, offset time.Duration) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, s.Location).Add(offset)
+}
+
+// nextDay returns local midnight of the day after t.
+func (s Schedule) nextDay(t time.Time) time.Time {
+	y, m, d := t.In(s.Location).Date()
+	return time.Date(y, m, d+1, 0, 0, 0, 0, s.Location)
+}
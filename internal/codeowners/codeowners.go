@@ -0,0 +1,137 @@
+// Package codeowners parses CODEOWNERS files and finds the owners of a path.
+package codeowners
+
+import (
+	"bufio"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"avito-pr-reviewer/internal/model"
+)
+
+// Rule assigns Owners to the paths matching a gitignore-style Pattern. A rule
+// without owners removes ownership set by earlier rules.
+type Rule struct {
+	Pattern string
+	Owners  []string
+	re      *regexp.Regexp
+}
+
+type File struct {
+	Rules []Rule
+}
+
+// Parse reads a CODEOWNERS file. Owners are @user, @org/team or an email address.
+func Parse(content string) (*File, error) {
+	f := &File{}
+	sc := bufio.NewScanner(strings.NewReader(content))
+	for n := 1; sc.Scan(); n++ {
+		line := sc.Text()
+		if i := strings.Index(line, " #"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		pattern, owners := fields[0], fields[1:]
+		if len(owners) == 0 {
+			owners = nil
+		}
+		if strings.HasPrefix(pattern, "!") || strings.ContainsAny(pattern, "[]") {
+			return nil, fmt.Errorf("%w: line %d: unsupported pattern %q", model.ErrInvalidCodeowners, n, pattern)
+		}
+		for _, o := range owners {
+			if !validOwner(o) {
+				return nil, fmt.Errorf("%w: line %d: invalid owner %q", model.ErrInvalidCodeowners, n, o)
+			}
+		}
+		re, err := compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", model.ErrInvalidCodeowners, n, err)
+		}
+		f.Rules = append(f.Rules, Rule{Pattern: pattern, Owners: owners, re: re})
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Owners returns the owners of path according to the last matching rule.
+func (f *File) Owners(path string) []string {
+	path = strings.TrimPrefix(path, "/")
+	for i := len(f.Rules) - 1; i >= 0; i-- {
+		if f.Rules[i].re.MatchString(path) {
+			return f.Rules[i].Owners
+		}
+	}
+	return nil
+}
+
+func validOwner(o string) bool {
+	if strings.HasPrefix(o, "@") {
+		name := strings.TrimPrefix(o, "@")
+		return name != "" && !strings.HasPrefix(name, "/") && !strings.HasSuffix(name, "/")
+	}
+	at := strings.Index(o, "@")
+	return at > 0 && at < len(o)-1
+}
+
+// compile turns a gitignore-style pattern into a regexp over slash-separated
+// paths. Patterns without a slash match at any depth, and a pattern matching
+// a directory matches everything below it.
+func compile(pattern string) (*regexp.Regexp, error) {
+	dirOnly := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+	anchored := strings.Contains(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored {
+		b.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(pattern[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case c == '*':
+			b.WriteString("[^/]*")
+		case c == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	if dirOnly {
+		b.WriteString("/.*$")
+	} else {
+		b.WriteString("(?:/.*)?$")
+	}
+	return regexp.Compile(b.String())
+}
+
+// Owner is a parsed CODEOWNERS owner: a user handle or email in User, or the
+// team name of an @org/team owner in Team.
+type Owner struct {
+	User string
+	Team string
+}
+
+func ParseOwner(o string) Owner {
+	if !strings.HasPrefix(o, "@") {
+		return Owner{User: o}
+	}
+	name := strings.TrimPrefix(o, "@")
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		return Owner{Team: name[i+1:]}
+	}
+	return Owner{User: name}
+}
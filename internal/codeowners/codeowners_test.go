@@ -0,0 +1,83 @@
+package codeowners
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"avito-pr-reviewer/internal/model"
+)
+
+const sample = `# Default owners
+*                   @alice
+*.go                @bob @acme/backend
+/docs/              docs@example.com
+internal/billing/** @carol # payments
+**/testdata         @dave
+/vendor/
+`
+
+func TestOwners(t *testing.T) {
+	f, err := Parse(sample)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{"README.md", []string{"@alice"}},
+		{"cmd/server/main.go", []string{"@bob", "@acme/backend"}},
+		{"/main.go", []string{"@bob", "@acme/backend"}},
+		{"docs/setup.md", []string{"docs@example.com"}},
+		{"api/docs/setup.md", []string{"@alice"}},
+		{"internal/billing/invoice/pay.go", []string{"@carol"}},
+		{"internal/service/testdata/a.json", []string{"@dave"}},
+		{"testdata/a.json", []string{"@dave"}},
+		{"vendor/lib/x.go", nil},
+	}
+	for _, tt := range tests {
+		if got := f.Owners(tt.path); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Owners(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestStar(t *testing.T) {
+	f, err := Parse("docs/*.md @alice\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := f.Owners("docs/a.md"); len(got) != 1 {
+		t.Errorf("docs/a.md: got %v", got)
+	}
+	if got := f.Owners("docs/sub/a.md"); got != nil {
+		t.Errorf("* must not cross directories, got %v", got)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, content := range []string{
+		"!*.go @alice",
+		"*.[ch] @alice",
+		"*.go alice",
+		"*.go @",
+	} {
+		if _, err := Parse(content); !errors.Is(err, model.ErrInvalidCodeowners) {
+			t.Errorf("Parse(%q): expected ErrInvalidCodeowners, got %v", content, err)
+		}
+	}
+}
+
+func TestParseOwner(t *testing.T) {
+	tests := map[string]Owner{
+		"@alice":            {User: "alice"},
+		"@acme/backend":     {Team: "backend"},
+		"alice@example.com": {User: "alice@example.com"},
+	}
+	for in, want := range tests {
+		if got := ParseOwner(in); got != want {
+			t.Errorf("ParseOwner(%q) = %+v, want %+v", in, got, want)
+		}
+	}
+}
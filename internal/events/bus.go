@@ -0,0 +1,126 @@
+package events
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"avito-pr-reviewer/internal/model"
+	"avito-pr-reviewer/internal/store"
+)
+
+const (
+	subscriptionBuffer = 64
+	reconnectDelay     = 2 * time.Second
+)
+
+// Subscription receives events matching its filter. C is closed when the
+// subscriber falls too far behind; it should reconnect and resume from the
+// last event it saw.
+type Subscription struct {
+	C      chan model.Event
+	filter func(model.Event) bool
+}
+
+// Bus fans out events to local subscribers. Events reach it through Postgres
+// LISTEN/NOTIFY, so every replica sees events committed by any other one.
+type Bus struct {
+	mu   sync.Mutex
+	subs map[*Subscription]struct{}
+}
+
+func NewBus() *Bus {
+	return &Bus{subs: make(map[*Subscription]struct{})}
+}
+
+func (b *Bus) Subscribe(filter func(model.Event) bool) *Subscription {
+	sub := &Subscription{C: make(chan model.Event, subscriptionBuffer), filter: filter}
+	b.mu.Lock()
+	b.subs[sub] = struct{}{}
+	b.mu.Unlock()
+	return sub
+}
+
+func (b *Bus) Unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.subs[sub]; ok {
+		delete(b.subs, sub)
+		close(sub.C)
+	}
+}
+
+func (b *Bus) Dispatch(e model.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.subs {
+		if !sub.filter(e) {
+			continue
+		}
+		select {
+		case sub.C <- e:
+		default:
+			delete(b.subs, sub)
+			close(sub.C)
+		}
+	}
+}
+
+// Run listens for new events in src and dispatches them until ctx is done,
+// reconnecting whenever the listening connection is lost.
+func (b *Bus) Run(ctx context.Context, src store.EventSource) {
+	for {
+		err := src.ListenEvents(ctx, func(id int64) {
+			e, err := src.GetEvent(ctx, id)
+			if err != nil {
+				log.Printf("events: load event %d: %v", id, err)
+				return
+			}
+			b.Dispatch(*e)
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("events: listener stopped: %v; reconnecting", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectDelay):
+		}
+	}
+}
+
+// Purge periodically removes events older than retention until ctx is done.
+func Purge(ctx context.Context, src store.EventSource, retention, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := src.DeleteEventsBefore(ctx, time.Now().Add(-retention)); err != nil {
+				log.Printf("events: purge: %v", err)
+			}
+		}
+	}
+}
+
+func ForUser(userID string) func(model.Event) bool {
+	return func(e model.Event) bool {
+		for _, id := range e.UserIDs {
+			if id == userID {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func ForTeam(teamName string) func(model.Event) bool {
+	return func(e model.Event) bool {
+		return e.TeamName == teamName
+	}
+}
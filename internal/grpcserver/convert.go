@@ -0,0 +1,57 @@
+package grpcserver
+
+import (
+	"avito-pr-reviewer/internal/model"
+	reviewerv1 "avito-pr-reviewer/internal/pb/reviewer/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func toProtoUser(u *model.User) *reviewerv1.User {
+	return &reviewerv1.User{
+		UserId:   u.ID,
+		Username: u.Username,
+		TeamName: u.TeamName,
+		IsActive: u.IsActive,
+	}
+}
+
+func toProtoTeam(t *model.Team) *reviewerv1.Team {
+	members := make([]*reviewerv1.TeamMember, len(t.Members))
+	for i, m := range t.Members {
+		members[i] = &reviewerv1.TeamMember{
+			UserId:   m.ID,
+			Username: m.Username,
+			IsActive: m.IsActive,
+		}
+	}
+	return &reviewerv1.Team{TeamName: t.Name, Members: members}
+}
+
+func toProtoStatus(s model.Status) reviewerv1.PullRequestStatus {
+	switch s {
+	case model.StatusOpen:
+		return reviewerv1.PullRequestStatus_PULL_REQUEST_STATUS_OPEN
+	case model.StatusMerged:
+		return reviewerv1.PullRequestStatus_PULL_REQUEST_STATUS_MERGED
+	default:
+		return reviewerv1.PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+	}
+}
+
+func toProtoPullRequest(pr *model.PullRequest) *reviewerv1.PullRequest {
+	res := &reviewerv1.PullRequest{
+		PullRequestId:     pr.ID,
+		PullRequestName:   pr.Name,
+		AuthorId:          pr.AuthorID,
+		Status:            toProtoStatus(pr.Status),
+		AssignedReviewers: pr.AssignedReviewers,
+	}
+	if !pr.CreatedAt.IsZero() {
+		res.CreatedAt = timestamppb.New(pr.CreatedAt)
+	}
+	if pr.MergedAt != nil {
+		res.MergedAt = timestamppb.New(*pr.MergedAt)
+	}
+	return res
+}
@@ -0,0 +1,216 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"avito-pr-reviewer/internal/model"
+	reviewerv1 "avito-pr-reviewer/internal/pb/reviewer/v1"
+	"avito-pr-reviewer/internal/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+type Server struct {
+	reviewerv1.UnimplementedReviewerServiceServer
+	svc *service.Service
+}
+
+func New(svc *service.Service) *Server {
+	return &Server{svc: svc}
+}
+
+// NewGRPCServer builds a grpc.Server with the reviewer service, health checks and reflection registered.
+// Calls whose authorization metadata bears adminToken are trusted to act without an x-actor-id.
+func NewGRPCServer(svc *service.Service, adminToken string) *grpc.Server {
+	s := grpc.NewServer(grpc.UnaryInterceptor(trust(adminToken)))
+	reviewerv1.RegisterReviewerServiceServer(s, New(svc))
+
+	hs := health.NewServer()
+	hs.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	hs.SetServingStatus(reviewerv1.ReviewerService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(s, hs)
+
+	reflection.Register(s)
+	return s
+}
+
+// toStatus maps domain errors to gRPC status codes.
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, model.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, model.ErrTeamExists), errors.Is(err, model.ErrPRExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, model.ErrPRMerged), errors.Is(err, model.ErrNotAssigned), errors.Is(err, model.ErrNoCandidate),
+		errors.Is(err, model.ErrNoOwner), errors.Is(err, model.ErrComposition), errors.Is(err, model.ErrOpenReviews):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, model.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		log.Printf("grpc: %v", err)
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
+
+// actor is the user on whose behalf the call is made, from the x-actor-id
+// metadata, or empty if there is none.
+func actor(ctx context.Context) string {
+	if v := metadata.ValueFromIncomingContext(ctx, "x-actor-id"); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// trust marks calls with "authorization: Bearer <adminToken>" metadata as
+// trusted. An empty adminToken trusts none.
+func trust(adminToken string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if v := metadata.ValueFromIncomingContext(ctx, "authorization"); len(v) > 0 {
+			ctx = service.TrustBearer(ctx, adminToken, v[0])
+		}
+		return handler(ctx, req)
+	}
+}
+
+// required takes name/value pairs and reports the first empty value as InvalidArgument.
+func required(pairs ...string) error {
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if pairs[i+1] == "" {
+			return status.Errorf(codes.InvalidArgument, "%s is required", pairs[i])
+		}
+	}
+	return nil
+}
+
+func (s *Server) CreateTeam(ctx context.Context, req *reviewerv1.CreateTeamRequest) (*reviewerv1.CreateTeamResponse, error) {
+	team := req.GetTeam()
+	if err := required("team.team_name", team.GetTeamName()); err != nil {
+		return nil, err
+	}
+	members := make([]model.User, len(team.GetMembers()))
+	for i, m := range team.GetMembers() {
+		if err := required("user_id", m.GetUserId(), "username", m.GetUsername()); err != nil {
+			return nil, err
+		}
+		members[i] = model.User{ID: m.GetUserId(), Username: m.GetUsername(), IsActive: m.GetIsActive()}
+	}
+
+	if err := s.svc.CreateTeam(ctx, actor(ctx), model.Team{Name: team.GetTeamName(), Members: members}); err != nil {
+		return nil, toStatus(err)
+	}
+	return &reviewerv1.CreateTeamResponse{Team: team}, nil
+}
+
+func (s *Server) GetTeam(ctx context.Context, req *reviewerv1.GetTeamRequest) (*reviewerv1.GetTeamResponse, error) {
+	if err := required("team_name", req.GetTeamName()); err != nil {
+		return nil, err
+	}
+	team, err := s.svc.GetTeam(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &reviewerv1.GetTeamResponse{Team: toProtoTeam(team)}, nil
+}
+
+func (s *Server) GetUser(ctx context.Context, req *reviewerv1.GetUserRequest) (*reviewerv1.GetUserResponse, error) {
+	if err := required("user_id", req.GetUserId()); err != nil {
+		return nil, err
+	}
+	user, err := s.svc.GetUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &reviewerv1.GetUserResponse{User: toProtoUser(user)}, nil
+}
+
+func (s *Server) SetUserActive(ctx context.Context, req *reviewerv1.SetUserActiveRequest) (*reviewerv1.SetUserActiveResponse, error) {
+	if err := required("user_id", req.GetUserId()); err != nil {
+		return nil, err
+	}
+	if err := s.svc.SetActive(ctx, actor(ctx), req.GetUserId(), req.GetIsActive()); err != nil {
+		return nil, toStatus(err)
+	}
+	user, err := s.svc.GetUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &reviewerv1.SetUserActiveResponse{User: toProtoUser(user)}, nil
+}
+
+func (s *Server) MassDeactivate(ctx context.Context, req *reviewerv1.MassDeactivateRequest) (*reviewerv1.MassDeactivateResponse, error) {
+	if err := required("team_name", req.GetTeamName()); err != nil {
+		return nil, err
+	}
+	if err := s.svc.MassDeactivate(ctx, actor(ctx), req.GetTeamName(), req.GetUserIds()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &reviewerv1.MassDeactivateResponse{DeactivatedCount: int32(len(req.GetUserIds()))}, nil
+}
+
+func (s *Server) GetUserReviews(ctx context.Context, req *reviewerv1.GetUserReviewsRequest) (*reviewerv1.GetUserReviewsResponse, error) {
+	if err := required("user_id", req.GetUserId()); err != nil {
+		return nil, err
+	}
+	prs, err := s.svc.GetUserReviews(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	res := make([]*reviewerv1.PullRequest, len(prs))
+	for i := range prs {
+		res[i] = toProtoPullRequest(&prs[i])
+	}
+	return &reviewerv1.GetUserReviewsResponse{UserId: req.GetUserId(), PullRequests: res}, nil
+}
+
+func (s *Server) CreatePullRequest(ctx context.Context, req *reviewerv1.CreatePullRequestRequest) (*reviewerv1.CreatePullRequestResponse, error) {
+	if err := required("pull_request_id", req.GetPullRequestId(), "pull_request_name", req.GetPullRequestName(), "author_id", req.GetAuthorId()); err != nil {
+		return nil, err
+	}
+	pr, _, err := s.svc.CreatePR(ctx, model.NewPullRequest{
+		ID:       req.GetPullRequestId(),
+		Name:     req.GetPullRequestName(),
+		AuthorID: req.GetAuthorId(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &reviewerv1.CreatePullRequestResponse{Pr: toProtoPullRequest(pr)}, nil
+}
+
+func (s *Server) MergePullRequest(ctx context.Context, req *reviewerv1.MergePullRequestRequest) (*reviewerv1.MergePullRequestResponse, error) {
+	if err := required("pull_request_id", req.GetPullRequestId()); err != nil {
+		return nil, err
+	}
+	pr, err := s.svc.MergePR(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &reviewerv1.MergePullRequestResponse{Pr: toProtoPullRequest(pr)}, nil
+}
+
+func (s *Server) ReassignReviewer(ctx context.Context, req *reviewerv1.ReassignReviewerRequest) (*reviewerv1.ReassignReviewerResponse, error) {
+	if err := required("pull_request_id", req.GetPullRequestId(), "old_reviewer_id", req.GetOldReviewerId()); err != nil {
+		return nil, err
+	}
+	newID, pr, err := s.svc.ReassignReviewer(ctx, actor(ctx), req.GetPullRequestId(), req.GetOldReviewerId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &reviewerv1.ReassignReviewerResponse{Pr: toProtoPullRequest(pr), ReplacedBy: newID}, nil
+}
+
+func (s *Server) GetReviewerStats(ctx context.Context, req *reviewerv1.GetReviewerStatsRequest) (*reviewerv1.GetReviewerStatsResponse, error) {
+	stats, err := s.svc.GetStats(ctx, "")
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &reviewerv1.GetReviewerStatsResponse{Stats: stats}, nil
+}
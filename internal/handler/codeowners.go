@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"avito-pr-reviewer/internal/api"
+	"avito-pr-reviewer/internal/model"
+)
+
+func (h *Handler) SetCodeowners(ctx context.Context, request api.SetCodeownersRequestObject) (api.SetCodeownersResponseObject, error) {
+	c, err := h.svc.SetCodeowners(ctx, &model.Codeowners{
+		TeamName:     request.Body.TeamName,
+		RepositoryID: request.Body.RepositoryId,
+		Content:      request.Body.Content,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidCodeowners):
+			return api.SetCodeowners400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		case errors.Is(err, model.ErrNotFound):
+			return api.SetCodeowners404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "team or repository not found")), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.SetCodeowners200JSONResponse{Codeowners: toAPICodeowners(c)}, nil
+}
+
+func (h *Handler) GetCodeowners(ctx context.Context, request api.GetCodeownersRequestObject) (api.GetCodeownersResponseObject, error) {
+	c, err := h.svc.GetCodeowners(ctx, request.Params.TeamName, request.Params.RepositoryId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return api.GetCodeowners404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "CODEOWNERS not found")), nil
+		}
+		return nil, err
+	}
+
+	return api.GetCodeowners200JSONResponse{Codeowners: toAPICodeowners(c)}, nil
+}
@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"avito-pr-reviewer/internal/api"
+	"avito-pr-reviewer/internal/model"
+)
+
+func (h *Handler) SetUserSeniority(ctx context.Context, request api.SetUserSeniorityRequestObject) (api.SetUserSeniorityResponseObject, error) {
+	user, err := h.svc.SetUserSeniority(ctx, request.Body.UserId, model.Seniority(request.Body.Seniority))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidSeniority):
+			return api.SetUserSeniority400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		case errors.Is(err, model.ErrNotFound):
+			return api.SetUserSeniority404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "user not found")), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.SetUserSeniority200JSONResponse{User: toAPIUser(user)}, nil
+}
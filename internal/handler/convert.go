@@ -0,0 +1,313 @@
+package handler
+
+import (
+	"avito-pr-reviewer/internal/api"
+	"avito-pr-reviewer/internal/model"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func toAPIUser(u *model.User) api.User {
+	res := api.User{
+		UserId:   u.ID,
+		Username: u.Username,
+		TeamName: u.TeamName,
+		IsActive: u.IsActive,
+	}
+	if len(u.Teams) > 0 {
+		res.Teams = &u.Teams
+	}
+	if u.Email != "" {
+		email := openapi_types.Email(u.Email)
+		res.Email = &email
+		res.EmailOptOut = &u.EmailOptOut
+	}
+	res.WorkingHours = toAPIWorkingHours(u.WorkingHours)
+	if len(u.Tags) > 0 {
+		res.Tags = &u.Tags
+	}
+	res.Seniority = toAPISeniority(u.Seniority)
+	return res
+}
+
+func toAPIWorkingHours(wh *model.WorkingHours) *api.WorkingHours {
+	if wh == nil {
+		return nil
+	}
+	return &api.WorkingHours{Timezone: wh.Timezone, Start: wh.Start, End: wh.End, Days: wh.Days}
+}
+
+func fromAPIWorkingHours(wh *api.WorkingHours) *model.WorkingHours {
+	if wh == nil {
+		return nil
+	}
+	return &model.WorkingHours{Timezone: wh.Timezone, Start: wh.Start, End: wh.End, Days: wh.Days}
+}
+
+func toAPISeniority(s model.Seniority) *api.Seniority {
+	if s == "" {
+		return nil
+	}
+	res := api.Seniority(s)
+	return &res
+}
+
+func toAPITeam(t *model.Team) api.Team {
+	members := make([]api.TeamMember, len(t.Members))
+	for i, m := range t.Members {
+		members[i] = api.TeamMember{
+			UserId:    m.ID,
+			Username:  m.Username,
+			IsActive:  m.IsActive,
+			Seniority: toAPISeniority(m.Seniority),
+			IsPrimary: m.TeamName == t.Name,
+			Role:      api.TeamRole(m.Role),
+		}
+	}
+	res := api.Team{TeamName: t.Name, Members: members, LeadAssignment: api.LeadAssignment(t.LeadAssignment)}
+	if t.Parent != "" {
+		res.ParentTeamName = &t.Parent
+	}
+	return res
+}
+
+func toAPITeamTree(t *model.TeamTree) api.TeamTree {
+	res := api.TeamTree{
+		TeamName:     t.Name,
+		Members:      t.Members,
+		OpenPrs:      t.OpenPRs,
+		TotalMembers: t.TotalMembers,
+		TotalOpenPrs: t.TotalOpenPRs,
+		SubTeams:     make([]api.TeamTree, len(t.SubTeams)),
+	}
+	if t.Parent != "" {
+		res.ParentTeamName = &t.Parent
+	}
+	for i := range t.SubTeams {
+		res.SubTeams[i] = toAPITeamTree(&t.SubTeams[i])
+	}
+	return res
+}
+
+// inheritedFrom is nil for settings of the team's own.
+func inheritedFrom(team string) *string {
+	if team == "" {
+		return nil
+	}
+	return &team
+}
+
+// fromAPITeamMember converts a member being added to teamName. TeamName is
+// set only if the team is to become the member's primary one.
+func fromAPITeamMember(m api.TeamMember, teamName string) model.User {
+	u := model.User{ID: m.UserId, Username: m.Username, IsActive: m.IsActive, Role: model.TeamRole(m.Role)}
+	if m.IsPrimary {
+		u.TeamName = teamName
+	}
+	if m.Seniority != nil {
+		u.Seniority = model.Seniority(*m.Seniority)
+	}
+	return u
+}
+
+func toAPIPullRequest(pr *model.PullRequest) api.PullRequest {
+	res := api.PullRequest{
+		PullRequestId:     pr.ID,
+		PullRequestName:   pr.Name,
+		AuthorId:          pr.AuthorID,
+		Status:            api.PullRequestStatus(pr.Status),
+		AssignedReviewers: pr.AssignedReviewers,
+		MergedAt:          pr.MergedAt,
+		AssignmentSeed:    pr.AssignmentSeed,
+	}
+	if res.AssignedReviewers == nil {
+		res.AssignedReviewers = []string{}
+	}
+	if !pr.CreatedAt.IsZero() {
+		createdAt := pr.CreatedAt
+		res.CreatedAt = &createdAt
+	}
+	if len(pr.Labels) > 0 {
+		res.Labels = &pr.Labels
+	}
+	if pr.RepositoryID != "" {
+		res.RepositoryId = &pr.RepositoryID
+	}
+	if pr.TeamName != "" {
+		res.TeamName = &pr.TeamName
+	}
+	return res
+}
+
+func toAPIPullRequestShort(pr *model.PullRequest) api.PullRequestShort {
+	return api.PullRequestShort{
+		PullRequestId:   pr.ID,
+		PullRequestName: pr.Name,
+		AuthorId:        pr.AuthorID,
+		Status:          api.PullRequestStatus(pr.Status),
+	}
+}
+
+func toAPITeamSLA(sla *model.TeamSLA) api.TeamSla {
+	return api.TeamSla{
+		TeamName:           sla.TeamName,
+		FirstResponseHours: sla.FirstResponseHours,
+		AutoReassign:       sla.AutoReassign,
+		InheritedFrom:      inheritedFrom(sla.InheritedFrom),
+	}
+}
+
+func toAPISLABreach(b *model.SLABreach) api.SlaBreach {
+	return api.SlaBreach{
+		PullRequestId:   b.PullRequestID,
+		PullRequestName: b.PullRequestName,
+		TeamName:        b.SLA.TeamName,
+		ReviewerId:      b.ReviewerID,
+		AssignedAt:      b.AssignedAt,
+		DueAt:           b.DueAt,
+		Escalated:       b.EscalatedAt != nil,
+	}
+}
+
+func toAPIStalePolicy(p *model.StalePolicy) api.StalePolicy {
+	return api.StalePolicy{
+		TeamName:         p.TeamName,
+		StaleAfterHours:  p.StaleAfterHours,
+		MaxReassignments: p.MaxReassignments,
+		DryRun:           p.DryRun,
+		InheritedFrom:    inheritedFrom(p.InheritedFrom),
+	}
+}
+
+func toAPIStaleAction(a *model.StaleAction) api.StaleAction {
+	res := api.StaleAction{
+		PullRequestId: a.PullRequestID,
+		TeamName:      a.TeamName,
+		ReviewerId:    a.ReviewerID,
+		Outcome:       api.StaleOutcome(a.Outcome),
+	}
+	if a.ReplacedBy != "" {
+		res.ReplacedBy = &a.ReplacedBy
+	}
+	return res
+}
+
+func toAPICodeowners(c *model.Codeowners) api.Codeowners {
+	return api.Codeowners{
+		TeamName:     c.TeamName,
+		RepositoryId: c.RepositoryID,
+		Content:      c.Content,
+		UpdatedAt:    &c.UpdatedAt,
+	}
+}
+
+func toAPIRepository(r *model.Repository) api.Repository {
+	res := api.Repository{
+		RepositoryId:  r.ID,
+		Name:          r.Name,
+		TeamName:      r.TeamName,
+		ReviewerCount: r.ReviewerCount,
+		RequireOwner:  r.RequireOwner,
+		ExcludedUsers: r.ExcludedUsers,
+		CreatedAt:     &r.CreatedAt,
+	}
+	if r.Strategy != "" {
+		strategy := api.AssignmentStrategy(r.Strategy)
+		res.Strategy = &strategy
+	}
+	return res
+}
+
+func fromAPIRepository(r api.Repository) *model.Repository {
+	res := &model.Repository{
+		ID:            r.RepositoryId,
+		Name:          r.Name,
+		TeamName:      r.TeamName,
+		ReviewerCount: r.ReviewerCount,
+		RequireOwner:  r.RequireOwner,
+		ExcludedUsers: r.ExcludedUsers,
+	}
+	if r.Strategy != nil {
+		res.Strategy = model.AssignmentStrategy(*r.Strategy)
+	}
+	return res
+}
+
+func toAPITeamRules(r *model.TeamRules) api.TeamRules {
+	return api.TeamRules{TeamName: r.TeamName, Rules: r.Source, UpdatedAt: &r.UpdatedAt, InheritedFrom: inheritedFrom(r.InheritedFrom)}
+}
+
+func toAPIRuleEvaluation(e *model.RuleEvaluation) api.RuleEvaluation {
+	res := api.RuleEvaluation{
+		TeamName:  e.TeamName,
+		Seed:      e.Seed,
+		Rules:     make([]api.RuleResult, len(e.Rules)),
+		Excluded:  make([]api.RuleExclusion, len(e.Excluded)),
+		Required:  make([]api.RuleRequirement, len(e.Required)),
+		Reviewers: e.Reviewers,
+	}
+	for i, r := range e.Rules {
+		res.Rules[i] = api.RuleResult{Line: r.Line, Rule: r.Rule, Applied: r.Applied}
+	}
+	for i, x := range e.Excluded {
+		res.Excluded[i] = api.RuleExclusion{UserId: x.UserID, Line: x.Line, Rule: x.Rule}
+	}
+	for i, r := range e.Required {
+		res.Required[i] = api.RuleRequirement{Line: r.Line, Rule: r.Rule, UserIds: append([]string{}, r.UserIDs...)}
+	}
+	if res.Reviewers == nil {
+		res.Reviewers = []string{}
+	}
+	if e.Error != "" {
+		res.Error = &e.Error
+	}
+	return res
+}
+
+func toAPIExplanation(e *model.Explanation) api.AssignmentExplanation {
+	res := api.AssignmentExplanation{
+		Strategy:      api.AssignmentStrategy(e.Strategy),
+		ReviewerCount: e.ReviewerCount,
+		Seed:          e.Seed,
+		Candidates:    make([]api.ExplainedCandidate, len(e.Candidates)),
+	}
+	for i, c := range e.Candidates {
+		x := api.ExplainedCandidate{
+			UserId:      c.UserID,
+			TeamName:    c.TeamName,
+			Seniority:   toAPISeniority(c.Seniority),
+			OpenReviews: c.OpenReviews,
+			Score:       c.Score,
+			WorkingNow:  c.WorkingNow,
+			Owner:       c.Owner,
+			Required:    c.Required,
+			Selected:    c.Selected,
+		}
+		if c.Excluded != "" {
+			excluded := api.ExplainedCandidateExcluded(c.Excluded)
+			x.Excluded = &excluded
+		}
+		if c.Rule != "" {
+			x.Rule = &c.Rule
+		}
+		if c.Rank > 0 {
+			x.Rank = &c.Rank
+		}
+		res.Candidates[i] = x
+	}
+	return res
+}
+
+func toAPIOrgChanges(changes []model.OrgChange) []api.OrgChange {
+	res := make([]api.OrgChange, len(changes))
+	for i, c := range changes {
+		res[i] = api.OrgChange{
+			Action:   api.OrgChangeAction(c.Action),
+			TeamName: c.TeamName,
+			UserId:   c.UserID,
+			From:     c.From,
+			To:       c.To,
+		}
+	}
+	return res
+}
@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"avito-pr-reviewer/internal/api"
+	"avito-pr-reviewer/internal/events"
+	"avito-pr-reviewer/internal/model"
+)
+
+const replayBatch = 100
+
+func (h *Handler) StreamUserEvents(ctx context.Context, request api.StreamUserEventsRequestObject) (api.StreamUserEventsResponseObject, error) {
+	userID := request.Params.UserId
+	if _, err := h.svc.GetUser(ctx, userID); err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return api.StreamUserEvents404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "user not found")), nil
+		}
+		return nil, err
+	}
+
+	return &eventStream{
+		ctx:       ctx,
+		bus:       h.bus,
+		heartbeat: h.heartbeat,
+		filter:    events.ForUser(userID),
+		lastID:    lastEventID(request.Params.LastEventID),
+		replay: func(ctx context.Context, afterID int64) ([]model.Event, error) {
+			return h.svc.ListUserEvents(ctx, userID, afterID, replayBatch)
+		},
+	}, nil
+}
+
+func (h *Handler) StreamTeamEvents(ctx context.Context, request api.StreamTeamEventsRequestObject) (api.StreamTeamEventsResponseObject, error) {
+	teamName := request.Params.TeamName
+	if _, err := h.svc.GetTeam(ctx, teamName); err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return api.StreamTeamEvents404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "team not found")), nil
+		}
+		return nil, err
+	}
+
+	return &eventStream{
+		ctx:       ctx,
+		bus:       h.bus,
+		heartbeat: h.heartbeat,
+		filter:    events.ForTeam(teamName),
+		lastID:    lastEventID(request.Params.LastEventID),
+		replay: func(ctx context.Context, afterID int64) ([]model.Event, error) {
+			return h.svc.ListTeamEvents(ctx, teamName, afterID, replayBatch)
+		},
+	}, nil
+}
+
+func lastEventID(p *api.LastEventIdHeader) int64 {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
+
+// eventStream writes events as Server-Sent Events until the client goes away.
+// It subscribes before replaying persisted events newer than lastID, so nothing
+// committed in between is lost; duplicates are dropped by id. Event ids are
+// drawn in commit order, so no event can commit behind one already sent.
+type eventStream struct {
+	ctx       context.Context
+	bus       *events.Bus
+	heartbeat time.Duration
+	filter    func(model.Event) bool
+	lastID    int64
+	replay    func(ctx context.Context, afterID int64) ([]model.Event, error)
+}
+
+func (s *eventStream) VisitStreamUserEventsResponse(w http.ResponseWriter) error {
+	return s.serve(w)
+}
+
+func (s *eventStream) VisitStreamTeamEventsResponse(w http.ResponseWriter) error {
+	return s.serve(w)
+}
+
+func (s *eventStream) serve(w http.ResponseWriter) error {
+	sub := s.bus.Subscribe(s.filter)
+	defer s.bus.Unsubscribe(sub)
+
+	rc := http.NewResponseController(w)
+	// Streams outlive the server's write timeout.
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+
+	h := w.Header()
+	h.Set("Content-Type", "text/event-stream")
+	h.Set("Cache-Control", "no-cache")
+	h.Set("Connection", "keep-alive")
+	h.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if s.lastID > 0 {
+		for {
+			batch, err := s.replay(s.ctx, s.lastID)
+			if err != nil {
+				log.Printf("events: replay after %d: %v", s.lastID, err)
+				return nil
+			}
+			for _, e := range batch {
+				if err := s.write(w, e); err != nil {
+					return nil
+				}
+			}
+			if len(batch) < replayBatch {
+				break
+			}
+		}
+	}
+	if err := rc.Flush(); err != nil {
+		return nil
+	}
+
+	ticker := time.NewTicker(s.heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err := io.WriteString(w, ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+		case e, ok := <-sub.C:
+			if !ok {
+				// Dropped for falling behind; the client resumes with Last-Event-ID.
+				return nil
+			}
+			if e.ID <= s.lastID {
+				continue
+			}
+			if err := s.write(w, e); err != nil {
+				return nil
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return nil
+		}
+	}
+}
+
+func (s *eventStream) write(w io.Writer, e model.Event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, data); err != nil {
+		return err
+	}
+	s.lastID = e.ID
+	return nil
+}
@@ -1,10 +1,14 @@
 package handler
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
+	"log"
 	"net/http"
+	"time"
 
+	"avito-pr-reviewer/internal/api"
+	"avito-pr-reviewer/internal/events"
 	"avito-pr-reviewer/internal/model"
 	"avito-pr-reviewer/internal/service"
 
@@ -13,254 +17,301 @@ import (
 )
 
 type Handler struct {
-	svc *service.Service
+	svc        *service.Service
+	bus        *events.Bus
+	heartbeat  time.Duration
+	adminToken string
 }
 
-func New(svc *service.Service) *Handler {
-	return &Handler{svc: svc}
+var _ api.StrictServerInterface = (*Handler)(nil)
+
+// New builds the handler. Requests bearing adminToken are trusted to act
+// without an X-Actor-Id; an empty adminToken trusts none.
+func New(svc *service.Service, bus *events.Bus, heartbeat time.Duration, adminToken string) *Handler {
+	return &Handler{svc: svc, bus: bus, heartbeat: heartbeat, adminToken: adminToken}
 }
 
 func (h *Handler) Routes() chi.Router {
 	r := chi.NewRouter()
+	r.Use(h.trust)
 
-	r.Post("/team/add", h.CreateTeam)
-	r.Get("/team/get", h.GetTeam)
-	r.Post("/users/setIsActive", h.SetActive)
-	r.Post("/pullRequest/create", h.CreatePR)
-	r.Post("/pullRequest/merge", h.MergePR)
-	r.Post("/pullRequest/reassign", h.Reassign)
-	r.Get("/users/getReview", h.GetUserReviews)
-	r.Get("/stats/reviewers", h.GetStats)
-	r.Post("/users/massDeactivate", h.MassDeactivate)
-	r.Get("/health", func(w http.ResponseWriter, r *http.Request) {
-		render.JSON(w, r, map[string]string{"status": "OK"})
+	si := api.NewStrictHandlerWithOptions(h, nil, api.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			writeError(w, r, api.ErrorCodeBADREQUEST, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			log.Printf("%s %s: %v", r.Method, r.URL.Path, err)
+			writeError(w, r, api.ErrorCodeINTERNALERROR, "internal server error", http.StatusInternalServerError)
+		},
+	})
+	api.HandlerWithOptions(si, api.ChiServerOptions{
+		BaseRouter: r,
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			writeError(w, r, api.ErrorCodeBADREQUEST, err.Error(), http.StatusBadRequest)
+		},
 	})
 	return r
 }
 
-func writeError(w http.ResponseWriter, r *http.Request, code string, msg string, status int) {
+func writeError(w http.ResponseWriter, r *http.Request, code api.ErrorCode, msg string, status int) {
 	render.Status(r, status)
-	render.JSON(w, r, map[string]map[string]string{
-		"error": {"code": code, "message": msg},
+	render.JSON(w, r, apiError(code, msg))
+}
+
+func apiError(code api.ErrorCode, msg string) api.ErrorResponse {
+	return api.ErrorResponse{Error: api.Error{Code: code, Message: msg}}
+}
+
+func badRequest(msg string) api.BadRequestJSONResponse {
+	return api.BadRequestJSONResponse(apiError(api.ErrorCodeBADREQUEST, msg))
+}
+
+func forbidden(err error) api.ForbiddenJSONResponse {
+	return api.ForbiddenJSONResponse(apiError(api.ErrorCodeFORBIDDEN, err.Error()))
+}
+
+// trust marks requests with "Authorization: Bearer <admin token>" as trusted.
+func (h *Handler) trust(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(service.TrustBearer(r.Context(), h.adminToken, r.Header.Get("Authorization"))))
 	})
 }
 
-func (h *Handler) CreateTeam(w http.ResponseWriter, r *http.Request) {
-	var req struct {
-		TeamName string       `json:"team_name"`
-		Members  []model.User `json:"members"`
+// actorID is the user from the X-Actor-Id header, or empty if there is none.
+func actorID(h *api.ActorIdHeader) string {
+	if h == nil {
+		return ""
 	}
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeError(w, r, "BAD_REQUEST", "invalid json", http.StatusBadRequest)
-		return
+	return *h
+}
+
+func (h *Handler) Health(ctx context.Context, request api.HealthRequestObject) (api.HealthResponseObject, error) {
+	return api.Health200JSONResponse{Status: "OK"}, nil
+}
+
+func (h *Handler) CreateTeam(ctx context.Context, request api.CreateTeamRequestObject) (api.CreateTeamResponseObject, error) {
+	members := make([]model.User, len(request.Body.Members))
+	for i, m := range request.Body.Members {
+		members[i] = fromAPITeamMember(m, request.Body.TeamName)
 	}
 
-	err := h.svc.CreateTeam(r.Context(), req.TeamName, req.Members)
+	t := model.Team{Name: request.Body.TeamName, Members: members, LeadAssignment: model.LeadAssignment(request.Body.LeadAssignment)}
+	if request.Body.ParentTeamName != nil {
+		t.Parent = *request.Body.ParentTeamName
+	}
+	err := h.svc.CreateTeam(ctx, actorID(request.Params.XActorId), t)
 	if err != nil {
-		if errors.Is(err, model.ErrTeamExists) {
-			writeError(w, r, "TEAM_EXISTS", "team_name already exists", http.StatusBadRequest)
-			return
+		switch {
+		case errors.Is(err, model.ErrTeamExists):
+			return api.CreateTeam400JSONResponse(apiError(api.ErrorCodeTEAMEXISTS, "team_name already exists")), nil
+		case errors.Is(err, model.ErrForbidden):
+			return api.CreateTeam403JSONResponse{ForbiddenJSONResponse: forbidden(err)}, nil
+		case errors.Is(err, model.ErrNotFound):
+			return api.CreateTeam404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "parent team not found")), nil
+		default:
+			return nil, err
 		}
-		writeError(w, r, "INTERNAL_ERROR", "internal server error", http.StatusInternalServerError)
-		return
 	}
 
-	render.Status(r, http.StatusCreated)
-	render.JSON(w, r, map[string]interface{}{
-		"team": map[string]interface{}{
-			"team_name": req.TeamName,
-			"members":   req.Members,
-		},
-	})
+	team, err := h.svc.GetTeam(ctx, request.Body.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	return api.CreateTeam201JSONResponse{Team: toAPITeam(team)}, nil
 }
 
-func (h *Handler) GetTeam(w http.ResponseWriter, r *http.Request) {
-	teamName := r.URL.Query().Get("team_name")
-	if teamName == "" {
-		writeError(w, r, "BAD_REQUEST", "team_name query param required", http.StatusBadRequest)
-		return
+func (h *Handler) GetTeam(ctx context.Context, request api.GetTeamRequestObject) (api.GetTeamResponseObject, error) {
+	if request.Params.TeamName == "" {
+		return api.GetTeam400JSONResponse{BadRequestJSONResponse: badRequest("team_name query param required")}, nil
 	}
 
-	team, err := h.svc.GetTeam(r.Context(), teamName)
+	team, err := h.svc.GetTeam(ctx, request.Params.TeamName)
 	if err != nil {
 		if errors.Is(err, model.ErrNotFound) {
-			writeError(w, r, "NOT_FOUND", "team not found", http.StatusNotFound)
-			return
+			return api.GetTeam404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "team not found")), nil
 		}
-		writeError(w, r, "INTERNAL_ERROR", "internal server error", http.StatusInternalServerError)
-		return
+		return nil, err
 	}
 
-	render.JSON(w, r, map[string]interface{}{"team": team})
+	return api.GetTeam200JSONResponse{Team: toAPITeam(team)}, nil
 }
 
-func (h *Handler) SetActive(w http.ResponseWriter, r *http.Request) {
-	var req struct {
-		UserID   string `json:"user_id"`
-		IsActive bool   `json:"is_active"`
-	}
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeError(w, r, "BAD_REQUEST", "invalid json", http.StatusBadRequest)
-		return
-	}
-
-	err := h.svc.SetActive(r.Context(), req.UserID, req.IsActive)
+func (h *Handler) SetUserActive(ctx context.Context, request api.SetUserActiveRequestObject) (api.SetUserActiveResponseObject, error) {
+	err := h.svc.SetActive(ctx, actorID(request.Params.XActorId), request.Body.UserId, request.Body.IsActive)
 	if err != nil {
 		if errors.Is(err, model.ErrNotFound) {
-			writeError(w, r, "NOT_FOUND", "user not found", http.StatusNotFound)
-			return
+			return api.SetUserActive404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "user not found")), nil
 		}
-		writeError(w, r, "INTERNAL_ERROR", "internal server error", http.StatusInternalServerError)
-		return
+		if errors.Is(err, model.ErrForbidden) {
+			return api.SetUserActive403JSONResponse{ForbiddenJSONResponse: forbidden(err)}, nil
+		}
+		return nil, err
 	}
 
-	user, err := h.svc.GetUser(r.Context(), req.UserID)
+	user, err := h.svc.GetUser(ctx, request.Body.UserId)
 	if err != nil {
-		writeError(w, r, "INTERNAL_ERROR", "failed to get user", http.StatusInternalServerError)
-		return
+		return nil, err
 	}
 
-	render.JSON(w, r, map[string]interface{}{
-		"user": user,
-	})
+	return api.SetUserActive200JSONResponse{User: toAPIUser(user)}, nil
 }
 
-func (h *Handler) MassDeactivate(w http.ResponseWriter, r *http.Request) {
-	var req struct {
-		TeamName string   `json:"team_name"`
-		UserIDs  []string `json:"user_ids"`
-	}
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeError(w, r, "BAD_REQUEST", "invalid json", http.StatusBadRequest)
-		return
+func (h *Handler) SetUserSlackId(ctx context.Context, request api.SetUserSlackIdRequestObject) (api.SetUserSlackIdResponseObject, error) {
+	user, err := h.svc.SetSlackUserID(ctx, actorID(request.Params.XActorId), request.Body.UserId, request.Body.SlackUserId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return api.SetUserSlackId404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "user not found")), nil
+		}
+		if errors.Is(err, model.ErrForbidden) {
+			return api.SetUserSlackId403JSONResponse{ForbiddenJSONResponse: forbidden(err)}, nil
+		}
+		return nil, err
 	}
 
-	err := h.svc.MassDeactivate(r.Context(), req.TeamName, req.UserIDs)
+	return api.SetUserSlackId200JSONResponse{User: toAPIUser(user)}, nil
+}
+
+func (h *Handler) SetUserEmail(ctx context.Context, request api.SetUserEmailRequestObject) (api.SetUserEmailResponseObject, error) {
+	user, err := h.svc.SetEmail(ctx, actorID(request.Params.XActorId), request.Body.UserId, string(request.Body.Email), request.Body.EmailOptOut)
 	if err != nil {
-		writeError(w, r, "INTERNAL_ERROR", "internal server error", http.StatusInternalServerError)
-		return
+		if errors.Is(err, model.ErrNotFound) {
+			return api.SetUserEmail404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "user not found")), nil
+		}
+		if errors.Is(err, model.ErrForbidden) {
+			return api.SetUserEmail403JSONResponse{ForbiddenJSONResponse: forbidden(err)}, nil
+		}
+		return nil, err
 	}
 
-	render.JSON(w, r, map[string]interface{}{
-		"message":           "users deactivated successfully",
-		"deactivated_count": len(req.UserIDs),
-	})
+	return api.SetUserEmail200JSONResponse{User: toAPIUser(user)}, nil
 }
 
-func (h *Handler) CreatePR(w http.ResponseWriter, r *http.Request) {
-	var req struct {
-		PullRequestID   string `json:"pull_request_id"`
-		PullRequestName string `json:"pull_request_name"`
-		AuthorID        string `json:"author_id"`
-	}
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeError(w, r, "BAD_REQUEST", "invalid json", http.StatusBadRequest)
-		return
+func (h *Handler) MassDeactivateUsers(ctx context.Context, request api.MassDeactivateUsersRequestObject) (api.MassDeactivateUsersResponseObject, error) {
+	err := h.svc.MassDeactivate(ctx, actorID(request.Params.XActorId), request.Body.TeamName, request.Body.UserIds)
+	if err != nil {
+		if errors.Is(err, model.ErrForbidden) {
+			return api.MassDeactivateUsers403JSONResponse{ForbiddenJSONResponse: forbidden(err)}, nil
+		}
+		return nil, err
 	}
 
-	pr, err := h.svc.CreatePR(r.Context(), req.PullRequestID, req.PullRequestName, req.AuthorID)
+	return api.MassDeactivateUsers200JSONResponse{
+		Message:          "users deactivated successfully",
+		DeactivatedCount: len(request.Body.UserIds),
+	}, nil
+}
+
+func (h *Handler) CreatePullRequest(ctx context.Context, request api.CreatePullRequestRequestObject) (api.CreatePullRequestResponseObject, error) {
+	pr, explanation, err := h.svc.CreatePR(ctx, model.NewPullRequest{
+		ID:           request.Body.PullRequestId,
+		Name:         request.Body.PullRequestName,
+		AuthorID:     request.Body.AuthorId,
+		RepositoryID: request.Body.RepositoryId,
+		TeamName:     request.Body.TeamName,
+		ChangedFiles: request.Body.ChangedFiles,
+		Labels:       request.Body.Labels,
+		Explain:      request.Params.Explain != nil && *request.Params.Explain,
+	})
 	if err != nil {
+		if errors.Is(err, model.ErrInvalidTag) {
+			return api.CreatePullRequest400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		}
 		if errors.Is(err, model.ErrPRExists) {
-			writeError(w, r, "PR_EXISTS", "PR id already exists", http.StatusConflict)
-			return
+			return api.CreatePullRequest409JSONResponse(apiError(api.ErrorCodePREXISTS, "PR id already exists")), nil
+		}
+		if errors.Is(err, model.ErrNoOwner) {
+			return api.CreatePullRequest409JSONResponse(apiError(api.ErrorCodeNOOWNER, "no active code owner to assign")), nil
+		}
+		if errors.Is(err, model.ErrComposition) {
+			return api.CreatePullRequest409JSONResponse(apiError(api.ErrorCodeCOMPOSITIONUNSATISFIED, err.Error())), nil
 		}
 		if errors.Is(err, model.ErrNotFound) {
-			writeError(w, r, "NOT_FOUND", "author/team not found", http.StatusNotFound)
-			return
+			return api.CreatePullRequest404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "author/team/repository not found")), nil
 		}
-		writeError(w, r, "INTERNAL_ERROR", "internal server error", http.StatusInternalServerError)
-		return
+		return nil, err
 	}
 
-	render.Status(r, http.StatusCreated)
-	render.JSON(w, r, map[string]interface{}{"pr": pr})
-}
-
-func (h *Handler) MergePR(w http.ResponseWriter, r *http.Request) {
-	var req struct {
-		PullRequestID string `json:"pull_request_id"`
-	}
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeError(w, r, "BAD_REQUEST", "invalid json", http.StatusBadRequest)
-		return
+	res := api.CreatePullRequest201JSONResponse{Pr: toAPIPullRequest(pr)}
+	if explanation != nil {
+		e := toAPIExplanation(explanation)
+		res.Explanation = &e
 	}
+	return res, nil
+}
 
-	pr, err := h.svc.MergePR(r.Context(), req.PullRequestID)
+func (h *Handler) MergePullRequest(ctx context.Context, request api.MergePullRequestRequestObject) (api.MergePullRequestResponseObject, error) {
+	pr, err := h.svc.MergePR(ctx, request.Body.PullRequestId)
 	if err != nil {
 		if errors.Is(err, model.ErrNotFound) {
-			writeError(w, r, "NOT_FOUND", "PR not found", http.StatusNotFound)
-			return
+			return api.MergePullRequest404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "PR not found")), nil
 		}
-		writeError(w, r, "INTERNAL_ERROR", "internal server error", http.StatusInternalServerError)
-		return
+		return nil, err
 	}
 
-	render.JSON(w, r, map[string]interface{}{"pr": pr})
+	return api.MergePullRequest200JSONResponse{Pr: toAPIPullRequest(pr)}, nil
 }
 
-func (h *Handler) Reassign(w http.ResponseWriter, r *http.Request) {
-	var req struct {
-		PullRequestID string `json:"pull_request_id"`
-		OldReviewerID string `json:"old_reviewer_id"`
-	}
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeError(w, r, "BAD_REQUEST", "invalid json", http.StatusBadRequest)
-		return
-	}
-
-	newID, pr, err := h.svc.ReassignReviewer(r.Context(), req.PullRequestID, req.OldReviewerID)
+func (h *Handler) ReassignReviewer(ctx context.Context, request api.ReassignReviewerRequestObject) (api.ReassignReviewerResponseObject, error) {
+	newID, pr, err := h.svc.ReassignReviewer(ctx, actorID(request.Params.XActorId), request.Body.PullRequestId, request.Body.OldReviewerId)
 	if err != nil {
 		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return api.ReassignReviewer403JSONResponse{ForbiddenJSONResponse: forbidden(err)}, nil
 		case errors.Is(err, model.ErrNotFound):
-			writeError(w, r, "NOT_FOUND", "PR or user not found", http.StatusNotFound)
+			return api.ReassignReviewer404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "PR or user not found")), nil
 		case errors.Is(err, model.ErrPRMerged):
-			writeError(w, r, "PR_MERGED", "cannot reassign on merged PR", http.StatusConflict)
+			return api.ReassignReviewer409JSONResponse(apiError(api.ErrorCodePRMERGED, "cannot reassign on merged PR")), nil
 		case errors.Is(err, model.ErrNotAssigned):
-			writeError(w, r, "NOT_ASSIGNED", "reviewer is not assigned to this PR", http.StatusConflict)
+			return api.ReassignReviewer409JSONResponse(apiError(api.ErrorCodeNOTASSIGNED, "reviewer is not assigned to this PR")), nil
 		case errors.Is(err, model.ErrNoCandidate):
-			writeError(w, r, "NO_CANDIDATE", "no active replacement candidate in team", http.StatusConflict)
+			return api.ReassignReviewer409JSONResponse(apiError(api.ErrorCodeNOCANDIDATE, "no active replacement candidate in team")), nil
+		case errors.Is(err, model.ErrComposition):
+			return api.ReassignReviewer409JSONResponse(apiError(api.ErrorCodeCOMPOSITIONUNSATISFIED, err.Error())), nil
 		default:
-			writeError(w, r, "INTERNAL_ERROR", "internal server error", http.StatusInternalServerError)
+			return nil, err
 		}
-		return
 	}
 
-	render.JSON(w, r, map[string]interface{}{
-		"pr":          pr,
-		"replaced_by": newID,
-	})
+	return api.ReassignReviewer200JSONResponse{
+		Pr:         toAPIPullRequest(pr),
+		ReplacedBy: newID,
+	}, nil
 }
 
-func (h *Handler) GetUserReviews(w http.ResponseWriter, r *http.Request) {
-	userID := r.URL.Query().Get("user_id")
-	if userID == "" {
-		writeError(w, r, "BAD_REQUEST", "user_id query param required", http.StatusBadRequest)
-		return
+func (h *Handler) GetUserReviews(ctx context.Context, request api.GetUserReviewsRequestObject) (api.GetUserReviewsResponseObject, error) {
+	if request.Params.UserId == "" {
+		return api.GetUserReviews400JSONResponse{BadRequestJSONResponse: badRequest("user_id query param required")}, nil
 	}
 
-	prs, err := h.svc.GetUserReviews(r.Context(), userID)
+	prs, err := h.svc.GetUserReviews(ctx, request.Params.UserId)
 	if err != nil {
 		if errors.Is(err, model.ErrNotFound) {
-			writeError(w, r, "NOT_FOUND", "user not found", http.StatusNotFound)
-			return
+			return api.GetUserReviews404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "user not found")), nil
 		}
-		writeError(w, r, "INTERNAL_ERROR", "internal server error", http.StatusInternalServerError)
-		return
+		return nil, err
 	}
 
-	render.JSON(w, r, map[string]interface{}{
-		"user_id":       userID,
-		"pull_requests": prs,
-	})
+	res := make([]api.PullRequestShort, len(prs))
+	for i, pr := range prs {
+		res[i] = toAPIPullRequestShort(&pr)
+	}
+	return api.GetUserReviews200JSONResponse{
+		UserId:       request.Params.UserId,
+		PullRequests: res,
+	}, nil
 }
 
-func (h *Handler) GetStats(w http.ResponseWriter, r *http.Request) {
-	stats, err := h.svc.GetStats(r.Context())
+func (h *Handler) GetReviewerStats(ctx context.Context, request api.GetReviewerStatsRequestObject) (api.GetReviewerStatsResponseObject, error) {
+	teamName := ""
+	if request.Params.TeamName != nil {
+		teamName = *request.Params.TeamName
+	}
+	stats, err := h.svc.GetStats(ctx, teamName)
 	if err != nil {
-		writeError(w, r, "INTERNAL_ERROR", "internal server error", http.StatusInternalServerError)
-		return
+		if errors.Is(err, model.ErrNotFound) {
+			return api.GetReviewerStats404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "team not found")), nil
+		}
+		return nil, err
 	}
-	render.JSON(w, r, map[string]interface{}{
-		"stats": stats,
-	})
+	return api.GetReviewerStats200JSONResponse{Stats: stats}, nil
 }
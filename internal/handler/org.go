@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"avito-pr-reviewer/internal/api"
+	"avito-pr-reviewer/internal/model"
+	"avito-pr-reviewer/internal/orgfile"
+)
+
+func (h *Handler) ImportOrg(ctx context.Context, request api.ImportOrgRequestObject) (api.ImportOrgResponseObject, error) {
+	res, err := h.svc.ImportOrg(ctx, actorID(request.Params.XActorId), orgfile.Format(request.Body.Format), []byte(request.Body.Content), request.Body.DryRun)
+	if err != nil {
+		if errors.Is(err, model.ErrForbidden) {
+			return api.ImportOrg403JSONResponse{ForbiddenJSONResponse: forbidden(err)}, nil
+		}
+		if errors.Is(err, model.ErrInvalidImport) {
+			resp := apiError(api.ErrorCodeBADREQUEST, err.Error())
+			var errs orgfile.Errors
+			if errors.As(err, &errs) {
+				for _, e := range errs {
+					resp.Error.Details = append(resp.Error.Details, api.FieldViolation{Line: e.Line, Field: e.Field, Message: e.Message})
+				}
+			}
+			return api.ImportOrg400JSONResponse(resp), nil
+		}
+		return nil, err
+	}
+
+	return api.ImportOrg200JSONResponse{DryRun: res.DryRun, Changes: toAPIOrgChanges(res.Changes)}, nil
+}
+
+func (h *Handler) ExportOrg(ctx context.Context, request api.ExportOrgRequestObject) (api.ExportOrgResponseObject, error) {
+	content, err := h.svc.ExportOrg(ctx, orgfile.Format(request.Params.Format))
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidImport) {
+			return api.ExportOrg400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		}
+		return nil, err
+	}
+
+	return api.ExportOrg200JSONResponse{Format: request.Params.Format, Content: string(content)}, nil
+}
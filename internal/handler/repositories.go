@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"avito-pr-reviewer/internal/api"
+	"avito-pr-reviewer/internal/model"
+)
+
+func (h *Handler) CreateRepository(ctx context.Context, request api.CreateRepositoryRequestObject) (api.CreateRepositoryResponseObject, error) {
+	repo, err := h.svc.CreateRepository(ctx, fromAPIRepository(api.Repository(*request.Body)))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidRepository):
+			return api.CreateRepository400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		case errors.Is(err, model.ErrNotFound):
+			return api.CreateRepository404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "team not found")), nil
+		case errors.Is(err, model.ErrRepoExists):
+			return api.CreateRepository409JSONResponse(apiError(api.ErrorCodeREPOEXISTS, "repository already exists")), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.CreateRepository201JSONResponse{Repository: toAPIRepository(repo)}, nil
+}
+
+func (h *Handler) GetRepository(ctx context.Context, request api.GetRepositoryRequestObject) (api.GetRepositoryResponseObject, error) {
+	repo, err := h.svc.GetRepository(ctx, request.Params.RepositoryId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return api.GetRepository404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "repository not found")), nil
+		}
+		return nil, err
+	}
+
+	return api.GetRepository200JSONResponse{Repository: toAPIRepository(repo)}, nil
+}
+
+func (h *Handler) ListRepositories(ctx context.Context, request api.ListRepositoriesRequestObject) (api.ListRepositoriesResponseObject, error) {
+	var teamName string
+	if request.Params.TeamName != nil {
+		teamName = *request.Params.TeamName
+	}
+	repos, err := h.svc.ListRepositories(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return api.ListRepositories404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "team not found")), nil
+		}
+		return nil, err
+	}
+
+	res := make([]api.Repository, len(repos))
+	for i := range repos {
+		res[i] = toAPIRepository(&repos[i])
+	}
+	return api.ListRepositories200JSONResponse{Repositories: res}, nil
+}
+
+func (h *Handler) UpdateRepository(ctx context.Context, request api.UpdateRepositoryRequestObject) (api.UpdateRepositoryResponseObject, error) {
+	repo, err := h.svc.UpdateRepository(ctx, fromAPIRepository(api.Repository(*request.Body)))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidRepository):
+			return api.UpdateRepository400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		case errors.Is(err, model.ErrNotFound):
+			return api.UpdateRepository404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "repository or team not found")), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.UpdateRepository200JSONResponse{Repository: toAPIRepository(repo)}, nil
+}
+
+func (h *Handler) DeleteRepository(ctx context.Context, request api.DeleteRepositoryRequestObject) (api.DeleteRepositoryResponseObject, error) {
+	if err := h.svc.DeleteRepository(ctx, request.Body.RepositoryId); err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return api.DeleteRepository404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "repository not found")), nil
+		}
+		return nil, err
+	}
+
+	return api.DeleteRepository204Response{}, nil
+}
@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"avito-pr-reviewer/internal/api"
+	"avito-pr-reviewer/internal/model"
+)
+
+func (h *Handler) SetTeamRules(ctx context.Context, request api.SetTeamRulesRequestObject) (api.SetTeamRulesResponseObject, error) {
+	r, err := h.svc.SetTeamRules(ctx, &model.TeamRules{
+		TeamName: request.Body.TeamName,
+		Source:   request.Body.Rules,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidRules):
+			return api.SetTeamRules400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		case errors.Is(err, model.ErrNotFound):
+			return api.SetTeamRules404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "team not found")), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.SetTeamRules200JSONResponse{Rules: toAPITeamRules(r)}, nil
+}
+
+func (h *Handler) GetTeamRules(ctx context.Context, request api.GetTeamRulesRequestObject) (api.GetTeamRulesResponseObject, error) {
+	r, err := h.svc.GetTeamRules(ctx, request.Params.TeamName)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return api.GetTeamRules404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "rules not set")), nil
+		}
+		return nil, err
+	}
+
+	return api.GetTeamRules200JSONResponse{Rules: toAPITeamRules(r)}, nil
+}
+
+func (h *Handler) EvaluateRules(ctx context.Context, request api.EvaluateRulesRequestObject) (api.EvaluateRulesResponseObject, error) {
+	req := model.NewPullRequest{
+		AuthorID:     request.Body.AuthorId,
+		RepositoryID: request.Body.RepositoryId,
+		TeamName:     request.Body.TeamName,
+		ChangedFiles: request.Body.ChangedFiles,
+		Labels:       request.Body.Labels,
+		Seed:         request.Body.Seed,
+	}
+	if request.Body.CreatedAt != nil {
+		req.CreatedAt = *request.Body.CreatedAt
+	}
+	eval, err := h.svc.EvaluateRules(ctx, req, request.Body.Rules)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidRules), errors.Is(err, model.ErrInvalidTag):
+			return api.EvaluateRules400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		case errors.Is(err, model.ErrNotFound):
+			return api.EvaluateRules404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "author or repository not found")), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.EvaluateRules200JSONResponse{Evaluation: toAPIRuleEvaluation(eval)}, nil
+}
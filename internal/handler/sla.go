@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"avito-pr-reviewer/internal/api"
+	"avito-pr-reviewer/internal/model"
+)
+
+func (h *Handler) SetTeamSla(ctx context.Context, request api.SetTeamSlaRequestObject) (api.SetTeamSlaResponseObject, error) {
+	sla := model.TeamSLA{
+		TeamName:           request.Body.TeamName,
+		FirstResponseHours: request.Body.FirstResponseHours,
+		AutoReassign:       request.Body.AutoReassign,
+	}
+	if err := h.svc.SetTeamSLA(ctx, sla); err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return api.SetTeamSla404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "team not found")), nil
+		}
+		return nil, err
+	}
+
+	return api.SetTeamSla200JSONResponse{Sla: toAPITeamSLA(&sla)}, nil
+}
+
+func (h *Handler) GetTeamSla(ctx context.Context, request api.GetTeamSlaRequestObject) (api.GetTeamSlaResponseObject, error) {
+	sla, err := h.svc.GetTeamSLA(ctx, request.Params.TeamName)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return api.GetTeamSla404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "team SLA not found")), nil
+		}
+		return nil, err
+	}
+
+	return api.GetTeamSla200JSONResponse{Sla: toAPITeamSLA(sla)}, nil
+}
+
+func (h *Handler) RespondToReview(ctx context.Context, request api.RespondToReviewRequestObject) (api.RespondToReviewResponseObject, error) {
+	pr, err := h.svc.RespondToReview(ctx, request.Body.PullRequestId, request.Body.ReviewerId)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrNotFound):
+			return api.RespondToReview404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "PR not found")), nil
+		case errors.Is(err, model.ErrPRMerged):
+			return api.RespondToReview409JSONResponse(apiError(api.ErrorCodePRMERGED, "PR is already merged")), nil
+		case errors.Is(err, model.ErrNotAssigned):
+			return api.RespondToReview409JSONResponse(apiError(api.ErrorCodeNOTASSIGNED, "reviewer is not assigned to this PR")), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.RespondToReview200JSONResponse{Pr: toAPIPullRequest(pr)}, nil
+}
+
+func (h *Handler) ListSlaBreaches(ctx context.Context, request api.ListSlaBreachesRequestObject) (api.ListSlaBreachesResponseObject, error) {
+	var teamName string
+	if request.Params.TeamName != nil {
+		teamName = *request.Params.TeamName
+	}
+
+	breaches, err := h.svc.ListSLABreaches(ctx, teamName, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]api.SlaBreach, len(breaches))
+	for i := range breaches {
+		res[i] = toAPISLABreach(&breaches[i])
+	}
+	return api.ListSlaBreaches200JSONResponse{Breaches: res}, nil
+}
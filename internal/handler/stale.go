@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"avito-pr-reviewer/internal/api"
+	"avito-pr-reviewer/internal/model"
+)
+
+func (h *Handler) SetStalePolicy(ctx context.Context, request api.SetStalePolicyRequestObject) (api.SetStalePolicyResponseObject, error) {
+	p := model.StalePolicy{
+		TeamName:         request.Body.TeamName,
+		StaleAfterHours:  request.Body.StaleAfterHours,
+		MaxReassignments: request.Body.MaxReassignments,
+		DryRun:           request.Body.DryRun,
+	}
+	if err := h.svc.SetStalePolicy(ctx, p); err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return api.SetStalePolicy404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "team not found")), nil
+		}
+		return nil, err
+	}
+
+	return api.SetStalePolicy200JSONResponse{Policy: toAPIStalePolicy(&p)}, nil
+}
+
+func (h *Handler) GetStalePolicy(ctx context.Context, request api.GetStalePolicyRequestObject) (api.GetStalePolicyResponseObject, error) {
+	p, err := h.svc.GetStalePolicy(ctx, request.Params.TeamName)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return api.GetStalePolicy404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "stale policy not found")), nil
+		}
+		return nil, err
+	}
+
+	return api.GetStalePolicy200JSONResponse{Policy: toAPIStalePolicy(p)}, nil
+}
+
+func (h *Handler) ReassignStale(ctx context.Context, request api.ReassignStaleRequestObject) (api.ReassignStaleResponseObject, error) {
+	actions, err := h.svc.ReassignStale(ctx, actorID(request.Params.XActorId), request.Body.TeamName, time.Now(), request.Body.DryRun)
+	if err != nil {
+		if errors.Is(err, model.ErrForbidden) {
+			return api.ReassignStale403JSONResponse{ForbiddenJSONResponse: forbidden(err)}, nil
+		}
+		return nil, err
+	}
+
+	res := make([]api.StaleAction, len(actions))
+	for i := range actions {
+		res[i] = toAPIStaleAction(&actions[i])
+	}
+	return api.ReassignStale200JSONResponse{Actions: res}, nil
+}
+
+func (h *Handler) ListReassignments(ctx context.Context, request api.ListReassignmentsRequestObject) (api.ListReassignmentsResponseObject, error) {
+	list, err := h.svc.ListReassignments(ctx, request.Params.PullRequestId)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return api.ListReassignments404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "PR not found")), nil
+		}
+		return nil, err
+	}
+
+	res := make([]api.Reassignment, len(list))
+	for i, r := range list {
+		res[i] = api.Reassignment{
+			OldReviewerId: r.OldReviewerID,
+			NewReviewerId: r.NewReviewerID,
+			Reason:        api.ReassignReason(r.Reason),
+			CreatedAt:     r.CreatedAt,
+		}
+	}
+	return api.ListReassignments200JSONResponse{
+		PullRequestId: request.Params.PullRequestId,
+		Reassignments: res,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"avito-pr-reviewer/internal/api"
+	"avito-pr-reviewer/internal/model"
+)
+
+func (h *Handler) SetUserTags(ctx context.Context, request api.SetUserTagsRequestObject) (api.SetUserTagsResponseObject, error) {
+	user, err := h.svc.SetUserTags(ctx, request.Body.UserId, request.Body.Tags)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidTag):
+			return api.SetUserTags400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		case errors.Is(err, model.ErrNotFound):
+			return api.SetUserTags404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "user not found")), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.SetUserTags200JSONResponse{User: toAPIUser(user)}, nil
+}
+
+func (h *Handler) AddUserTags(ctx context.Context, request api.AddUserTagsRequestObject) (api.AddUserTagsResponseObject, error) {
+	user, err := h.svc.AddUserTags(ctx, request.Body.UserId, request.Body.Tags)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidTag):
+			return api.AddUserTags400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		case errors.Is(err, model.ErrNotFound):
+			return api.AddUserTags404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "user not found")), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.AddUserTags200JSONResponse{User: toAPIUser(user)}, nil
+}
+
+func (h *Handler) RemoveUserTags(ctx context.Context, request api.RemoveUserTagsRequestObject) (api.RemoveUserTagsResponseObject, error) {
+	user, err := h.svc.RemoveUserTags(ctx, request.Body.UserId, request.Body.Tags)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidTag):
+			return api.RemoveUserTags400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		case errors.Is(err, model.ErrNotFound):
+			return api.RemoveUserTags404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "user not found")), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.RemoveUserTags200JSONResponse{User: toAPIUser(user)}, nil
+}
+
+func (h *Handler) ListTags(ctx context.Context, request api.ListTagsRequestObject) (api.ListTagsResponseObject, error) {
+	var teamName string
+	if request.Params.TeamName != nil {
+		teamName = *request.Params.TeamName
+	}
+	tags, err := h.svc.ListTags(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return api.ListTags404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "team not found")), nil
+		}
+		return nil, err
+	}
+
+	res := make([]api.TagCount, len(tags))
+	for i, t := range tags {
+		res[i] = api.TagCount{Tag: t.Tag, Users: t.Users}
+	}
+	return api.ListTags200JSONResponse{Tags: res}, nil
+}
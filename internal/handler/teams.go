@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"avito-pr-reviewer/internal/api"
+	"avito-pr-reviewer/internal/model"
+)
+
+func (h *Handler) RenameTeam(ctx context.Context, request api.RenameTeamRequestObject) (api.RenameTeamResponseObject, error) {
+	team, err := h.svc.RenameTeam(ctx, actorID(request.Params.XActorId), request.Body.TeamName, request.Body.NewTeamName)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return api.RenameTeam403JSONResponse{ForbiddenJSONResponse: forbidden(err)}, nil
+		case errors.Is(err, model.ErrNotFound):
+			return api.RenameTeam404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "team not found")), nil
+		case errors.Is(err, model.ErrTeamExists):
+			return api.RenameTeam409JSONResponse(apiError(api.ErrorCodeTEAMEXISTS, "new_team_name already exists")), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.RenameTeam200JSONResponse{Team: toAPITeam(team)}, nil
+}
+
+func (h *Handler) DeleteTeam(ctx context.Context, request api.DeleteTeamRequestObject) (api.DeleteTeamResponseObject, error) {
+	policy := model.OpenReviewsReject
+	if request.Params.OpenPrs != nil {
+		policy = model.OpenReviewPolicy(*request.Params.OpenPrs)
+	}
+	d, err := h.svc.DeleteTeam(ctx, actorID(request.Params.XActorId), request.Params.TeamName, policy)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return api.DeleteTeam403JSONResponse{ForbiddenJSONResponse: forbidden(err)}, nil
+		case errors.Is(err, model.ErrInvalidPolicy):
+			return api.DeleteTeam400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		case errors.Is(err, model.ErrNotFound):
+			return api.DeleteTeam404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "team not found")), nil
+		case errors.Is(err, model.ErrTeamHasOpenPRs):
+			return api.DeleteTeam409JSONResponse(apiError(api.ErrorCodeTEAMHASOPENPRS, err.Error())), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.DeleteTeam200JSONResponse{Deletion: api.TeamDeletion{
+		TeamName:   d.TeamName,
+		Members:    d.Members,
+		Unassigned: d.Unassigned,
+	}}, nil
+}
+
+func (h *Handler) SetTeamParent(ctx context.Context, request api.SetTeamParentRequestObject) (api.SetTeamParentResponseObject, error) {
+	team, err := h.svc.SetTeamParent(ctx, request.Body.TeamName, request.Body.ParentTeamName)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrNotFound):
+			return api.SetTeamParent404JSONResponse(apiError(api.ErrorCodeNOTFOUND, err.Error())), nil
+		case errors.Is(err, model.ErrTeamCycle):
+			return api.SetTeamParent409JSONResponse(apiError(api.ErrorCodeTEAMCYCLE, err.Error())), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.SetTeamParent200JSONResponse{Team: toAPITeam(team)}, nil
+}
+
+func (h *Handler) SetTeamMemberRole(ctx context.Context, request api.SetTeamMemberRoleRequestObject) (api.SetTeamMemberRoleResponseObject, error) {
+	team, err := h.svc.SetMemberRole(ctx, actorID(request.Params.XActorId), request.Body.TeamName, request.Body.UserId, model.TeamRole(request.Body.Role))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidRole):
+			return api.SetTeamMemberRole400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		case errors.Is(err, model.ErrForbidden):
+			return api.SetTeamMemberRole403JSONResponse{ForbiddenJSONResponse: forbidden(err)}, nil
+		case errors.Is(err, model.ErrNotFound):
+			return api.SetTeamMemberRole404JSONResponse(apiError(api.ErrorCodeNOTFOUND, err.Error())), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.SetTeamMemberRole200JSONResponse{Team: toAPITeam(team)}, nil
+}
+
+func (h *Handler) SetTeamLeadAssignment(ctx context.Context, request api.SetTeamLeadAssignmentRequestObject) (api.SetTeamLeadAssignmentResponseObject, error) {
+	team, err := h.svc.SetLeadAssignment(ctx, actorID(request.Params.XActorId), request.Body.TeamName, model.LeadAssignment(request.Body.LeadAssignment))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidLeadAssign):
+			return api.SetTeamLeadAssignment400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		case errors.Is(err, model.ErrForbidden):
+			return api.SetTeamLeadAssignment403JSONResponse{ForbiddenJSONResponse: forbidden(err)}, nil
+		case errors.Is(err, model.ErrNotFound):
+			return api.SetTeamLeadAssignment404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "team not found")), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.SetTeamLeadAssignment200JSONResponse{Team: toAPITeam(team)}, nil
+}
+
+func (h *Handler) GetTeamTree(ctx context.Context, request api.GetTeamTreeRequestObject) (api.GetTeamTreeResponseObject, error) {
+	tree, err := h.svc.GetTeamTree(ctx, request.Params.TeamName)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return api.GetTeamTree404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "team not found")), nil
+		}
+		return nil, err
+	}
+
+	return api.GetTeamTree200JSONResponse{Tree: toAPITeamTree(tree)}, nil
+}
+
+func (h *Handler) AddTeamMember(ctx context.Context, request api.AddTeamMemberRequestObject) (api.AddTeamMemberResponseObject, error) {
+	member := fromAPITeamMember(request.Body.Member, request.Body.TeamName)
+	team, err := h.svc.AddTeamMember(ctx, actorID(request.Params.XActorId), request.Body.TeamName, member)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrForbidden):
+			return api.AddTeamMember403JSONResponse{ForbiddenJSONResponse: forbidden(err)}, nil
+		case errors.Is(err, model.ErrNotFound):
+			return api.AddTeamMember404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "team not found")), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.AddTeamMember200JSONResponse{Team: toAPITeam(team)}, nil
+}
+
+func (h *Handler) RemoveTeamMember(ctx context.Context, request api.RemoveTeamMemberRequestObject) (api.RemoveTeamMemberResponseObject, error) {
+	team, err := h.svc.RemoveTeamMember(ctx, actorID(request.Params.XActorId), request.Body.TeamName, request.Body.UserId, openReviewPolicy(request.Body.OpenReviews))
+	if err != nil {
+		if errors.Is(err, model.ErrForbidden) {
+			return api.RemoveTeamMember403JSONResponse{ForbiddenJSONResponse: forbidden(err)}, nil
+		}
+		if errors.Is(err, model.ErrNotFound) {
+			return api.RemoveTeamMember404JSONResponse(apiError(api.ErrorCodeNOTFOUND, err.Error())), nil
+		}
+		if resp, ok := leaveConflict(err); ok {
+			return api.RemoveTeamMember409JSONResponse(resp), nil
+		}
+		return nil, err
+	}
+
+	return api.RemoveTeamMember200JSONResponse{Team: toAPITeam(team)}, nil
+}
+
+// openReviewPolicy defaults to refusing to move reviewers with open reviews.
+func openReviewPolicy(p *api.OpenReviewPolicy) model.OpenReviewPolicy {
+	if p == nil {
+		return model.OpenReviewsReject
+	}
+	return model.OpenReviewPolicy(*p)
+}
+
+// leaveConflict reports why a member could not leave a team.
+func leaveConflict(err error) (api.ErrorResponse, bool) {
+	switch {
+	case errors.Is(err, model.ErrOpenReviews):
+		return apiError(api.ErrorCodeMEMBERHASOPENREVIEWS, err.Error()), true
+	case errors.Is(err, model.ErrNoCandidate):
+		return apiError(api.ErrorCodeNOCANDIDATE, err.Error()), true
+	case errors.Is(err, model.ErrComposition):
+		return apiError(api.ErrorCodeCOMPOSITIONUNSATISFIED, err.Error()), true
+	}
+	return api.ErrorResponse{}, false
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"avito-pr-reviewer/internal/api"
+	"avito-pr-reviewer/internal/model"
+)
+
+func (h *Handler) SetUserWorkingHours(ctx context.Context, request api.SetUserWorkingHoursRequestObject) (api.SetUserWorkingHoursResponseObject, error) {
+	user, err := h.svc.SetUserWorkingHours(ctx, request.Body.UserId, fromAPIWorkingHours(request.Body.WorkingHours))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidWorkingHours):
+			return api.SetUserWorkingHours400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		case errors.Is(err, model.ErrNotFound):
+			return api.SetUserWorkingHours404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "user not found")), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.SetUserWorkingHours200JSONResponse{User: toAPIUser(user)}, nil
+}
+
+func (h *Handler) SetTeamWorkingHours(ctx context.Context, request api.SetTeamWorkingHoursRequestObject) (api.SetTeamWorkingHoursResponseObject, error) {
+	err := h.svc.SetTeamWorkingHours(ctx, request.Body.TeamName, fromAPIWorkingHours(request.Body.WorkingHours))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidWorkingHours):
+			return api.SetTeamWorkingHours400JSONResponse{BadRequestJSONResponse: badRequest(err.Error())}, nil
+		case errors.Is(err, model.ErrNotFound):
+			return api.SetTeamWorkingHours404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "team not found")), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return api.SetTeamWorkingHours200JSONResponse{
+		TeamName:     request.Body.TeamName,
+		WorkingHours: request.Body.WorkingHours,
+	}, nil
+}
+
+func (h *Handler) GetTeamWorkingHours(ctx context.Context, request api.GetTeamWorkingHoursRequestObject) (api.GetTeamWorkingHoursResponseObject, error) {
+	wh, err := h.svc.GetTeamWorkingHours(ctx, request.Params.TeamName)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return api.GetTeamWorkingHours404JSONResponse(apiError(api.ErrorCodeNOTFOUND, "team not found")), nil
+		}
+		return nil, err
+	}
+
+	return api.GetTeamWorkingHours200JSONResponse{
+		TeamName:     request.Params.TeamName,
+		WorkingHours: toAPIWorkingHours(wh),
+	}, nil
+}
@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"avito-pr-reviewer/internal/api"
+	"avito-pr-reviewer/internal/model"
+	"avito-pr-reviewer/internal/store"
+
+	chimw "github.com/go-chi/chi/v5/middleware"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotentReplayedHeader  = "Idempotent-Replayed"
+	maxIdempotentRequestBytes = 1 << 20
+)
+
+// Idempotency makes POST requests carrying an Idempotency-Key header safe to retry:
+// the first response is stored and replayed for repeats with the same body.
+// Bodies over maxIdempotentRequestBytes are refused, as they cannot be hashed
+// whole.
+func Idempotency(st store.IdempotencyStore, ttl time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if r.Method != http.MethodPost || key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body, err := io.ReadAll(io.LimitReader(r.Body, maxIdempotentRequestBytes+1))
+			if err != nil {
+				writeError(w, r, api.ErrorCodeBADREQUEST, "failed to read request body", http.StatusBadRequest)
+				return
+			}
+			if len(body) > maxIdempotentRequestBytes {
+				writeError(w, r, api.ErrorCodeREQUESTTOOLARGE, "request body is too large for an idempotent request", http.StatusRequestEntityTooLarge)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			sum := sha256.Sum256(body)
+			hash := hex.EncodeToString(sum[:])
+			path := r.URL.Path
+
+			reserved, err := st.ReserveIdempotencyKey(r.Context(), key, path, hash, time.Now().Add(ttl))
+			if err != nil {
+				writeError(w, r, api.ErrorCodeINTERNALERROR, "internal server error", http.StatusInternalServerError)
+				return
+			}
+			if !reserved {
+				replay(w, r, st, key, path, hash)
+				return
+			}
+
+			// Outlive the client connection so a disconnect does not leave the key reserved.
+			ctx := context.WithoutCancel(r.Context())
+			release := func() {
+				if err := st.DeleteIdempotencyKey(ctx, key, path); err != nil {
+					log.Printf("idempotency: release key %q: %v", key, err)
+				}
+			}
+			// A panic is answered by Recoverer further out; the key must not
+			// stay in progress until it expires.
+			defer func() {
+				if p := recover(); p != nil {
+					release()
+					panic(p)
+				}
+			}()
+
+			var buf bytes.Buffer
+			ww := chimw.NewWrapResponseWriter(w, r.ProtoMajor)
+			ww.Tee(&buf)
+			next.ServeHTTP(ww, r)
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+			if status >= http.StatusInternalServerError {
+				release()
+				return
+			}
+			if err := st.CompleteIdempotencyKey(ctx, key, path, status, buf.Bytes()); err != nil {
+				log.Printf("idempotency: store response for key %q: %v", key, err)
+			}
+		})
+	}
+}
+
+func replay(w http.ResponseWriter, r *http.Request, st store.IdempotencyStore, key, path, hash string) {
+	rec, err := st.GetIdempotencyKey(r.Context(), key, path)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			writeError(w, r, api.ErrorCodeIDEMPOTENCYINPROGRESS, "request with this idempotency key is being processed", http.StatusConflict)
+			return
+		}
+		writeError(w, r, api.ErrorCodeINTERNALERROR, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	if rec.RequestHash != hash {
+		writeError(w, r, api.ErrorCodeIDEMPOTENCYKEYREUSED, "idempotency key was already used with a different request body", http.StatusUnprocessableEntity)
+		return
+	}
+	if !rec.Completed {
+		writeError(w, r, api.ErrorCodeIDEMPOTENCYINPROGRESS, "request with this idempotency key is being processed", http.StatusConflict)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(rec.StatusCode)
+	w.Write(rec.ResponseBody)
+}
+
+// PurgeIdempotencyKeys periodically removes expired keys until ctx is done.
+func PurgeIdempotencyKeys(ctx context.Context, st store.IdempotencyStore, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := st.DeleteExpiredIdempotencyKeys(ctx); err != nil {
+				log.Printf("idempotency: purge expired keys: %v", err)
+			}
+		}
+	}
+}
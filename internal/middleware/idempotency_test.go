@@ -0,0 +1,118 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"avito-pr-reviewer/internal/model"
+)
+
+// memIdempotency is an in-memory store.IdempotencyStore.
+type memIdempotency struct {
+	mu   sync.Mutex
+	keys map[string]*model.IdempotencyRecord
+}
+
+func (m *memIdempotency) ReserveIdempotencyKey(_ context.Context, key, path, hash string, expiresAt time.Time) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.keys[path+key]; ok {
+		return false, nil
+	}
+	m.keys[path+key] = &model.IdempotencyRecord{Key: key, Path: path, RequestHash: hash, ExpiresAt: expiresAt}
+	return true, nil
+}
+
+func (m *memIdempotency) GetIdempotencyKey(_ context.Context, key, path string) (*model.IdempotencyRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	rec, ok := m.keys[path+key]
+	if !ok {
+		return nil, model.ErrNotFound
+	}
+	return rec, nil
+}
+
+func (m *memIdempotency) CompleteIdempotencyKey(_ context.Context, key, path string, status int, body []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	rec := m.keys[path+key]
+	rec.StatusCode, rec.ResponseBody, rec.Completed = status, body, true
+	return nil
+}
+
+func (m *memIdempotency) DeleteIdempotencyKey(_ context.Context, key, path string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.keys, path+key)
+	return nil
+}
+
+func (m *memIdempotency) DeleteExpiredIdempotencyKeys(context.Context) (int64, error) { return 0, nil }
+
+func idempotentPost(h http.Handler, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/team/add", strings.NewReader(body))
+	req.Header.Set(IdempotencyKeyHeader, "k1")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestIdempotencyReplays(t *testing.T) {
+	st := &memIdempotency{keys: make(map[string]*model.IdempotencyRecord)}
+	calls := 0
+	h := Idempotency(st, time.Hour)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"ok":true}`))
+	}))
+
+	idempotentPost(h, `{"a":1}`)
+	rec := idempotentPost(h, `{"a":1}`)
+	if calls != 1 || rec.Code != http.StatusCreated || rec.Header().Get(IdempotentReplayedHeader) != "true" {
+		t.Errorf("replay: calls = %d, status = %d, headers = %v", calls, rec.Code, rec.Header())
+	}
+	if rec := idempotentPost(h, `{"a":2}`); rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("other body: status = %d, want 422", rec.Code)
+	}
+}
+
+func TestIdempotencyReleasesKeyOnPanic(t *testing.T) {
+	st := &memIdempotency{keys: make(map[string]*model.IdempotencyRecord)}
+	panics := true
+	h := Idempotency(st, time.Hour)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if panics {
+			panic("boom")
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	func() {
+		defer func() {
+			if p := recover(); p != "boom" {
+				t.Errorf("recovered %v, want the handler's panic", p)
+			}
+		}()
+		idempotentPost(h, `{}`)
+	}()
+	panics = false
+	if rec := idempotentPost(h, `{}`); rec.Code != http.StatusOK {
+		t.Errorf("retry after panic: status = %d, want 200", rec.Code)
+	}
+}
+
+func TestIdempotencyRejectsLargeBodies(t *testing.T) {
+	st := &memIdempotency{keys: make(map[string]*model.IdempotencyRecord)}
+	h := Idempotency(st, time.Hour)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("handler called")
+	}))
+	rec := idempotentPost(h, strings.Repeat("x", maxIdempotentRequestBytes+1))
+	if rec.Code != http.StatusRequestEntityTooLarge || len(st.keys) != 0 {
+		t.Errorf("status = %d, keys = %d", rec.Code, len(st.keys))
+	}
+}
@@ -0,0 +1,20 @@
+package middleware
+
+import (
+	"net/http"
+
+	"avito-pr-reviewer/internal/api"
+
+	"github.com/go-chi/render"
+)
+
+func writeError(w http.ResponseWriter, r *http.Request, code api.ErrorCode, msg string, status int) {
+	writeErrorDetails(w, r, code, msg, nil, status)
+}
+
+func writeErrorDetails(w http.ResponseWriter, r *http.Request, code api.ErrorCode, msg string, details []api.FieldViolation, status int) {
+	render.Status(r, status)
+	render.JSON(w, r, api.ErrorResponse{
+		Error: api.Error{Code: code, Message: msg, Details: details},
+	})
+}
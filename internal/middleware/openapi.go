@@ -0,0 +1,163 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"avito-pr-reviewer/internal/api"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+)
+
+// OpenAPIValidator checks requests (and optionally responses) against the service spec.
+type OpenAPIValidator struct {
+	router            routers.Router
+	validateResponses bool
+}
+
+func NewOpenAPIValidator(ctx context.Context, specPath string, validateResponses bool) (*OpenAPIValidator, error) {
+	doc, err := openapi3.NewLoader().LoadFromFile(specPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load openapi spec: %w", err)
+	}
+	if err := doc.Validate(ctx); err != nil {
+		return nil, fmt.Errorf("invalid openapi spec: %w", err)
+	}
+	router, err := legacy.NewRouter(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build openapi router: %w", err)
+	}
+	return &OpenAPIValidator{router: router, validateResponses: validateResponses}, nil
+}
+
+func (v *OpenAPIValidator) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route, pathParams, err := v.router.FindRoute(r)
+		if err != nil {
+			// Routes that are not described in the spec are served as is.
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		input := &openapi3filter.RequestValidationInput{
+			Request:    r,
+			PathParams: pathParams,
+			Route:      route,
+			Options: &openapi3filter.Options{
+				MultiError:         true,
+				AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+			},
+		}
+		input.Options.WithCustomSchemaErrorFunc(schemaReason)
+		if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+			writeErrorDetails(w, r, api.ErrorCodeBADREQUEST, "request does not match the API schema", violations(err), http.StatusBadRequest)
+			return
+		}
+
+		if !v.validateResponses || streaming(route) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		rec := &bufferedResponse{header: w.Header(), status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		opts := &openapi3filter.Options{IncludeResponseStatus: true}
+		opts.WithCustomSchemaErrorFunc(schemaReason)
+		err = openapi3filter.ValidateResponse(r.Context(), (&openapi3filter.ResponseValidationInput{
+			RequestValidationInput: input,
+			Status:                 rec.status,
+			Header:                 rec.header,
+			Options:                opts,
+		}).SetBodyBytes(rec.body.Bytes()))
+		if err != nil {
+			log.Printf("openapi: %s %s: response violates spec: %v", r.Method, r.URL.Path, err)
+			w.Header().Del("Content-Length")
+			writeErrorDetails(w, r, api.ErrorCodeINVALIDRESPONSE, "response does not match the API schema", violations(err), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(rec.status)
+		w.Write(rec.body.Bytes())
+	})
+}
+
+// streaming reports whether the route answers with an event stream, which
+// cannot be buffered for validation.
+func streaming(route *routers.Route) bool {
+	ok := route.Operation.Responses.Status(http.StatusOK)
+	return ok != nil && ok.Value != nil && ok.Value.Content.Get("text/event-stream") != nil
+}
+
+// schemaReason formats schema errors without the schema and the offending
+// value, which kin-openapi dumps by default and which would otherwise end up
+// in logs.
+func schemaReason(err *openapi3.SchemaError) string {
+	if err.Reason != "" {
+		return err.Reason
+	}
+	return fmt.Sprintf("doesn't match schema %q", err.SchemaField)
+}
+
+// violations flattens kin-openapi errors into a list of offending fields.
+func violations(err error) []api.FieldViolation {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		res := make([]api.FieldViolation, 0, len(e))
+		for _, inner := range e {
+			res = append(res, violations(inner)...)
+		}
+		return res
+	case *openapi3filter.RequestError:
+		var parseErr *openapi3filter.ParseError
+		switch {
+		case e.Parameter != nil:
+			msg := e.Reason
+			var schemaErr *openapi3.SchemaError
+			if errors.As(e.Err, &schemaErr) {
+				msg = schemaErr.Reason
+			} else if e.Err != nil {
+				msg = e.Err.Error()
+			}
+			return []api.FieldViolation{{Field: e.Parameter.Name, Message: msg}}
+		case errors.As(e.Err, &parseErr):
+			return []api.FieldViolation{{Message: "invalid json"}}
+		case e.Err != nil:
+			return violations(e.Err)
+		default:
+			return []api.FieldViolation{{Message: e.Reason}}
+		}
+	case *openapi3filter.ResponseError:
+		if e.Err != nil {
+			return violations(e.Err)
+		}
+		return []api.FieldViolation{{Message: e.Reason}}
+	case *openapi3.SchemaError:
+		return []api.FieldViolation{{
+			Field:   strings.Join(e.JSONPointer(), "."),
+			Message: e.Reason,
+		}}
+	default:
+		return []api.FieldViolation{{Message: err.Error()}}
+	}
+}
+
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponse) Header() http.Header { return b.header }
+
+func (b *bufferedResponse) Write(p []byte) (int, error) { return b.body.Write(p) }
+
+func (b *bufferedResponse) WriteHeader(status int) { b.status = status }
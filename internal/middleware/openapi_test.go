@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"avito-pr-reviewer/internal/api"
+)
+
+func TestOpenAPIValidatorRejectsMalformedBody(t *testing.T) {
+	v, err := NewOpenAPIValidator(context.Background(), "../../openapi.yaml", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := v.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("handler reached with %s", r.URL.Path)
+	}))
+
+	cases := []struct {
+		name  string
+		body  string
+		field string
+	}{
+		{name: "invalid json", body: `{"team_name":`},
+		{name: "missing field", body: `{"team_name":"payments","members":[{"user_id":"u1","is_active":true}]}`, field: "members.0.username"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/team/add", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("status = %d, want 400: %s", rec.Code, rec.Body)
+			}
+			var resp api.ErrorResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("decode %q: %v", rec.Body, err)
+			}
+			if resp.Error.Code != api.ErrorCodeBADREQUEST || resp.Error.Message != "request does not match the API schema" {
+				t.Fatalf("error = %+v", resp.Error)
+			}
+			if len(resp.Error.Details) == 0 {
+				t.Fatalf("no details in %s", rec.Body)
+			}
+			if tc.field == "" {
+				return
+			}
+			for _, d := range resp.Error.Details {
+				if d.Field == tc.field && !strings.Contains(d.Message, "{") {
+					return
+				}
+			}
+			t.Fatalf("details = %+v, want a violation for %s", resp.Error.Details, tc.field)
+		})
+	}
+}
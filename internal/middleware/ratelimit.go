@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"avito-pr-reviewer/internal/api"
+	"avito-pr-reviewer/internal/ratelimit"
+)
+
+// RateLimit applies a token bucket per client and route. Clients are identified by
+// their bearer token, else by X-Actor-Id, else by remote IP. The service verifies
+// neither, so the gateway in front of it must reject made-up ones.
+func RateLimit(l ratelimit.Limiter, rules ratelimit.Rules) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rule := rules.For(r.URL.Path)
+			if rule.Unlimited() {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			res, err := l.Allow(r.Context(), clientKey(r)+"|"+r.URL.Path, rule)
+			if err != nil {
+				// Fail open: a limiter outage must not take the API down with it.
+				log.Printf("ratelimit: %v", err)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			h := w.Header()
+			h.Set("RateLimit-Limit", strconv.Itoa(res.Limit))
+			h.Set("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+			h.Set("RateLimit-Reset", strconv.Itoa(int(res.Reset.Seconds())))
+			if !res.Allowed {
+				h.Set("Retry-After", strconv.Itoa(int(res.RetryAfter.Seconds())))
+				writeError(w, r, api.ErrorCodeRATELIMITED, "too many requests", http.StatusTooManyRequests)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func clientKey(r *http.Request) string {
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		token := strings.TrimSpace(strings.TrimPrefix(auth, "Bearer "))
+		sum := sha256.Sum256([]byte(token))
+		return "token:" + hex.EncodeToString(sum[:])
+	}
+	if actor := r.Header.Get("X-Actor-Id"); actor != "" {
+		return "actor:" + actor
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return "ip:" + host
+}
@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"avito-pr-reviewer/internal/ratelimit"
+)
+
+func TestRateLimit(t *testing.T) {
+	rules := ratelimit.Rules{Default: ratelimit.Rule{Rate: 1, Burst: 2}}
+	h := RateLimit(ratelimit.NewMemoryLimiter(), rules)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	send := func(addr string, header ...string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/team/get", nil)
+		req.RemoteAddr = addr
+		for i := 0; i < len(header); i += 2 {
+			req.Header.Set(header[i], header[i+1])
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	send("10.0.0.1:1000")
+	rec := send("10.0.0.1:1001")
+	if rec.Code != http.StatusOK || rec.Header().Get("RateLimit-Remaining") != "0" {
+		t.Fatalf("second request: %d %v", rec.Code, rec.Header())
+	}
+	rec = send("10.0.0.1:1002")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("status = %d, want 429", rec.Code)
+	}
+	for header, want := range map[string]string{"Retry-After": "1", "RateLimit-Limit": "2", "RateLimit-Remaining": "0", "RateLimit-Reset": "2"} {
+		if got := rec.Header().Get(header); got != want {
+			t.Errorf("%s = %q, want %q", header, got, want)
+		}
+	}
+	if rec := send("10.0.0.2:1000"); rec.Code != http.StatusOK {
+		t.Errorf("other address: status = %d", rec.Code)
+	}
+
+	// A token or an actor has its own bucket, wherever it comes from.
+	for _, header := range [][]string{{"Authorization", "Bearer a"}, {"X-Actor-Id", "u1"}} {
+		send("10.0.0.1:1003", header...)
+		if rec := send("10.0.0.3:1000", header...); rec.Code != http.StatusOK || rec.Header().Get("RateLimit-Remaining") != "0" {
+			t.Errorf("%s: second request: %d %v", header[0], rec.Code, rec.Header())
+		}
+		if rec := send("10.0.0.4:1000", header...); rec.Code != http.StatusTooManyRequests {
+			t.Errorf("%s: status = %d, want 429", header[0], rec.Code)
+		}
+	}
+}
@@ -1,17 +1,35 @@
 package model
 
 import (
+	"encoding/json"
 	"errors"
 	"time"
 )
 
 var (
-	ErrTeamExists  = errors.New("team already exists")
-	ErrPRExists    = errors.New("PR already exists")
-	ErrPRMerged    = errors.New("cannot reassign on merged PR")
-	ErrNotAssigned = errors.New("reviewer is not assigned to this PR")
-	ErrNoCandidate = errors.New("no active replacement candidate in team")
-	ErrNotFound    = errors.New("resource not found")
+	ErrTeamExists          = errors.New("team already exists")
+	ErrPRExists            = errors.New("PR already exists")
+	ErrPRMerged            = errors.New("cannot reassign on merged PR")
+	ErrNotAssigned         = errors.New("reviewer is not assigned to this PR")
+	ErrNoCandidate         = errors.New("no active replacement candidate in team")
+	ErrInvalidWorkingHours = errors.New("invalid working hours")
+	ErrInvalidCodeowners   = errors.New("invalid CODEOWNERS file")
+	ErrInvalidTag          = errors.New("invalid tag")
+	ErrRepoExists          = errors.New("repository already exists")
+	ErrInvalidRepository   = errors.New("invalid repository policy")
+	ErrNoOwner             = errors.New("no active code owner to assign")
+	ErrInvalidSeniority    = errors.New("invalid seniority")
+	ErrComposition         = errors.New("team cannot satisfy reviewer composition rules")
+	ErrInvalidRules        = errors.New("invalid assignment rules")
+	ErrNotFound            = errors.New("resource not found")
+	ErrOpenReviews         = errors.New("user holds open reviews in the team")
+	ErrTeamHasOpenPRs      = errors.New("team has open pull requests")
+	ErrInvalidPolicy       = errors.New("invalid open review policy")
+	ErrTeamCycle           = errors.New("team cannot be its own ancestor")
+	ErrForbidden           = errors.New("not allowed for this team role")
+	ErrInvalidRole         = errors.New("invalid team role")
+	ErrInvalidLeadAssign   = errors.New("invalid lead assignment")
+	ErrInvalidImport       = errors.New("invalid import file")
 )
 
 type Status string
@@ -27,18 +45,497 @@ type PullRequest struct {
 	AuthorID          string     `json:"author_id"`
 	Status            Status     `json:"status"`
 	AssignedReviewers []string   `json:"assigned_reviewers"`
+	Labels            []string   `json:"labels,omitempty"`
+	RepositoryID      string     `json:"repository_id,omitempty"`
 	CreatedAt         time.Time  `json:"created_at"`
 	MergedAt          *time.Time `json:"merged_at,omitempty"`
+	// AssignmentSeed is the seed its reviewers were chosen with; nil for pull
+	// requests created before seeds were kept.
+	AssignmentSeed *int64 `json:"assignment_seed,omitempty"`
+	// TeamName is the team the reviewers are drawn from.
+	TeamName string `json:"team_name,omitempty"`
+}
+
+// NewPullRequest is what a client submits to open a pull request.
+type NewPullRequest struct {
+	ID       string
+	Name     string
+	AuthorID string
+	// RepositoryID is optional; its policy and CODEOWNERS file apply.
+	RepositoryID string
+	ChangedFiles []string
+	Labels       []string
+	// Explain asks for an account of how the reviewers were chosen.
+	Explain bool
+	// Seed makes random choices repeat those made with the same seed; nil
+	// picks a new one. Only rule evaluation takes it from the caller, so
+	// clients cannot steer who reviews a real pull request.
+	Seed *int64
+	// TeamName is the team to draw reviewers from. Empty means the
+	// repository's team, or else the author's primary team.
+	TeamName string
+	// CreatedAt decides which candidates are inside their working hours; the
+	// zero time means now. Like Seed, only rule evaluation takes it from the
+	// caller.
+	CreatedAt time.Time
+}
+
+// Codeowners is a CODEOWNERS file registered for a team's repository. An
+// empty RepositoryID is the team's default file.
+type Codeowners struct {
+	TeamName     string    `json:"team_name"`
+	RepositoryID string    `json:"repository_id"`
+	Content      string    `json:"content"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// TeamRules is the source of a team's assignment rules.
+type TeamRules struct {
+	TeamName  string    `json:"team_name"`
+	Source    string    `json:"rules"`
+	UpdatedAt time.Time `json:"updated_at"`
+	// InheritedFrom is the ancestor whose rules the team follows, if it has
+	// none of its own.
+	InheritedFrom string `json:"inherited_from,omitempty"`
+}
+
+// ExclusionReason tells why a potential reviewer could not get a pull request.
+type ExclusionReason string
+
+const (
+	ExcludedAuthor     ExclusionReason = "author"
+	ExcludedInactive   ExclusionReason = "inactive"
+	ExcludedRepository ExclusionReason = "repository_policy"
+	ExcludedRule       ExclusionReason = "rule"
+	ExcludedLead       ExclusionReason = "team_lead"
+)
+
+// Explanation tells how the reviewers of a pull request were chosen.
+type Explanation struct {
+	Strategy      AssignmentStrategy
+	ReviewerCount int
+	Seed          int64
+	Candidates    []ExplainedCandidate
+}
+
+// ExplainedCandidate is one member of the candidate pool: the author's team,
+// the teams that rules require reviewers from and the code owners.
+type ExplainedCandidate struct {
+	UserID      string
+	TeamName    string
+	Seniority   Seniority
+	OpenReviews int
+	// Excluded is empty for eligible candidates; Rule is the excluding rule.
+	Excluded ExclusionReason
+	Rule     string
+	// Rank is the position among the eligible team candidates after ranking,
+	// starting at 1.
+	Rank int
+	// Score is set under strategies that score candidates.
+	Score *int
+	// WorkingNow is set when reviewers inside their working hours are preferred.
+	WorkingNow *bool
+	Owner      bool
+	Required   bool
+	Selected   bool
+}
+
+// RuleEvaluation explains how a team's rules shape the choice of reviewers
+// for a pull request.
+type RuleEvaluation struct {
+	TeamName  string
+	Seed      int64
+	Rules     []RuleResult
+	Excluded  []RuleExclusion
+	Required  []RuleRequirement
+	Reviewers []string
+	// Error tells why no reviewers could be chosen.
+	Error string
+}
+
+type RuleResult struct {
+	Line    int
+	Rule    string
+	Applied bool
+}
+
+type RuleExclusion struct {
+	UserID string
+	Line   int
+	Rule   string
+}
+
+type RuleRequirement struct {
+	Line    int
+	Rule    string
+	UserIDs []string
+}
+
+// DefaultReviewerCount is how many reviewers a pull request gets unless its
+// repository says otherwise.
+const DefaultReviewerCount = 2
+
+// Repository belongs to a team and may override how its pull requests are
+// assigned.
+type Repository struct {
+	ID       string `json:"repository_id"`
+	Name     string `json:"name"`
+	TeamName string `json:"team_name"`
+	// ReviewerCount replaces DefaultReviewerCount when set.
+	ReviewerCount *int `json:"reviewer_count,omitempty"`
+	// Strategy replaces the server's assignment strategy when set.
+	Strategy AssignmentStrategy `json:"strategy,omitempty"`
+	// RequireOwner makes pull requests fail rather than go without a code owner.
+	RequireOwner  bool      `json:"require_owner"`
+	ExcludedUsers []string  `json:"excluded_users"`
+	CreatedAt     time.Time `json:"created_at"`
 }
 
 type Team struct {
 	Name    string `json:"team_name"`
 	Members []User `json:"members"`
+	// Parent is the department the team belongs to, if any.
+	Parent         string         `json:"parent_team_name,omitempty"`
+	LeadAssignment LeadAssignment `json:"lead_assignment,omitempty"`
 }
 
-type User struct {
-	ID       string `json:"user_id"`
-	Username string `json:"username"`
+// TeamRole is what a member may do in a team. Leads manage the team: they
+// reassign reviews, deactivate members, hand out roles and get escalations.
+// Maintainers may reassign reviews. A role in a department covers its
+// sub-teams.
+type TeamRole string
+
+const (
+	RoleLead       TeamRole = "lead"
+	RoleMaintainer TeamRole = "maintainer"
+	RoleMember     TeamRole = "member"
+)
+
+func (r TeamRole) Valid() bool {
+	return r == RoleLead || r == RoleMaintainer || r == RoleMember
+}
+
+// LeadAssignment says how a team's leads take part in automatic assignment.
+type LeadAssignment string
+
+const (
+	LeadsNormal LeadAssignment = "normal"
+	// LeadsLastResort picks leads only when no one else fits.
+	LeadsLastResort LeadAssignment = "last_resort"
+	// LeadsExcluded never picks leads automatically.
+	LeadsExcluded LeadAssignment = "excluded"
+)
+
+func (a LeadAssignment) Valid() bool {
+	return a == LeadsNormal || a == LeadsLastResort || a == LeadsExcluded
+}
+
+// TeamTree is a team with its sub-teams. Members and OpenPRs count the team
+// alone; the totals cover the whole subtree, each member once.
+type TeamTree struct {
+	Name         string
+	Parent       string
+	Members      int
+	OpenPRs      int
+	TotalMembers int
+	TotalOpenPRs int
+	SubTeams     []TeamTree
+}
+
+// OpenReviewPolicy says what happens to the open reviews someone holds in a
+// team they leave, or that is deleted.
+type OpenReviewPolicy string
+
+const (
+	// OpenReviewsReject refuses the change while there are open reviews.
+	OpenReviewsReject OpenReviewPolicy = "reject"
+	// OpenReviewsReassign hands each review to someone else in the team.
+	OpenReviewsReassign OpenReviewPolicy = "reassign"
+	// OpenReviewsUnassign drops the reviewer from the pull requests.
+	OpenReviewsUnassign OpenReviewPolicy = "unassign"
+)
+
+func (p OpenReviewPolicy) Valid() bool {
+	switch p {
+	case OpenReviewsReject, OpenReviewsReassign, OpenReviewsUnassign:
+		return true
+	}
+	return false
+}
+
+// TeamDeletion is what deleting a team left behind.
+type TeamDeletion struct {
 	TeamName string `json:"team_name"`
-	IsActive bool   `json:"is_active"`
+	// Members no longer belong to any team.
+	Members []string `json:"members"`
+	// Unassigned are the pull requests that lost reviewers.
+	Unassigned []string `json:"unassigned"`
+}
+
+type OrgChangeAction string
+
+const (
+	OrgCreateTeam        OrgChangeAction = "create_team"
+	OrgSetParent         OrgChangeAction = "set_parent"
+	OrgSetLeadAssignment OrgChangeAction = "set_lead_assignment"
+	OrgCreateUser        OrgChangeAction = "create_user"
+	OrgRenameUser        OrgChangeAction = "rename_user"
+	OrgSetActive         OrgChangeAction = "set_active"
+	OrgSetSeniority      OrgChangeAction = "set_seniority"
+	OrgAddMember         OrgChangeAction = "add_member"
+	OrgSetPrimary        OrgChangeAction = "set_primary"
+	OrgSetRole           OrgChangeAction = "set_role"
+)
+
+// OrgChange is one difference between an import file and the stored teams.
+// From and To are the old and new value, if the change has one.
+type OrgChange struct {
+	Action   OrgChangeAction
+	TeamName string
+	UserID   string
+	From     string
+	To       string
+}
+
+// OrgImport is what an import changed or, on a dry run, would change.
+type OrgImport struct {
+	DryRun  bool
+	Changes []OrgChange
+}
+
+// WorkingHours is when someone can be expected to review: Start and End are
+// "HH:MM" in Timezone, Days are lowercase abbreviations (mon, tue, ...).
+type WorkingHours struct {
+	Timezone string   `json:"timezone"`
+	Start    string   `json:"start"`
+	End      string   `json:"end"`
+	Days     []string `json:"days"`
+}
+
+type User struct {
+	ID          string `json:"user_id"`
+	Username    string `json:"username"`
+	TeamName    string `json:"team_name"`
+	IsActive    bool   `json:"is_active"`
+	Email       string `json:"email,omitempty"`
+	EmailOptOut bool   `json:"email_opt_out,omitempty"`
+	// WorkingHours overrides the team's; nil means the team's apply.
+	WorkingHours *WorkingHours `json:"working_hours,omitempty"`
+	Tags         []string      `json:"tags,omitempty"`
+	Seniority    Seniority     `json:"seniority,omitempty"`
+	// Teams are all the user's teams, the primary one first. TeamName is the
+	// primary team, or empty for a user in no team.
+	Teams []string `json:"teams,omitempty"`
+	// Role is the user's role in the team they are listed or added in.
+	Role TeamRole `json:"role,omitempty"`
+}
+
+type Seniority string
+
+const (
+	SeniorityJunior Seniority = "junior"
+	SeniorityMiddle Seniority = "middle"
+	SenioritySenior Seniority = "senior"
+)
+
+// Seniorities lists all levels, most junior first.
+var Seniorities = []Seniority{SeniorityJunior, SeniorityMiddle, SenioritySenior}
+
+func (s Seniority) Valid() bool {
+	return s == SeniorityJunior || s == SeniorityMiddle || s == SenioritySenior
+}
+
+// TagCount is how many users carry a tag.
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Users int    `json:"users"`
+}
+
+// AssignmentStrategy decides which of the eligible reviewers get a pull request.
+type AssignmentStrategy string
+
+const (
+	// StrategyRandom picks reviewers uniformly at random.
+	StrategyRandom AssignmentStrategy = "random"
+	// StrategySkill prefers reviewers whose tags match the pull request's
+	// labels and who have few open reviews.
+	StrategySkill AssignmentStrategy = "skill"
+	// StrategyLeastLoaded picks the reviewers with the fewest open reviews.
+	StrategyLeastLoaded AssignmentStrategy = "least_loaded"
+	// StrategyKnowledgeSpread avoids reviewers who recently reviewed the
+	// same author, so that knowledge of everyone's code spreads.
+	StrategyKnowledgeSpread AssignmentStrategy = "knowledge_spread"
+)
+
+func (s AssignmentStrategy) Valid() bool {
+	switch s {
+	case StrategyRandom, StrategySkill, StrategyLeastLoaded, StrategyKnowledgeSpread:
+		return true
+	}
+	return false
+}
+
+// Candidate is a potential reviewer with what assignment strategies need to know.
+type Candidate struct {
+	UserID string
+	// TeamName is the primary team; Teams are all of them.
+	TeamName    string
+	Teams       []string
+	Tags        []string
+	OpenReviews int
+	Seniority   Seniority
+	// Pairing is how much the candidate recently reviewed the author: each
+	// review counts 1 when new, fading to 0 over the pairing window. It is
+	// only filled in for the knowledge spread strategy.
+	Pairing float64
+}
+
+type EventType string
+
+const (
+	EventReviewAssigned   EventType = "review_assigned"
+	EventReviewReassigned EventType = "review_reassigned"
+	EventPRMerged         EventType = "pull_request_merged"
+	EventSLABreached      EventType = "review_sla_breached"
+	EventReviewUnassigned EventType = "review_unassigned"
+)
+
+// Event is a persisted notification about a pull request. UserIDs lists the
+// users it is addressed to; TeamName is the team it belongs to.
+type Event struct {
+	ID            int64           `json:"id"`
+	Type          EventType       `json:"type"`
+	PullRequestID string          `json:"pull_request_id"`
+	TeamName      string          `json:"team_name"`
+	UserIDs       []string        `json:"user_ids"`
+	Payload       json.RawMessage `json:"payload"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+type EventPayload struct {
+	PR            *PullRequest `json:"pr"`
+	OldReviewerID string       `json:"old_reviewer_id,omitempty"`
+	ReplacedBy    string       `json:"replaced_by,omitempty"`
+	Reason        string       `json:"reason,omitempty"`
+	ReviewerID    string       `json:"reviewer_id,omitempty"`
+	DueAt         *time.Time   `json:"due_at,omitempty"`
+}
+
+// TeamSLA is the review service level a team commits to: every assigned
+// reviewer responds within FirstResponseHours.
+type TeamSLA struct {
+	TeamName           string
+	FirstResponseHours int
+	// AutoReassign replaces a reviewer who breached the SLA.
+	AutoReassign bool
+	// InheritedFrom is the ancestor whose SLA the team follows, if it has
+	// none of its own.
+	InheritedFrom string
+}
+
+// PendingReview is an assignment that has not been responded to yet on a pull
+// request of a team with an SLA.
+type PendingReview struct {
+	PullRequestID   string
+	PullRequestName string
+	ReviewerID      string
+	AssignedAt      time.Time
+	EscalatedAt     *time.Time
+	SLA             TeamSLA
+}
+
+type SLABreach struct {
+	PendingReview
+	DueAt time.Time
+}
+
+// ReassignReason says why a reviewer was replaced.
+type ReassignReason string
+
+const (
+	ReassignManual ReassignReason = "manual"
+	ReassignSLA    ReassignReason = "sla"
+	ReassignStale  ReassignReason = "stale"
+	// ReassignTeamChange replaces a reviewer who left the author's team.
+	ReassignTeamChange ReassignReason = "team_change"
+)
+
+type Reassignment struct {
+	ID            int64          `json:"id"`
+	PullRequestID string         `json:"pull_request_id"`
+	OldReviewerID string         `json:"old_reviewer_id"`
+	NewReviewerID string         `json:"new_reviewer_id"`
+	Reason        ReassignReason `json:"reason"`
+	CreatedAt     time.Time      `json:"created_at"`
+}
+
+// History is the pull request activity that assignment simulations replay.
+// It is also the format of history exports.
+type History struct {
+	Users         []User         `json:"users"`
+	PullRequests  []PullRequest  `json:"pull_requests"`
+	Reassignments []Reassignment `json:"reassignments"`
+}
+
+// StalePolicy makes the service replace reviewers who have not acted on a pull
+// request within StaleAfterHours, at most MaxReassignments times per pull
+// request. In DryRun mode it only reports what it would do.
+type StalePolicy struct {
+	TeamName         string
+	StaleAfterHours  int
+	MaxReassignments int
+	DryRun           bool
+	// InheritedFrom is the ancestor whose policy the team follows, if it has
+	// none of its own.
+	InheritedFrom string
+}
+
+// StaleCandidate is an unanswered assignment on a pull request of a team with a
+// stale policy, together with the automatic reassignments the PR already had.
+type StaleCandidate struct {
+	PullRequestID string
+	ReviewerID    string
+	AssignedAt    time.Time
+	Policy        StalePolicy
+	Reassignments int
+}
+
+type StaleOutcome string
+
+const (
+	StaleReassigned    StaleOutcome = "reassigned"
+	StaleWouldReassign StaleOutcome = "would_reassign"
+	StaleCapReached    StaleOutcome = "cap_reached"
+	StaleNoCandidate   StaleOutcome = "no_candidate"
+)
+
+// StaleAction reports what the stale policy did, or would do, with one reviewer.
+type StaleAction struct {
+	PullRequestID string
+	TeamName      string
+	ReviewerID    string
+	ReplacedBy    string
+	Outcome       StaleOutcome
+}
+
+// DigestEntry summarises the open reviews of one digest recipient.
+type DigestEntry struct {
+	UserID          string
+	Username        string
+	Email           string
+	OpenReviews     int
+	OldestCreatedAt time.Time
+	// LastSentOn is the date of the previous digest, zero if there was none.
+	LastSentOn   time.Time
+	WorkingHours *WorkingHours
+}
+
+type IdempotencyRecord struct {
+	Key          string
+	Path         string
+	RequestHash  string
+	StatusCode   int
+	ResponseBody []byte
+	Completed    bool
+	ExpiresAt    time.Time
 }
@@ -0,0 +1,90 @@
+package notifier
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"avito-pr-reviewer/internal/businesstime"
+	"avito-pr-reviewer/internal/store"
+)
+
+const digestCheckInterval = 5 * time.Minute
+
+// Digest emails every reviewer with open reviews once a working day. Replicas
+// share the work through DigestStore claims, so each user gets a single digest.
+type Digest struct {
+	store  store.DigestStore
+	mailer *SMTP
+	calc   *businesstime.Calculator
+	// Schedule applies to recipients without working hours of their own.
+	Schedule businesstime.Schedule
+	// At is the time of day, as an offset from the recipient's local
+	// midnight, after which digests go out.
+	At time.Duration
+}
+
+func NewDigest(st store.DigestStore, mailer *SMTP, calc *businesstime.Calculator, schedule businesstime.Schedule, at time.Duration) *Digest {
+	return &Digest{store: st, mailer: mailer, calc: calc, Schedule: schedule, At: at}
+}
+
+// Run sends due digests until ctx is done.
+func (d *Digest) Run(ctx context.Context) {
+	ticker := time.NewTicker(digestCheckInterval)
+	defer ticker.Stop()
+	for {
+		if err := d.SendDue(ctx, time.Now()); err != nil {
+			log.Printf("digest: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendDue sends digests to recipients for whom it is a working day past the
+// configured time of day and who have not had today's digest yet.
+func (d *Digest) SendDue(ctx context.Context, now time.Time) error {
+	recipients, err := d.store.ListDigestRecipients(ctx)
+	if err != nil {
+		return err
+	}
+	for _, r := range recipients {
+		s := d.Schedule
+		if r.WorkingHours != nil {
+			if parsed, err := businesstime.Parse(*r.WorkingHours); err == nil {
+				s = parsed
+			}
+		}
+		if !d.calc.IsWorkingDay(now, s) {
+			continue
+		}
+		local := now.In(s.Location)
+		midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, s.Location)
+		if local.Sub(midnight) < d.At {
+			continue
+		}
+		day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+		if !r.LastSentOn.Before(day) {
+			continue
+		}
+
+		claimed, err := d.store.ClaimEmailDigest(ctx, r.UserID, day)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			continue
+		}
+		if err := d.mailer.SendDigest(ctx, r, d.calc.WorkingDays(r.OldestCreatedAt, now, s)); err != nil {
+			log.Printf("digest: %v", err)
+			// Let the next run retry.
+			if err := d.store.ReleaseEmailDigest(ctx, r.UserID, day); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"avito-pr-reviewer/internal/model"
+)
+
+// Notification tells a single user about a change to a pull request they
+// review, or, for a missed deadline, that a reviewer in their team is late.
+type Notification struct {
+	Type          model.EventType
+	UserID        string
+	PR            *model.PullRequest
+	OldReviewerID string
+	ReviewerID    string
+	DueAt         *time.Time
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+// Multi delivers every notification through all of its notifiers.
+type Multi []Notifier
+
+func (m Multi) Notify(ctx context.Context, n Notification) error {
+	var errs []error
+	for _, nt := range m {
+		if err := nt.Notify(ctx, n); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
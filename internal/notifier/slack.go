@@ -0,0 +1,159 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"avito-pr-reviewer/internal/model"
+	"avito-pr-reviewer/internal/store"
+)
+
+type SlackConfig struct {
+	// BaseURL is the Web API root, e.g. https://slack.com/api.
+	BaseURL    string
+	Token      string
+	MaxRetries int
+	// Backoff is the delay before the first retry; it doubles on every attempt
+	// unless Slack asks for a specific one via Retry-After.
+	Backoff   time.Duration
+	Templates map[model.EventType]string
+}
+
+// Slack sends notifications as direct messages through chat.postMessage.
+// Users without a Slack mapping are skipped.
+type Slack struct {
+	cfg       SlackConfig
+	client    *http.Client
+	users     store.SlackUserStore
+	templates map[model.EventType]*template.Template
+}
+
+var _ Notifier = (*Slack)(nil)
+
+func NewSlack(cfg SlackConfig, users store.SlackUserStore) (*Slack, error) {
+	templates, err := parseTemplates(cfg.Templates)
+	if err != nil {
+		return nil, err
+	}
+	cfg.BaseURL = strings.TrimRight(cfg.BaseURL, "/")
+	return &Slack{
+		cfg:       cfg,
+		client:    &http.Client{Timeout: 10 * time.Second},
+		users:     users,
+		templates: templates,
+	}, nil
+}
+
+func (s *Slack) Notify(ctx context.Context, n Notification) error {
+	t, ok := s.templates[n.Type]
+	if !ok {
+		return nil
+	}
+	channel, err := s.users.GetSlackUserID(ctx, n.UserID)
+	if errors.Is(err, model.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("slack: lookup %s: %w", n.UserID, err)
+	}
+	text, err := render(t, n)
+	if err != nil {
+		return fmt.Errorf("slack: render %s: %w", n.Type, err)
+	}
+	return s.postMessage(ctx, channel, text)
+}
+
+type slackMessage struct {
+	Channel string `json:"channel"`
+	Text    string `json:"text"`
+}
+
+type slackResponse struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+}
+
+// retryableError is returned for failures worth another attempt.
+type retryableError struct {
+	err   error
+	after time.Duration
+}
+
+func (e *retryableError) Error() string { return e.err.Error() }
+
+func (e *retryableError) Unwrap() error { return e.err }
+
+func (s *Slack) postMessage(ctx context.Context, channel, text string) error {
+	body, err := json.Marshal(slackMessage{Channel: channel, Text: text})
+	if err != nil {
+		return err
+	}
+
+	backoff := s.cfg.Backoff
+	for attempt := 0; ; attempt++ {
+		err := s.send(ctx, body)
+		var retry *retryableError
+		if err == nil || !errors.As(err, &retry) || attempt >= s.cfg.MaxRetries {
+			if err != nil {
+				return fmt.Errorf("slack: chat.postMessage: %w", err)
+			}
+			return nil
+		}
+
+		wait := backoff
+		if retry.after > 0 {
+			wait = retry.after
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+		backoff *= 2
+	}
+}
+
+func (s *Slack) send(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.BaseURL+"/chat.postMessage", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Authorization", "Bearer "+s.cfg.Token)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return err
+		}
+		return &retryableError{err: err}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+		io.Copy(io.Discard, resp.Body)
+		after, _ := strconv.Atoi(resp.Header.Get("Retry-After"))
+		return &retryableError{err: fmt.Errorf("status %d", resp.StatusCode), after: time.Duration(after) * time.Second}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("status %d", resp.StatusCode)
+	}
+
+	var res slackResponse
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	if !res.OK {
+		return fmt.Errorf("slack error %q", res.Error)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package notifier_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"avito-pr-reviewer/internal/model"
+	"avito-pr-reviewer/internal/notifier"
+	"avito-pr-reviewer/internal/notifier/slacktest"
+)
+
+type slackUsers map[string]string
+
+func (u slackUsers) GetSlackUserID(ctx context.Context, userID string) (string, error) {
+	id, ok := u[userID]
+	if !ok {
+		return "", model.ErrNotFound
+	}
+	return id, nil
+}
+
+func newSlack(t *testing.T, token string) (*notifier.Slack, *slacktest.Fake) {
+	t.Helper()
+
+	fake := slacktest.NewFake("xoxb-test")
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	slack, err := notifier.NewSlack(notifier.SlackConfig{
+		BaseURL:    srv.URL,
+		Token:      token,
+		MaxRetries: 2,
+		Backoff:    time.Millisecond,
+	}, slackUsers{"u2": "U02", "u3": "U03"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return slack, fake
+}
+
+var pr = &model.PullRequest{ID: "pr-1", Name: "Add search", AuthorID: "u1"}
+
+func TestSlackNotify(t *testing.T) {
+	slack, fake := newSlack(t, "xoxb-test")
+	ctx := context.Background()
+
+	if err := slack.Notify(ctx, notifier.Notification{Type: model.EventReviewAssigned, UserID: "u2", PR: pr}); err != nil {
+		t.Fatal(err)
+	}
+	err := slack.Notify(ctx, notifier.Notification{
+		Type:          model.EventReviewReassigned,
+		UserID:        "u3",
+		PR:            pr,
+		OldReviewerID: "u2",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// No Slack account linked: nothing is sent.
+	if err := slack.Notify(ctx, notifier.Notification{Type: model.EventReviewAssigned, UserID: "u4", PR: pr}); err != nil {
+		t.Fatal(err)
+	}
+
+	msgs := fake.Messages()
+	if len(msgs) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(msgs))
+	}
+	if msgs[0].Channel != "U02" || !strings.Contains(msgs[0].Text, "*Add search*") {
+		t.Fatalf("unexpected assignment message: %+v", msgs[0])
+	}
+	if msgs[1].Channel != "U03" || !strings.Contains(msgs[1].Text, "replaced u2") {
+		t.Fatalf("unexpected reassignment message: %+v", msgs[1])
+	}
+}
+
+func TestSlackRetries(t *testing.T) {
+	slack, fake := newSlack(t, "xoxb-test")
+	n := notifier.Notification{Type: model.EventReviewAssigned, UserID: "u2", PR: pr}
+
+	fake.FailNext(http.StatusServiceUnavailable, http.StatusTooManyRequests)
+	if err := slack.Notify(context.Background(), n); err != nil {
+		t.Fatalf("expected delivery after retries, got %v", err)
+	}
+
+	fake.FailNext(http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError)
+	if err := slack.Notify(context.Background(), n); err == nil {
+		t.Fatal("expected error once retries are exhausted")
+	}
+
+	if got := len(fake.Messages()); got != 1 {
+		t.Fatalf("expected 1 delivered message, got %d", got)
+	}
+}
+
+func TestSlackAPIError(t *testing.T) {
+	slack, fake := newSlack(t, "xoxb-wrong")
+
+	err := slack.Notify(context.Background(), notifier.Notification{Type: model.EventReviewAssigned, UserID: "u2", PR: pr})
+	if err == nil || !strings.Contains(err.Error(), "invalid_auth") {
+		t.Fatalf("expected invalid_auth error, got %v", err)
+	}
+	if got := len(fake.Messages()); got != 0 {
+		t.Fatalf("expected no messages, got %d", got)
+	}
+}
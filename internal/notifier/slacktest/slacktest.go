@@ -0,0 +1,99 @@
+// Package slacktest provides a fake Slack Web API for tests and local runs.
+package slacktest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Message is a chat.postMessage call received by the fake.
+type Message struct {
+	Channel string `json:"channel"`
+	Text    string `json:"text"`
+}
+
+// Fake answers chat.postMessage like Slack does and records every delivered
+// message. It rejects requests that do not carry Token.
+type Fake struct {
+	Token string
+	// OnMessage, if set, is called for every delivered message.
+	OnMessage func(Message)
+
+	mu       sync.Mutex
+	messages []Message
+	failures []int
+}
+
+func NewFake(token string) *Fake {
+	return &Fake{Token: token}
+}
+
+// FailNext makes the next requests fail with the given HTTP statuses, in order.
+func (f *Fake) FailNext(statuses ...int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failures = append(f.failures, statuses...)
+}
+
+func (f *Fake) Messages() []Message {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]Message(nil), f.messages...)
+}
+
+func (f *Fake) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.URL.Path != "/chat.postMessage" {
+		http.NotFound(w, r)
+		return
+	}
+
+	f.mu.Lock()
+	if len(f.failures) > 0 {
+		status := f.failures[0]
+		f.failures = f.failures[1:]
+		f.mu.Unlock()
+		w.WriteHeader(status)
+		return
+	}
+	f.mu.Unlock()
+
+	if r.Header.Get("Authorization") != "Bearer "+f.Token {
+		reply(w, false, "invalid_auth")
+		return
+	}
+	var msg Message
+	if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+		reply(w, false, "invalid_json")
+		return
+	}
+	if msg.Channel == "" {
+		reply(w, false, "channel_not_found")
+		return
+	}
+	if msg.Text == "" {
+		reply(w, false, "no_text")
+		return
+	}
+
+	f.mu.Lock()
+	f.messages = append(f.messages, msg)
+	f.mu.Unlock()
+	if f.OnMessage != nil {
+		f.OnMessage(msg)
+	}
+	reply(w, true, "")
+}
+
+func reply(w http.ResponseWriter, ok bool, errCode string) {
+	res := map[string]any{"ok": ok}
+	if ok {
+		res["ts"] = strconv.FormatFloat(float64(time.Now().UnixMicro())/1e6, 'f', 6, 64)
+	} else {
+		res["error"] = errCode
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(res)
+}
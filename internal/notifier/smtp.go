@@ -0,0 +1,195 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	texttemplate "text/template"
+	"time"
+
+	"avito-pr-reviewer/internal/model"
+	"avito-pr-reviewer/internal/store"
+)
+
+//go:embed templates/*.tmpl
+var emailTemplates embed.FS
+
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+// SMTP sends assignment emails and review digests. Users without an email
+// address or who opted out are skipped.
+type SMTP struct {
+	cfg   SMTPConfig
+	users store.UserStore
+	text  *texttemplate.Template
+	html  *htmltemplate.Template
+}
+
+var _ Notifier = (*SMTP)(nil)
+
+func NewSMTP(cfg SMTPConfig, users store.UserStore) (*SMTP, error) {
+	text, err := texttemplate.ParseFS(emailTemplates, "templates/*.txt.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("email templates: %w", err)
+	}
+	html, err := htmltemplate.ParseFS(emailTemplates, "templates/*.html.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("email templates: %w", err)
+	}
+	return &SMTP{cfg: cfg, users: users, text: text, html: html}, nil
+}
+
+func (m *SMTP) Notify(ctx context.Context, n Notification) error {
+	if m.text.Lookup(string(n.Type)+".subject") == nil {
+		return nil
+	}
+	user, err := m.users.GetUser(ctx, n.UserID)
+	if err != nil {
+		return fmt.Errorf("smtp: lookup %s: %w", n.UserID, err)
+	}
+	if user.Email == "" || user.EmailOptOut {
+		return nil
+	}
+
+	data := struct {
+		Notification
+		User *model.User
+	}{n, user}
+	return m.sendTemplate(ctx, user.Email, string(n.Type), data)
+}
+
+// SendDigest emails a summary of the recipient's open reviews, the oldest of
+// which has been waiting for oldestDays working days.
+func (m *SMTP) SendDigest(ctx context.Context, d model.DigestEntry, oldestDays int) error {
+	data := struct {
+		model.DigestEntry
+		OldestDays int
+	}{d, oldestDays}
+	return m.sendTemplate(ctx, d.Email, "digest", data)
+}
+
+func (m *SMTP) sendTemplate(ctx context.Context, to, name string, data any) error {
+	var subject, text, html bytes.Buffer
+	if err := m.text.ExecuteTemplate(&subject, name+".subject", data); err != nil {
+		return fmt.Errorf("smtp: render %s: %w", name, err)
+	}
+	if err := m.text.ExecuteTemplate(&text, name+".text", data); err != nil {
+		return fmt.Errorf("smtp: render %s: %w", name, err)
+	}
+	if err := m.html.ExecuteTemplate(&html, name+".html", data); err != nil {
+		return fmt.Errorf("smtp: render %s: %w", name, err)
+	}
+
+	msg, err := buildMessage(m.cfg.From, to, subject.String(), text.Bytes(), html.Bytes())
+	if err != nil {
+		return fmt.Errorf("smtp: build %s: %w", name, err)
+	}
+	if err := m.send(ctx, to, msg); err != nil {
+		return fmt.Errorf("smtp: send %s to %s: %w", name, to, err)
+	}
+	return nil
+}
+
+func (m *SMTP) send(ctx context.Context, to string, msg []byte) error {
+	addr := net.JoinHostPort(m.cfg.Host, strconv.Itoa(m.cfg.Port))
+	d := net.Dialer{Timeout: 10 * time.Second}
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	c, err := smtp.NewClient(conn, m.cfg.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: m.cfg.Host}); err != nil {
+			return err
+		}
+	}
+	if m.cfg.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", m.cfg.Username, m.cfg.Password, m.cfg.Host)); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(m.cfg.From); err != nil {
+		return err
+	}
+	if err := c.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// buildMessage assembles a multipart/alternative message with text and HTML bodies.
+func buildMessage(from, to, subject string, text, html []byte) ([]byte, error) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	for _, part := range []struct {
+		contentType string
+		content     []byte
+	}{
+		{"text/plain; charset=utf-8", text},
+		{"text/html; charset=utf-8", html},
+	} {
+		pw, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		qw := quotedprintable.NewWriter(pw)
+		if _, err := qw.Write(part.content); err != nil {
+			return nil, err
+		}
+		if err := qw.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", from)
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&msg, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", mw.Boundary())
+	msg.Write(body.Bytes())
+	return msg.Bytes(), nil
+}
@@ -0,0 +1,156 @@
+package notifier_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"avito-pr-reviewer/internal/businesstime"
+	"avito-pr-reviewer/internal/model"
+	"avito-pr-reviewer/internal/notifier"
+	"avito-pr-reviewer/internal/notifier/smtptest"
+)
+
+type users map[string]*model.User
+
+func (u users) GetUser(ctx context.Context, id string) (*model.User, error) {
+	user, ok := u[id]
+	if !ok {
+		return nil, model.ErrNotFound
+	}
+	return user, nil
+}
+
+func newSMTP(t *testing.T) (*notifier.SMTP, *smtptest.Sink) {
+	t.Helper()
+
+	sink, err := smtptest.NewSink()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sink.Close() })
+
+	host, port := sink.Addr()
+	mailer, err := notifier.NewSMTP(notifier.SMTPConfig{Host: host, Port: port, From: "reviewer@example.com"}, users{
+		"u2": {ID: "u2", Username: "Bob", Email: "bob@example.com"},
+		"u3": {ID: "u3", Username: "Carol", Email: "carol@example.com", EmailOptOut: true},
+		"u4": {ID: "u4", Username: "Dave"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mailer, sink
+}
+
+func TestSMTPNotify(t *testing.T) {
+	mailer, sink := newSMTP(t)
+	ctx := context.Background()
+	pr := &model.PullRequest{ID: "pr-1", Name: "Add <search>", AuthorID: "u1"}
+
+	for _, id := range []string{"u2", "u3", "u4"} {
+		if err := mailer.Notify(ctx, notifier.Notification{Type: model.EventReviewAssigned, UserID: id, PR: pr}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	msgs := sink.Messages()
+	if len(msgs) != 1 {
+		t.Fatalf("expected 1 email (opted out and address-less users skipped), got %d", len(msgs))
+	}
+	m := msgs[0]
+	if m.From != "reviewer@example.com" || len(m.To) != 1 || m.To[0] != "bob@example.com" {
+		t.Fatalf("unexpected envelope: %+v", m)
+	}
+	for _, want := range []string{
+		"Subject: Review requested: Add <search>",
+		"Content-Type: multipart/alternative",
+		"Content-Type: text/plain; charset=utf-8",
+		"Content-Type: text/html; charset=utf-8",
+		"Hi Bob,",
+		"<strong>Add &lt;search&gt;</strong>",
+	} {
+		if !strings.Contains(m.Data, want) {
+			t.Errorf("email does not contain %q:\n%s", want, m.Data)
+		}
+	}
+}
+
+type digestStore struct {
+	entries []model.DigestEntry
+	sent    map[string]time.Time
+}
+
+func (s *digestStore) ListDigestRecipients(ctx context.Context) ([]model.DigestEntry, error) {
+	return s.entries, nil
+}
+
+func (s *digestStore) ClaimEmailDigest(ctx context.Context, userID string, day time.Time) (bool, error) {
+	if last, ok := s.sent[userID]; ok && !last.Before(day) {
+		return false, nil
+	}
+	s.sent[userID] = day
+	return true, nil
+}
+
+func (s *digestStore) ReleaseEmailDigest(ctx context.Context, userID string, day time.Time) error {
+	delete(s.sent, userID)
+	return nil
+}
+
+func TestDigest(t *testing.T) {
+	mailer, sink := newSMTP(t)
+	now := time.Date(2025, 10, 24, 10, 0, 0, 0, time.UTC)
+	st := &digestStore{
+		entries: []model.DigestEntry{{
+			UserID:          "u2",
+			Username:        "Bob",
+			Email:           "bob@example.com",
+			OpenReviews:     3,
+			OldestCreatedAt: now.Add(-50 * time.Hour),
+		}, {
+			UserID:          "u5",
+			Username:        "Erin",
+			Email:           "erin@example.com",
+			OpenReviews:     1,
+			OldestCreatedAt: now.Add(-time.Hour),
+			// 13:00 in New York, but Friday is not one of Erin's working days.
+			WorkingHours: &model.WorkingHours{Timezone: "America/New_York", Start: "09:00", End: "17:00", Days: []string{"mon", "tue"}},
+		}},
+		sent: map[string]time.Time{},
+	}
+	digest := notifier.NewDigest(st, mailer, businesstime.NewCalculator(nil), businesstime.Default, 9*time.Hour)
+
+	// Before the configured time nothing is sent.
+	if err := digest.SendDue(context.Background(), now.Add(-2*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(sink.Messages()); got != 0 {
+		t.Fatalf("expected no digest before 09:00, got %d", got)
+	}
+
+	// Second run on the same day does not send again.
+	for i := 0; i < 2; i++ {
+		if err := digest.SendDue(context.Background(), now); err != nil {
+			t.Fatal(err)
+		}
+	}
+	msgs := sink.Messages()
+	if len(msgs) != 1 {
+		t.Fatalf("expected 1 digest, got %d", len(msgs))
+	}
+	// Wednesday 08:00 to Friday 10:00 is 19 working hours.
+	for _, want := range []string{"Subject: You have 3 open reviews", "the oldest is 2 working days old"} {
+		if !strings.Contains(msgs[0].Data, want) {
+			t.Errorf("digest does not contain %q:\n%s", want, msgs[0].Data)
+		}
+	}
+
+	// No digests go out on the weekend.
+	if err := digest.SendDue(context.Background(), now.Add(24*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(sink.Messages()); got != 1 {
+		t.Fatalf("expected no digest on Saturday, got %d", got-1)
+	}
+}
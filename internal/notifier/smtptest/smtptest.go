@@ -0,0 +1,125 @@
+// Package smtptest provides an in-process SMTP server that keeps every message
+// it receives, for tests.
+package smtptest
+
+import (
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+)
+
+type Message struct {
+	From string
+	To   []string
+	Data string
+}
+
+// Sink accepts any mail on a local port. It supports the plain SMTP subset
+// used by net/smtp without TLS or authentication.
+type Sink struct {
+	ln net.Listener
+	wg sync.WaitGroup
+
+	mu       sync.Mutex
+	messages []Message
+}
+
+func NewSink() (*Sink, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &Sink{ln: ln}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+// Addr returns the host and port the sink listens on.
+func (s *Sink) Addr() (host string, port int) {
+	a := s.ln.Addr().(*net.TCPAddr)
+	return a.IP.String(), a.Port
+}
+
+func (s *Sink) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Message(nil), s.messages...)
+}
+
+func (s *Sink) Close() error {
+	err := s.ln.Close()
+	s.wg.Wait()
+	return err
+}
+
+func (s *Sink) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close()
+			s.handle(textproto.NewConn(conn))
+		}()
+	}
+}
+
+func (s *Sink) handle(c *textproto.Conn) {
+	c.PrintfLine("220 smtptest ready")
+
+	var msg Message
+	for {
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			c.PrintfLine("250 smtptest")
+		case "MAIL":
+			msg = Message{From: address(arg)}
+			c.PrintfLine("250 OK")
+		case "RCPT":
+			msg.To = append(msg.To, address(arg))
+			c.PrintfLine("250 OK")
+		case "DATA":
+			c.PrintfLine("354 end data with <CR><LF>.<CR><LF>")
+			data, err := c.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			msg.Data = string(data)
+			s.mu.Lock()
+			s.messages = append(s.messages, msg)
+			s.mu.Unlock()
+			c.PrintfLine("250 OK")
+		case "RSET":
+			msg = Message{}
+			c.PrintfLine("250 OK")
+		case "NOOP":
+			c.PrintfLine("250 OK")
+		case "QUIT":
+			c.PrintfLine("221 bye")
+			return
+		default:
+			c.PrintfLine("502 command not implemented")
+		}
+	}
+}
+
+// address extracts the mailbox from "FROM:<a@b>" or "TO:<a@b>".
+func address(arg string) string {
+	_, addr, _ := strings.Cut(arg, ":")
+	addr = strings.TrimSpace(addr)
+	if i := strings.IndexByte(addr, ' '); i >= 0 {
+		addr = addr[:i]
+	}
+	return strings.Trim(addr, "<>")
+}
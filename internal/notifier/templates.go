@@ -0,0 +1,46 @@
+package notifier
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"avito-pr-reviewer/internal/model"
+)
+
+// DefaultSlackTemplates are used for event types that have no template in SlackConfig.
+var DefaultSlackTemplates = map[model.EventType]string{
+	model.EventReviewAssigned: ":eyes: You were assigned to review *{{.PR.Name}}* (`{{.PR.ID}}`) by {{.PR.AuthorID}}.",
+	model.EventReviewReassigned: ":arrows_counterclockwise: You replaced {{.OldReviewerID}} as a reviewer of " +
+		"*{{.PR.Name}}* (`{{.PR.ID}}`) by {{.PR.AuthorID}}.",
+	model.EventSLABreached: ":rotating_light: {{.ReviewerID}} has not responded to *{{.PR.Name}}* (`{{.PR.ID}}`) " +
+		"by {{.PR.AuthorID}} in time.",
+}
+
+func parseTemplates(overrides map[model.EventType]string) (map[model.EventType]*template.Template, error) {
+	texts := make(map[model.EventType]string, len(DefaultSlackTemplates))
+	for typ, text := range DefaultSlackTemplates {
+		texts[typ] = text
+	}
+	for typ, text := range overrides {
+		texts[typ] = text
+	}
+
+	res := make(map[model.EventType]*template.Template, len(texts))
+	for typ, text := range texts {
+		t, err := template.New(string(typ)).Option("missingkey=error").Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("template %s: %w", typ, err)
+		}
+		res[typ] = t
+	}
+	return res, nil
+}
+
+func render(t *template.Template, n Notification) (string, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, n); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
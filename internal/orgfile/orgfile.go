@@ -0,0 +1,469 @@
+// Package orgfile reads and writes teams with their members as CSV or YAML,
+// for bulk import and export.
+//
+// A CSV file has a header naming its columns, in any order: team_name,
+// user_id and username are required, parent_team_name, lead_assignment,
+// is_active, seniority, role and is_primary are optional. Every record is one
+// membership; a record without user_id only describes the team. Team columns
+// may be left empty on all but one of a team's records. Lines starting with
+// # are comments.
+//
+// A YAML file has a list of teams, each with its members:
+//
+//	teams:
+//	  - team_name: payments
+//	    parent_team_name: backend
+//	    members:
+//	      - user_id: u1
+//	        username: Alice
+//	        role: lead
+package orgfile
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"avito-pr-reviewer/internal/model"
+
+	"gopkg.in/yaml.v3"
+)
+
+type Format string
+
+const (
+	CSV  Format = "csv"
+	YAML Format = "yaml"
+)
+
+func (f Format) Valid() bool {
+	return f == CSV || f == YAML
+}
+
+// File is the teams of a file in the order they first appear.
+type File struct {
+	Teams []Team `yaml:"teams"`
+}
+
+// Team is a team with the members listed for it. Empty fields are left as
+// they are on import.
+type Team struct {
+	Name           string               `yaml:"team_name"`
+	Parent         string               `yaml:"parent_team_name,omitempty"`
+	LeadAssignment model.LeadAssignment `yaml:"lead_assignment,omitempty"`
+	Members        []Member             `yaml:"members"`
+	// Line is where the team is first described.
+	Line int `yaml:"-"`
+}
+
+// Member is a user's membership in a team. IsActive is nil when the file does
+// not say.
+type Member struct {
+	UserID    string          `yaml:"user_id"`
+	Username  string          `yaml:"username"`
+	IsActive  *bool           `yaml:"is_active,omitempty"`
+	Seniority model.Seniority `yaml:"seniority,omitempty"`
+	Role      model.TeamRole  `yaml:"role,omitempty"`
+	Primary   bool            `yaml:"is_primary,omitempty"`
+	Line      int             `yaml:"-"`
+}
+
+// Error is a problem with one row of a file: a CSV record, or a YAML team or
+// member.
+type Error struct {
+	Line    int
+	Field   string
+	Message string
+}
+
+func (e Error) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+	}
+	return fmt.Sprintf("line %d: %s: %s", e.Line, e.Field, e.Message)
+}
+
+// Errors are all the problems found in a file, by line. They match
+// model.ErrInvalidImport.
+type Errors []Error
+
+func (e Errors) Error() string {
+	msg := fmt.Sprintf("%v: %v", model.ErrInvalidImport, e[0])
+	if len(e) > 1 {
+		msg += fmt.Sprintf(" (and %d more)", len(e)-1)
+	}
+	return msg
+}
+
+func (e Errors) Unwrap() error { return model.ErrInvalidImport }
+
+// Parse reads a whole file and checks every row. Problems in the content are
+// returned together as Errors.
+func Parse(format Format, data []byte) (*File, error) {
+	var (
+		f    *File
+		errs Errors
+	)
+	switch format {
+	case CSV:
+		f, errs = parseCSV(data)
+	case YAML:
+		f, errs = parseYAML(data)
+	default:
+		return nil, fmt.Errorf("%w: unknown format %q", model.ErrInvalidImport, format)
+	}
+	errs = append(errs, check(f)...)
+	if len(errs) > 0 {
+		sort.SliceStable(errs, func(i, j int) bool { return errs[i].Line < errs[j].Line })
+		return nil, errs
+	}
+	return f, nil
+}
+
+var csvColumns = []string{"team_name", "parent_team_name", "lead_assignment", "user_id", "username", "is_active", "seniority", "role", "is_primary"}
+
+func parseCSV(data []byte) (*File, Errors) {
+	f := &File{}
+	var errs Errors
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comment = '#'
+	r.TrimLeadingSpace = true
+	r.FieldsPerRecord = -1
+
+	header, err := r.Read()
+	if err == io.EOF {
+		return f, nil
+	}
+	if err != nil {
+		return f, Errors{csvError(err)}
+	}
+	cols := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if !contains(csvColumns, name) {
+			errs = append(errs, Error{Line: 1, Field: name, Message: "unknown column"})
+		}
+		cols[name] = i
+	}
+	for _, name := range []string{"team_name", "user_id", "username"} {
+		if _, ok := cols[name]; !ok {
+			errs = append(errs, Error{Line: 1, Field: name, Message: "missing column"})
+		}
+	}
+	if len(errs) > 0 {
+		return f, errs
+	}
+
+	teams := make(map[string]int)
+	for {
+		rec, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errs = append(errs, csvError(err))
+			continue
+		}
+		line, _ := r.FieldPos(0)
+		get := func(name string) string {
+			if i, ok := cols[name]; ok && i < len(rec) {
+				return strings.TrimSpace(rec[i])
+			}
+			return ""
+		}
+
+		name := get("team_name")
+		i, ok := teams[name]
+		if !ok {
+			i = len(f.Teams)
+			teams[name] = i
+			f.Teams = append(f.Teams, Team{Name: name, Line: line})
+		}
+		t := &f.Teams[i]
+		if v := get("parent_team_name"); v != "" {
+			if t.Parent != "" && t.Parent != v {
+				errs = append(errs, Error{Line: line, Field: "parent_team_name", Message: fmt.Sprintf("%s already has parent %s", name, t.Parent)})
+			}
+			t.Parent = v
+		}
+		if v := model.LeadAssignment(get("lead_assignment")); v != "" {
+			if t.LeadAssignment != "" && t.LeadAssignment != v {
+				errs = append(errs, Error{Line: line, Field: "lead_assignment", Message: fmt.Sprintf("%s already has %s", name, t.LeadAssignment)})
+			}
+			t.LeadAssignment = v
+		}
+
+		m := Member{
+			UserID:    get("user_id"),
+			Username:  get("username"),
+			Seniority: model.Seniority(get("seniority")),
+			Role:      model.TeamRole(get("role")),
+			Line:      line,
+		}
+		if m.UserID == "" {
+			if m.Username != "" || m.Seniority != "" || m.Role != "" || get("is_active") != "" || get("is_primary") != "" {
+				errs = append(errs, Error{Line: line, Field: "user_id", Message: "required for a member"})
+			}
+			continue
+		}
+		if v := get("is_active"); v != "" {
+			active, err := strconv.ParseBool(v)
+			if err != nil {
+				errs = append(errs, Error{Line: line, Field: "is_active", Message: fmt.Sprintf("%q is not true or false", v)})
+			}
+			m.IsActive = &active
+		}
+		if v := get("is_primary"); v != "" {
+			primary, err := strconv.ParseBool(v)
+			if err != nil {
+				errs = append(errs, Error{Line: line, Field: "is_primary", Message: fmt.Sprintf("%q is not true or false", v)})
+			}
+			m.Primary = primary
+		}
+		t.Members = append(t.Members, m)
+	}
+	return f, errs
+}
+
+func csvError(err error) Error {
+	var pe *csv.ParseError
+	if errors.As(err, &pe) {
+		return Error{Line: pe.Line, Message: pe.Err.Error()}
+	}
+	return Error{Message: err.Error()}
+}
+
+var (
+	teamKeys   = []string{"team_name", "parent_team_name", "lead_assignment", "members"}
+	memberKeys = []string{"user_id", "username", "is_active", "seniority", "role", "is_primary"}
+	// yamlLine finds the line in the messages of a yaml.TypeError.
+	yamlLine = regexp.MustCompile(`^line (\d+): (.*)$`)
+)
+
+func parseYAML(data []byte) (*File, Errors) {
+	f := &File{}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return f, yamlError(0, err)
+	}
+	if len(doc.Content) == 0 {
+		return f, nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return f, Errors{{Line: root.Line, Message: "expected a mapping with teams"}}
+	}
+	errs := unknownKeys(root, "teams")
+	teamsNode := value(root, "teams")
+	if teamsNode == nil {
+		return f, errs
+	}
+	if teamsNode.Kind != yaml.SequenceNode {
+		return f, append(errs, Error{Line: teamsNode.Line, Field: "teams", Message: "expected a list"})
+	}
+
+	seen := make(map[string]int)
+	for _, n := range teamsNode.Content {
+		var t Team
+		if err := n.Decode(&t); err != nil {
+			errs = append(errs, yamlError(n.Line, err)...)
+			continue
+		}
+		t.Line = n.Line
+		errs = append(errs, unknownKeys(n, teamKeys...)...)
+		if members := value(n, "members"); members != nil && members.Kind == yaml.SequenceNode {
+			for i, m := range members.Content {
+				errs = append(errs, unknownKeys(m, memberKeys...)...)
+				if i < len(t.Members) {
+					t.Members[i].Line = m.Line
+				}
+			}
+		}
+		if line, ok := seen[t.Name]; ok && t.Name != "" {
+			errs = append(errs, Error{Line: t.Line, Field: "team_name", Message: fmt.Sprintf("%s is already listed on line %d", t.Name, line)})
+			continue
+		}
+		seen[t.Name] = t.Line
+		f.Teams = append(f.Teams, t)
+	}
+	return f, errs
+}
+
+// value returns the value of key in a mapping node, or nil.
+func value(n *yaml.Node, key string) *yaml.Node {
+	if n.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func unknownKeys(n *yaml.Node, keys ...string) Errors {
+	var errs Errors
+	if n.Kind != yaml.MappingNode {
+		return errs
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if k := n.Content[i]; !contains(keys, k.Value) {
+			errs = append(errs, Error{Line: k.Line, Field: k.Value, Message: "unknown field"})
+		}
+	}
+	return errs
+}
+
+// yamlError splits a decoding error into one Error per line it names; the
+// rest are put on line.
+func yamlError(line int, err error) Errors {
+	var te *yaml.TypeError
+	if !errors.As(err, &te) {
+		msg := strings.TrimPrefix(err.Error(), "yaml: ")
+		if m := yamlLine.FindStringSubmatch(msg); m != nil {
+			n, _ := strconv.Atoi(m[1])
+			return Errors{{Line: n, Message: m[2]}}
+		}
+		return Errors{{Line: line, Message: msg}}
+	}
+	errs := make(Errors, 0, len(te.Errors))
+	for _, msg := range te.Errors {
+		e := Error{Line: line, Message: msg}
+		if m := yamlLine.FindStringSubmatch(msg); m != nil {
+			e.Line, _ = strconv.Atoi(m[1])
+			e.Message = m[2]
+		}
+		errs = append(errs, e)
+	}
+	return errs
+}
+
+// check finds what is wrong whatever the format: missing and invalid values,
+// members listed twice and users described differently in different teams.
+func check(f *File) Errors {
+	var errs Errors
+	users := make(map[string]*Member)
+	primary := make(map[string]string)
+	for _, t := range f.Teams {
+		if t.Name == "" {
+			errs = append(errs, Error{Line: t.Line, Field: "team_name", Message: "required"})
+		}
+		if t.Parent != "" && t.Parent == t.Name {
+			errs = append(errs, Error{Line: t.Line, Field: "parent_team_name", Message: "a team cannot be its own parent"})
+		}
+		if t.LeadAssignment != "" && !t.LeadAssignment.Valid() {
+			errs = append(errs, Error{Line: t.Line, Field: "lead_assignment", Message: fmt.Sprintf("unknown value %q", t.LeadAssignment)})
+		}
+		listed := make(map[string]bool, len(t.Members))
+		for i := range t.Members {
+			m := &t.Members[i]
+			switch {
+			case m.UserID == "":
+				errs = append(errs, Error{Line: m.Line, Field: "user_id", Message: "required"})
+				continue
+			case m.Username == "":
+				errs = append(errs, Error{Line: m.Line, Field: "username", Message: "required"})
+			case listed[m.UserID]:
+				errs = append(errs, Error{Line: m.Line, Field: "user_id", Message: fmt.Sprintf("%s is already a member of %s", m.UserID, t.Name)})
+			}
+			listed[m.UserID] = true
+			if m.Seniority != "" && !m.Seniority.Valid() {
+				errs = append(errs, Error{Line: m.Line, Field: "seniority", Message: fmt.Sprintf("unknown value %q", m.Seniority)})
+			}
+			if m.Role != "" && !m.Role.Valid() {
+				errs = append(errs, Error{Line: m.Line, Field: "role", Message: fmt.Sprintf("unknown value %q", m.Role)})
+			}
+			if m.Primary {
+				if team, ok := primary[m.UserID]; ok && team != t.Name {
+					errs = append(errs, Error{Line: m.Line, Field: "is_primary", Message: fmt.Sprintf("%s is already primary for %s", team, m.UserID)})
+				}
+				primary[m.UserID] = t.Name
+			}
+
+			prev, ok := users[m.UserID]
+			if !ok {
+				users[m.UserID] = m
+				continue
+			}
+			if msg := conflict(prev, m); msg != "" {
+				errs = append(errs, Error{Line: m.Line, Field: msg, Message: fmt.Sprintf("differs from line %d", prev.Line)})
+			}
+		}
+	}
+	return errs
+}
+
+// conflict names a user field that two memberships of the same user both set
+// to different values.
+func conflict(a, b *Member) string {
+	switch {
+	case a.Username != b.Username:
+		return "username"
+	case a.IsActive != nil && b.IsActive != nil && *a.IsActive != *b.IsActive:
+		return "is_active"
+	case a.Seniority != "" && b.Seniority != "" && a.Seniority != b.Seniority:
+		return "seniority"
+	}
+	return ""
+}
+
+// Write writes f in the format, every field filled in.
+func Write(w io.Writer, format Format, f *File) error {
+	switch format {
+	case CSV:
+		return writeCSV(w, f)
+	case YAML:
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(f); err != nil {
+			return err
+		}
+		return enc.Close()
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
+
+func writeCSV(w io.Writer, f *File) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvColumns); err != nil {
+		return err
+	}
+	for _, t := range f.Teams {
+		team := []string{t.Name, t.Parent, string(t.LeadAssignment)}
+		if len(t.Members) == 0 {
+			if err := cw.Write(append(team, "", "", "", "", "", "")); err != nil {
+				return err
+			}
+		}
+		for _, m := range t.Members {
+			active := ""
+			if m.IsActive != nil {
+				active = strconv.FormatBool(*m.IsActive)
+			}
+			rec := append(append([]string{}, team...),
+				m.UserID, m.Username, active, string(m.Seniority), string(m.Role), strconv.FormatBool(m.Primary))
+			if err := cw.Write(rec); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func contains(list []string, v string) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,155 @@
+package orgfile
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"avito-pr-reviewer/internal/model"
+)
+
+const sampleCSV = `team_name,parent_team_name,lead_assignment,user_id,username,is_active,seniority,role,is_primary
+# Backend
+backend,,,,,,,,
+payments,backend,last_resort,u1,Alice,true,senior,lead,true
+payments,,,u2,Bob,,,,
+search,backend,,u2,Bob,false,junior,maintainer,
+`
+
+const sampleYAML = `teams:
+  - team_name: backend
+    members: []
+  - team_name: payments
+    parent_team_name: backend
+    lead_assignment: last_resort
+    members:
+      - user_id: u1
+        username: Alice
+        is_active: true
+        seniority: senior
+        role: lead
+        is_primary: true
+      - user_id: u2
+        username: Bob
+  - team_name: search
+    parent_team_name: backend
+    members:
+      - user_id: u2
+        username: Bob
+        is_active: false
+        seniority: junior
+        role: maintainer
+`
+
+func TestParse(t *testing.T) {
+	for _, tt := range []struct {
+		format Format
+		data   string
+	}{{CSV, sampleCSV}, {YAML, sampleYAML}} {
+		f, err := Parse(tt.format, []byte(tt.data))
+		if err != nil {
+			t.Fatalf("%s: %v", tt.format, err)
+		}
+		if len(f.Teams) != 3 {
+			t.Fatalf("%s: got %d teams", tt.format, len(f.Teams))
+		}
+		pay := f.Teams[1]
+		if pay.Name != "payments" || pay.Parent != "backend" || pay.LeadAssignment != model.LeadsLastResort {
+			t.Errorf("%s: payments = %+v", tt.format, pay)
+		}
+		if len(pay.Members) != 2 || pay.Members[0].Role != model.RoleLead || !pay.Members[0].Primary || pay.Members[1].IsActive != nil {
+			t.Errorf("%s: payments members = %+v", tt.format, pay.Members)
+		}
+		bob := f.Teams[2].Members[0]
+		if bob.IsActive == nil || *bob.IsActive || bob.Seniority != model.SeniorityJunior || bob.Line == 0 {
+			t.Errorf("%s: search member = %+v", tt.format, bob)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	want, err := Parse(YAML, []byte(sampleYAML))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, format := range []Format{CSV, YAML} {
+		var buf bytes.Buffer
+		if err := Write(&buf, format, want); err != nil {
+			t.Fatal(err)
+		}
+		got, err := Parse(format, buf.Bytes())
+		if err != nil {
+			t.Fatalf("%s: %v\n%s", format, err, buf.String())
+		}
+		if names(got) != names(want) {
+			t.Errorf("%s: teams %s, want %s", format, names(got), names(want))
+		}
+		for i := range want.Teams {
+			for j := range want.Teams[i].Members {
+				g, w := got.Teams[i].Members[j], want.Teams[i].Members[j]
+				g.Line, w.Line = 0, 0
+				if !reflect.DeepEqual(g, w) {
+					t.Errorf("%s: member %+v, want %+v", format, g, w)
+				}
+			}
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		format Format
+		data   string
+		want   []Error
+	}{
+		{CSV, "team_name,user_id\n", []Error{{Line: 1, Field: "username", Message: "missing column"}}},
+		{CSV, "team_name,user_id,username,color\n", []Error{{Line: 1, Field: "color", Message: "unknown column"}}},
+		{CSV, "team_name,user_id,username,role,is_active\n" +
+			"a,u1,Alice,boss,\n" +
+			",u2,Bob,,maybe\n" +
+			"a,u1,Alice,,\n",
+			[]Error{
+				{Line: 2, Field: "role", Message: `unknown value "boss"`},
+				{Line: 3, Field: "is_active", Message: `"maybe" is not true or false`},
+				{Line: 3, Field: "team_name", Message: "required"},
+				{Line: 4, Field: "user_id", Message: "u1 is already a member of a"},
+			}},
+		{CSV, "team_name,parent_team_name,user_id,username\n" +
+			"a,b,u1,Alice\n" +
+			"a,c,u2,Alan\n" +
+			"b,,u1,Alicia\n",
+			[]Error{
+				{Line: 3, Field: "parent_team_name", Message: "a already has parent b"},
+				{Line: 4, Field: "username", Message: "differs from line 2"},
+			}},
+		{YAML, "teams:\n  - team_name: a\n    colour: red\n  - team_name: a\n", []Error{
+			{Line: 3, Field: "colour", Message: "unknown field"},
+			{Line: 4, Field: "team_name", Message: "a is already listed on line 2"},
+		}},
+		{YAML, "teams:\n  - team_name: a\n    parent_team_name: a\n    members:\n      - user_id: u1\n", []Error{
+			{Line: 2, Field: "parent_team_name", Message: "a team cannot be its own parent"},
+			{Line: 5, Field: "username", Message: "required"},
+		}},
+	}
+	for _, tt := range tests {
+		_, err := Parse(tt.format, []byte(tt.data))
+		if !errors.Is(err, model.ErrInvalidImport) {
+			t.Errorf("%q: err = %v, want ErrInvalidImport", tt.data, err)
+			continue
+		}
+		var got Errors
+		errors.As(err, &got)
+		if !reflect.DeepEqual([]Error(got), tt.want) {
+			t.Errorf("%q:\n got %v\nwant %v", tt.data, got, tt.want)
+		}
+	}
+}
+
+func names(f *File) string {
+	var s string
+	for _, t := range f.Teams {
+		s += t.Name + "<" + t.Parent + " "
+	}
+	return s
+}
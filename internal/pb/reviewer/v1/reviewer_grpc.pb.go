@@ -0,0 +1,467 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: reviewer/v1/reviewer.proto
+
+package reviewerv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ReviewerService_CreateTeam_FullMethodName        = "/reviewer.v1.ReviewerService/CreateTeam"
+	ReviewerService_GetTeam_FullMethodName           = "/reviewer.v1.ReviewerService/GetTeam"
+	ReviewerService_GetUser_FullMethodName           = "/reviewer.v1.ReviewerService/GetUser"
+	ReviewerService_SetUserActive_FullMethodName     = "/reviewer.v1.ReviewerService/SetUserActive"
+	ReviewerService_MassDeactivate_FullMethodName    = "/reviewer.v1.ReviewerService/MassDeactivate"
+	ReviewerService_GetUserReviews_FullMethodName    = "/reviewer.v1.ReviewerService/GetUserReviews"
+	ReviewerService_CreatePullRequest_FullMethodName = "/reviewer.v1.ReviewerService/CreatePullRequest"
+	ReviewerService_MergePullRequest_FullMethodName  = "/reviewer.v1.ReviewerService/MergePullRequest"
+	ReviewerService_ReassignReviewer_FullMethodName  = "/reviewer.v1.ReviewerService/ReassignReviewer"
+	ReviewerService_GetReviewerStats_FullMethodName  = "/reviewer.v1.ReviewerService/GetReviewerStats"
+)
+
+// ReviewerServiceClient is the client API for ReviewerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ReviewerService mirrors the HTTP API described in openapi.yaml.
+type ReviewerServiceClient interface {
+	CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*CreateTeamResponse, error)
+	GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*GetTeamResponse, error)
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	SetUserActive(ctx context.Context, in *SetUserActiveRequest, opts ...grpc.CallOption) (*SetUserActiveResponse, error)
+	MassDeactivate(ctx context.Context, in *MassDeactivateRequest, opts ...grpc.CallOption) (*MassDeactivateResponse, error)
+	GetUserReviews(ctx context.Context, in *GetUserReviewsRequest, opts ...grpc.CallOption) (*GetUserReviewsResponse, error)
+	CreatePullRequest(ctx context.Context, in *CreatePullRequestRequest, opts ...grpc.CallOption) (*CreatePullRequestResponse, error)
+	MergePullRequest(ctx context.Context, in *MergePullRequestRequest, opts ...grpc.CallOption) (*MergePullRequestResponse, error)
+	ReassignReviewer(ctx context.Context, in *ReassignReviewerRequest, opts ...grpc.CallOption) (*ReassignReviewerResponse, error)
+	GetReviewerStats(ctx context.Context, in *GetReviewerStatsRequest, opts ...grpc.CallOption) (*GetReviewerStatsResponse, error)
+}
+
+type reviewerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReviewerServiceClient(cc grpc.ClientConnInterface) ReviewerServiceClient {
+	return &reviewerServiceClient{cc}
+}
+
+func (c *reviewerServiceClient) CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*CreateTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTeamResponse)
+	err := c.cc.Invoke(ctx, ReviewerService_CreateTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*GetTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamResponse)
+	err := c.cc.Invoke(ctx, ReviewerService_GetTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, ReviewerService_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) SetUserActive(ctx context.Context, in *SetUserActiveRequest, opts ...grpc.CallOption) (*SetUserActiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserActiveResponse)
+	err := c.cc.Invoke(ctx, ReviewerService_SetUserActive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) MassDeactivate(ctx context.Context, in *MassDeactivateRequest, opts ...grpc.CallOption) (*MassDeactivateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MassDeactivateResponse)
+	err := c.cc.Invoke(ctx, ReviewerService_MassDeactivate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) GetUserReviews(ctx context.Context, in *GetUserReviewsRequest, opts ...grpc.CallOption) (*GetUserReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserReviewsResponse)
+	err := c.cc.Invoke(ctx, ReviewerService_GetUserReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) CreatePullRequest(ctx context.Context, in *CreatePullRequestRequest, opts ...grpc.CallOption) (*CreatePullRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePullRequestResponse)
+	err := c.cc.Invoke(ctx, ReviewerService_CreatePullRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) MergePullRequest(ctx context.Context, in *MergePullRequestRequest, opts ...grpc.CallOption) (*MergePullRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergePullRequestResponse)
+	err := c.cc.Invoke(ctx, ReviewerService_MergePullRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) ReassignReviewer(ctx context.Context, in *ReassignReviewerRequest, opts ...grpc.CallOption) (*ReassignReviewerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReassignReviewerResponse)
+	err := c.cc.Invoke(ctx, ReviewerService_ReassignReviewer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) GetReviewerStats(ctx context.Context, in *GetReviewerStatsRequest, opts ...grpc.CallOption) (*GetReviewerStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReviewerStatsResponse)
+	err := c.cc.Invoke(ctx, ReviewerService_GetReviewerStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReviewerServiceServer is the server API for ReviewerService service.
+// All implementations must embed UnimplementedReviewerServiceServer
+// for forward compatibility.
+//
+// ReviewerService mirrors the HTTP API described in openapi.yaml.
+type ReviewerServiceServer interface {
+	CreateTeam(context.Context, *CreateTeamRequest) (*CreateTeamResponse, error)
+	GetTeam(context.Context, *GetTeamRequest) (*GetTeamResponse, error)
+	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	SetUserActive(context.Context, *SetUserActiveRequest) (*SetUserActiveResponse, error)
+	MassDeactivate(context.Context, *MassDeactivateRequest) (*MassDeactivateResponse, error)
+	GetUserReviews(context.Context, *GetUserReviewsRequest) (*GetUserReviewsResponse, error)
+	CreatePullRequest(context.Context, *CreatePullRequestRequest) (*CreatePullRequestResponse, error)
+	MergePullRequest(context.Context, *MergePullRequestRequest) (*MergePullRequestResponse, error)
+	ReassignReviewer(context.Context, *ReassignReviewerRequest) (*ReassignReviewerResponse, error)
+	GetReviewerStats(context.Context, *GetReviewerStatsRequest) (*GetReviewerStatsResponse, error)
+	mustEmbedUnimplementedReviewerServiceServer()
+}
+
+// UnimplementedReviewerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReviewerServiceServer struct{}
+
+func (UnimplementedReviewerServiceServer) CreateTeam(context.Context, *CreateTeamRequest) (*CreateTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeam not implemented")
+}
+func (UnimplementedReviewerServiceServer) GetTeam(context.Context, *GetTeamRequest) (*GetTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeam not implemented")
+}
+func (UnimplementedReviewerServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedReviewerServiceServer) SetUserActive(context.Context, *SetUserActiveRequest) (*SetUserActiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserActive not implemented")
+}
+func (UnimplementedReviewerServiceServer) MassDeactivate(context.Context, *MassDeactivateRequest) (*MassDeactivateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MassDeactivate not implemented")
+}
+func (UnimplementedReviewerServiceServer) GetUserReviews(context.Context, *GetUserReviewsRequest) (*GetUserReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserReviews not implemented")
+}
+func (UnimplementedReviewerServiceServer) CreatePullRequest(context.Context, *CreatePullRequestRequest) (*CreatePullRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePullRequest not implemented")
+}
+func (UnimplementedReviewerServiceServer) MergePullRequest(context.Context, *MergePullRequestRequest) (*MergePullRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergePullRequest not implemented")
+}
+func (UnimplementedReviewerServiceServer) ReassignReviewer(context.Context, *ReassignReviewerRequest) (*ReassignReviewerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassignReviewer not implemented")
+}
+func (UnimplementedReviewerServiceServer) GetReviewerStats(context.Context, *GetReviewerStatsRequest) (*GetReviewerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewerStats not implemented")
+}
+func (UnimplementedReviewerServiceServer) mustEmbedUnimplementedReviewerServiceServer() {}
+func (UnimplementedReviewerServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeReviewerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReviewerServiceServer will
+// result in compilation errors.
+type UnsafeReviewerServiceServer interface {
+	mustEmbedUnimplementedReviewerServiceServer()
+}
+
+func RegisterReviewerServiceServer(s grpc.ServiceRegistrar, srv ReviewerServiceServer) {
+	// If the following call pancis, it indicates UnimplementedReviewerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReviewerService_ServiceDesc, srv)
+}
+
+func _ReviewerService_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).CreateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_CreateTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).CreateTeam(ctx, req.(*CreateTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_GetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).GetTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_GetTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).GetTeam(ctx, req.(*GetTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_SetUserActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).SetUserActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_SetUserActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).SetUserActive(ctx, req.(*SetUserActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_MassDeactivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MassDeactivateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).MassDeactivate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_MassDeactivate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).MassDeactivate(ctx, req.(*MassDeactivateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_GetUserReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).GetUserReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_GetUserReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).GetUserReviews(ctx, req.(*GetUserReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_CreatePullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePullRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).CreatePullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_CreatePullRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).CreatePullRequest(ctx, req.(*CreatePullRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_MergePullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergePullRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).MergePullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_MergePullRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).MergePullRequest(ctx, req.(*MergePullRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_ReassignReviewer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignReviewerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).ReassignReviewer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_ReassignReviewer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).ReassignReviewer(ctx, req.(*ReassignReviewerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_GetReviewerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).GetReviewerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_GetReviewerStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).GetReviewerStats(ctx, req.(*GetReviewerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReviewerService_ServiceDesc is the grpc.ServiceDesc for ReviewerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReviewerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "reviewer.v1.ReviewerService",
+	HandlerType: (*ReviewerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTeam",
+			Handler:    _ReviewerService_CreateTeam_Handler,
+		},
+		{
+			MethodName: "GetTeam",
+			Handler:    _ReviewerService_GetTeam_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _ReviewerService_GetUser_Handler,
+		},
+		{
+			MethodName: "SetUserActive",
+			Handler:    _ReviewerService_SetUserActive_Handler,
+		},
+		{
+			MethodName: "MassDeactivate",
+			Handler:    _ReviewerService_MassDeactivate_Handler,
+		},
+		{
+			MethodName: "GetUserReviews",
+			Handler:    _ReviewerService_GetUserReviews_Handler,
+		},
+		{
+			MethodName: "CreatePullRequest",
+			Handler:    _ReviewerService_CreatePullRequest_Handler,
+		},
+		{
+			MethodName: "MergePullRequest",
+			Handler:    _ReviewerService_MergePullRequest_Handler,
+		},
+		{
+			MethodName: "ReassignReviewer",
+			Handler:    _ReviewerService_ReassignReviewer_Handler,
+		},
+		{
+			MethodName: "GetReviewerStats",
+			Handler:    _ReviewerService_GetReviewerStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "reviewer/v1/reviewer.proto",
+}
@@ -0,0 +1,82 @@
+// Package ranking orders reviewer candidates by assignment strategy. It is
+// shared by the service and the policy simulator so both rank alike.
+package ranking
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"avito-pr-reviewer/internal/model"
+)
+
+// MatchWeight is how many open reviews one tag matching a label outweighs.
+const MatchWeight = 3
+
+// PairingWeight is how many open reviews one fresh review of the same author
+// outweighs.
+const PairingWeight = 5
+
+// Score rates c for a pull request with labels under strategy; higher is
+// better. The random strategy does not score, so ok is false for it.
+func Score(strategy model.AssignmentStrategy, c model.Candidate, labels []string) (score int, ok bool) {
+	switch strategy {
+	case model.StrategySkill:
+		matches := 0
+		for _, t := range c.Tags {
+			if contains(labels, t) {
+				matches++
+			}
+		}
+		return matches*MatchWeight - c.OpenReviews, true
+	case model.StrategyLeastLoaded:
+		return -c.OpenReviews, true
+	case model.StrategyKnowledgeSpread:
+		return -int(math.Round(c.Pairing*PairingWeight)) - c.OpenReviews, true
+	}
+	return 0, false
+}
+
+// Pairing weighs the times a candidate was assigned to review the author: 1
+// for now, fading linearly to 0 at window ago.
+func Pairing(assigned []time.Time, now time.Time, window time.Duration) float64 {
+	if window <= 0 {
+		return 0
+	}
+	var res float64
+	for _, at := range assigned {
+		age := now.Sub(at)
+		if age < 0 {
+			age = 0
+		}
+		if age < window {
+			res += 1 - float64(age)/float64(window)
+		}
+	}
+	return res
+}
+
+// Sort orders candidates best first. Candidates with equal scores, and all of
+// them under the random strategy, keep their order, so shuffle them first.
+func Sort(strategy model.AssignmentStrategy, labels []string, candidates []model.Candidate) {
+	scores := make(map[string]int, len(candidates))
+	for _, c := range candidates {
+		score, ok := Score(strategy, c, labels)
+		if !ok {
+			return
+		}
+		scores[c.UserID] = score
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return scores[candidates[i].UserID] > scores[candidates[j].UserID]
+	})
+}
+
+func contains(list []string, v string) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package ranking
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"avito-pr-reviewer/internal/model"
+)
+
+func ids(cs []model.Candidate) []string {
+	res := make([]string, len(cs))
+	for i, c := range cs {
+		res[i] = c.UserID
+	}
+	return res
+}
+
+func TestSort(t *testing.T) {
+	candidates := func() []model.Candidate {
+		return []model.Candidate{
+			{UserID: "u1", OpenReviews: 2},
+			{UserID: "u2", OpenReviews: 0, Tags: []string{"go"}, Pairing: 1},
+			{UserID: "u3", OpenReviews: 1, Tags: []string{"go", "sql"}},
+			{UserID: "u4", OpenReviews: 0, Pairing: 0.1},
+		}
+	}
+	tests := []struct {
+		strategy model.AssignmentStrategy
+		want     []string
+	}{
+		{model.StrategyRandom, []string{"u1", "u2", "u3", "u4"}},
+		{model.StrategyLeastLoaded, []string{"u2", "u4", "u3", "u1"}},
+		{model.StrategySkill, []string{"u3", "u2", "u4", "u1"}},
+		{model.StrategyKnowledgeSpread, []string{"u3", "u4", "u1", "u2"}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.strategy), func(t *testing.T) {
+			cs := candidates()
+			Sort(tt.strategy, []string{"go", "sql"}, cs)
+			if got := ids(cs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScore(t *testing.T) {
+	c := model.Candidate{UserID: "u1", OpenReviews: 1, Tags: []string{"go"}}
+	if s, ok := Score(model.StrategySkill, c, []string{"go"}); !ok || s != MatchWeight-1 {
+		t.Errorf("skill score = %d, %v", s, ok)
+	}
+	if s, ok := Score(model.StrategyLeastLoaded, c, nil); !ok || s != -1 {
+		t.Errorf("least_loaded score = %d, %v", s, ok)
+	}
+	if _, ok := Score(model.StrategyRandom, c, nil); ok {
+		t.Error("random should not score")
+	}
+}
+
+func TestPairing(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	window := 10 * 24 * time.Hour
+	assigned := []time.Time{
+		now,
+		now.Add(-5 * 24 * time.Hour),
+		now.Add(-10 * 24 * time.Hour),
+		now.Add(-30 * 24 * time.Hour),
+	}
+	if got := Pairing(assigned, now, window); math.Abs(got-1.5) > 1e-9 {
+		t.Errorf("Pairing = %v, want 1.5", got)
+	}
+	if got := Pairing(assigned, now, 0); got != 0 {
+		t.Errorf("Pairing without a window = %v, want 0", got)
+	}
+}
@@ -0,0 +1,73 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	rule    Rule
+}
+
+// MemoryLimiter keeps buckets in process memory. Each replica limits independently.
+type MemoryLimiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewMemoryLimiter() *MemoryLimiter {
+	return &MemoryLimiter{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+func (l *MemoryLimiter) Allow(_ context.Context, key string, rule Rule) (Result, error) {
+	if rule.Unlimited() {
+		return Result{Allowed: true}, nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(rule.Burst), updated: now}
+		l.buckets[key] = b
+	}
+	b.rule = rule
+	b.tokens = math.Min(float64(rule.Burst), b.tokens+now.Sub(b.updated).Seconds()*rule.Rate)
+	b.updated = now
+
+	allowed := b.tokens >= 1
+	if allowed {
+		b.tokens--
+	}
+	return result(rule, b.tokens, allowed), nil
+}
+
+// sweep drops buckets that have refilled completely, since they are
+// indistinguishable from new ones.
+func (l *MemoryLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+	for key, b := range l.buckets {
+		full := b.tokens + now.Sub(b.updated).Seconds()*b.rule.Rate
+		if full >= float64(b.rule.Burst) {
+			delete(l.buckets, key)
+		}
+	}
+}
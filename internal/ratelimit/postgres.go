@@ -0,0 +1,57 @@
+package ratelimit
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"avito-pr-reviewer/internal/store"
+)
+
+const (
+	staleBucketAge = time.Hour
+	purgeInterval  = 10 * time.Minute
+)
+
+// PostgresLimiter keeps buckets in Postgres so that all replicas share the same limits.
+type PostgresLimiter struct {
+	store store.RateLimitStore
+
+	mu        sync.Mutex
+	lastPurge time.Time
+}
+
+func NewPostgresLimiter(st store.RateLimitStore) *PostgresLimiter {
+	return &PostgresLimiter{store: st, lastPurge: time.Now()}
+}
+
+func (l *PostgresLimiter) Allow(ctx context.Context, key string, rule Rule) (Result, error) {
+	if rule.Unlimited() {
+		return Result{Allowed: true}, nil
+	}
+	l.maybePurge()
+
+	tokens, allowed, err := l.store.TakeRateLimitToken(ctx, key, rule.Rate, float64(rule.Burst))
+	if err != nil {
+		return Result{}, err
+	}
+	return result(rule, tokens, allowed), nil
+}
+
+func (l *PostgresLimiter) maybePurge() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if time.Since(l.lastPurge) < purgeInterval {
+		return
+	}
+	l.lastPurge = time.Now()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if _, err := l.store.DeleteStaleRateLimitBuckets(ctx, time.Now().Add(-staleBucketAge)); err != nil {
+			log.Printf("ratelimit: purge stale buckets: %v", err)
+		}
+	}()
+}
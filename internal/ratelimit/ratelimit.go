@@ -0,0 +1,100 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Rule describes a token bucket: Burst tokens at most, refilled at Rate tokens per second.
+// A rule with Burst <= 0 disables limiting.
+type Rule struct {
+	Rate  float64
+	Burst int
+}
+
+func (r Rule) Unlimited() bool {
+	return r.Burst <= 0 || r.Rate <= 0
+}
+
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	Reset      time.Duration
+	RetryAfter time.Duration
+}
+
+type Limiter interface {
+	Allow(ctx context.Context, key string, rule Rule) (Result, error)
+}
+
+// Rules holds the default rule and per-route overrides keyed by URL path.
+type Rules struct {
+	Default Rule
+	Routes  map[string]Rule
+}
+
+func (r Rules) For(path string) Rule {
+	if rule, ok := r.Routes[path]; ok {
+		return rule
+	}
+	return r.Default
+}
+
+// ParseRoutes parses overrides in the form "/path=rate:burst,/other=off".
+func ParseRoutes(spec string) (map[string]Rule, error) {
+	routes := make(map[string]Rule)
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		path, value, ok := strings.Cut(item, "=")
+		if !ok || path == "" {
+			return nil, fmt.Errorf("invalid rate limit route %q", item)
+		}
+		if value == "off" {
+			routes[path] = Rule{}
+			continue
+		}
+		rateStr, burstStr, ok := strings.Cut(value, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid rate limit route %q: want rate:burst", item)
+		}
+		rate, err := strconv.ParseFloat(rateStr, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate in %q: %w", item, err)
+		}
+		burst, err := strconv.Atoi(burstStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid burst in %q: %w", item, err)
+		}
+		routes[path] = Rule{Rate: rate, Burst: burst}
+	}
+	return routes, nil
+}
+
+// result builds a Result from the tokens left in the bucket after the request was counted.
+func result(rule Rule, tokens float64, allowed bool) Result {
+	res := Result{
+		Allowed:   allowed,
+		Limit:     rule.Burst,
+		Remaining: int(math.Max(0, math.Floor(tokens))),
+		Reset:     seconds((float64(rule.Burst) - tokens) / rule.Rate),
+	}
+	if !allowed {
+		res.RetryAfter = seconds((1 - tokens) / rule.Rate)
+	}
+	return res
+}
+
+func seconds(s float64) time.Duration {
+	if s <= 0 {
+		return 0
+	}
+	return time.Duration(math.Ceil(s)) * time.Second
+}
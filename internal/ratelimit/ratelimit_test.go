@@ -0,0 +1,69 @@
+package ratelimit
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMemoryLimiterBurstAndRefill(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(0, 0)
+	l := NewMemoryLimiter()
+	l.now = func() time.Time { return now }
+	rule := Rule{Rate: 2, Burst: 3}
+
+	for i := 0; i < 3; i++ {
+		res, _ := l.Allow(ctx, "k", rule)
+		if !res.Allowed || res.Remaining != 2-i || res.Limit != 3 {
+			t.Fatalf("request %d: %+v", i, res)
+		}
+	}
+	res, _ := l.Allow(ctx, "k", rule)
+	if res.Allowed || res.RetryAfter != time.Second || res.Reset != 2*time.Second {
+		t.Fatalf("over burst: %+v", res)
+	}
+	if res, _ := l.Allow(ctx, "other", rule); !res.Allowed {
+		t.Errorf("keys must not share a bucket: %+v", res)
+	}
+
+	// Half a second refills one token at 2 per second.
+	now = now.Add(500 * time.Millisecond)
+	if res, _ := l.Allow(ctx, "k", rule); !res.Allowed || res.Remaining != 0 {
+		t.Errorf("after refill: %+v", res)
+	}
+	if res, _ := l.Allow(ctx, "k", rule); res.Allowed {
+		t.Errorf("refill gave more than one token: %+v", res)
+	}
+
+	// The bucket never holds more than Burst.
+	now = now.Add(time.Hour)
+	if res, _ := l.Allow(ctx, "k", rule); res.Remaining != 2 {
+		t.Errorf("after a long idle: %+v", res)
+	}
+}
+
+func TestParseRoutes(t *testing.T) {
+	got, err := ParseRoutes(" /pullRequest/create=0.5:5, /health=off,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]Rule{"/pullRequest/create": {Rate: 0.5, Burst: 5}, "/health": {}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if !got["/health"].Unlimited() {
+		t.Error("off must disable limiting")
+	}
+	rules := Rules{Default: Rule{Rate: 1, Burst: 1}, Routes: got}
+	if rules.For("/team/add") != rules.Default || rules.For("/pullRequest/create").Burst != 5 {
+		t.Error("For picks the wrong rule")
+	}
+
+	for _, spec := range []string{"/a", "=1:1", "/a=1", "/a=x:1", "/a=1:x"} {
+		if _, err := ParseRoutes(spec); err == nil {
+			t.Errorf("ParseRoutes(%q) succeeded", spec)
+		}
+	}
+}
@@ -0,0 +1,363 @@
+// Package rules parses and evaluates per-team reviewer assignment rules.
+//
+// Each line holds one rule:
+//
+//	exclude tag=on-call
+//	exclude user=u5 when author=u1
+//	require 1 from team=payments when label=billing
+//	prefer seniority=senior when label=security and repository=api
+//
+// Selectors pick candidates by user, tag, team or seniority. Conditions test
+// the pull request's label, author or repository; all of them must hold for
+// the rule to apply.
+package rules
+
+import (
+	"bufio"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"avito-pr-reviewer/internal/model"
+)
+
+type Action string
+
+const (
+	Exclude Action = "exclude"
+	Require Action = "require"
+	Prefer  Action = "prefer"
+)
+
+// MaxRequired is the most reviewers a single require rule may ask for.
+const MaxRequired = 10
+
+// Term is a key=value pair, used both for selectors and conditions.
+type Term struct {
+	Key   string
+	Value string
+}
+
+func (t Term) String() string {
+	return t.Key + "=" + t.Value
+}
+
+type Rule struct {
+	Line   int
+	Text   string
+	Action Action
+	// Count is how many reviewers a require rule asks for.
+	Count  int
+	Target Term
+	When   []Term
+}
+
+type Set struct {
+	Rules []Rule
+}
+
+var (
+	selectorKeys  = map[string]bool{"user": true, "tag": true, "team": true, "seniority": true}
+	conditionKeys = map[string]bool{"label": true, "author": true, "repository": true}
+)
+
+// Parse reads rules, one per line. Empty lines and text after # are ignored.
+func Parse(src string) (*Set, error) {
+	s := &Set{}
+	sc := bufio.NewScanner(strings.NewReader(src))
+	for n := 1; sc.Scan(); n++ {
+		line := sc.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		r, err := parseRule(fields)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", model.ErrInvalidRules, n, err)
+		}
+		r.Line = n
+		r.Text = strings.Join(fields, " ")
+		s.Rules = append(s.Rules, r)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func parseRule(fields []string) (Rule, error) {
+	r := Rule{Action: Action(fields[0])}
+	rest := fields[1:]
+	switch r.Action {
+	case Exclude, Prefer:
+	case Require:
+		if len(rest) < 2 || rest[1] != "from" {
+			return r, fmt.Errorf("expected \"require N from key=value\"")
+		}
+		n, err := strconv.Atoi(rest[0])
+		if err != nil || n < 1 || n > MaxRequired {
+			return r, fmt.Errorf("count must be between 1 and %d, got %q", MaxRequired, rest[0])
+		}
+		r.Count = n
+		rest = rest[2:]
+	default:
+		return r, fmt.Errorf("unknown action %q", fields[0])
+	}
+
+	if len(rest) == 0 {
+		return r, fmt.Errorf("missing selector")
+	}
+	target, err := parseTerm(rest[0], selectorKeys)
+	if err != nil {
+		return r, err
+	}
+	if target.Key == "seniority" && !model.Seniority(target.Value).Valid() {
+		return r, fmt.Errorf("unknown seniority %q", target.Value)
+	}
+	r.Target = target
+	rest = rest[1:]
+
+	if len(rest) == 0 {
+		return r, nil
+	}
+	if rest[0] != "when" || len(rest) == 1 {
+		return r, fmt.Errorf("expected \"when key=value\" after the selector")
+	}
+	for i, f := range rest[1:] {
+		if i%2 == 1 {
+			if f != "and" {
+				return r, fmt.Errorf("expected \"and\", got %q", f)
+			}
+			continue
+		}
+		cond, err := parseTerm(f, conditionKeys)
+		if err != nil {
+			return r, err
+		}
+		r.When = append(r.When, cond)
+	}
+	if len(rest)%2 == 1 {
+		return r, fmt.Errorf("missing condition after \"and\"")
+	}
+	return r, nil
+}
+
+func parseTerm(s string, keys map[string]bool) (Term, error) {
+	key, value, ok := strings.Cut(s, "=")
+	if !ok || value == "" {
+		return Term{}, fmt.Errorf("expected key=value, got %q", s)
+	}
+	if !keys[key] {
+		return Term{}, fmt.Errorf("unknown key %q", key)
+	}
+	if key == "tag" || key == "label" {
+		value = strings.ToLower(value)
+	}
+	return Term{Key: key, Value: value}, nil
+}
+
+// PR is what rule conditions can refer to.
+type PR struct {
+	AuthorID     string
+	RepositoryID string
+	Labels       []string
+}
+
+// Applies reports whether all of the rule's conditions hold for pr.
+func (r *Rule) Applies(pr PR) bool {
+	for _, c := range r.When {
+		var ok bool
+		switch c.Key {
+		case "label":
+			ok = contains(pr.Labels, c.Value)
+		case "author":
+			ok = pr.AuthorID == c.Value
+		case "repository":
+			ok = pr.RepositoryID == c.Value
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Matches reports whether the rule's selector picks c.
+func (r *Rule) Matches(c model.Candidate) bool {
+	switch r.Target.Key {
+	case "user":
+		return c.UserID == r.Target.Value
+	case "tag":
+		return contains(c.Tags, r.Target.Value)
+	case "team":
+		return c.TeamName == r.Target.Value || contains(c.Teams, r.Target.Value)
+	case "seniority":
+		return string(c.Seniority) == r.Target.Value
+	}
+	return false
+}
+
+// Teams returns the teams that require rules applying to pr draw reviewers
+// from, so that their members can be considered as well.
+func (s *Set) Teams(pr PR) []string {
+	var teams []string
+	for i := range s.Rules {
+		r := &s.Rules[i]
+		if r.Action == Require && r.Target.Key == "team" && r.Applies(pr) && !contains(teams, r.Target.Value) {
+			teams = append(teams, r.Target.Value)
+		}
+	}
+	return teams
+}
+
+type Exclusion struct {
+	UserID string
+	Rule   *Rule
+}
+
+type Requirement struct {
+	Rule    *Rule
+	UserIDs []string
+}
+
+// Decision is the outcome of evaluating a rule set for one pull request.
+type Decision struct {
+	Applied  []*Rule
+	Excluded []Exclusion
+	// Eligible are the remaining candidates, best first.
+	Eligible []string
+	// Required are the reviewers picked to satisfy require rules.
+	Required []Requirement
+}
+
+// Evaluate applies the rules to candidates, which come in ranked order. It
+// drops excluded candidates, moves preferred ones to the front and picks the
+// best eligible candidates for every require rule. If a require rule cannot
+// be met, the decision is returned along with an error wrapping
+// model.ErrComposition.
+func (s *Set) Evaluate(pr PR, candidates []model.Candidate) (*Decision, error) {
+	d := &Decision{}
+	for i := range s.Rules {
+		if s.Rules[i].Applies(pr) {
+			d.Applied = append(d.Applied, &s.Rules[i])
+		}
+	}
+
+	eligible := make([]model.Candidate, 0, len(candidates))
+	preference := make(map[string]int, len(candidates))
+	for _, c := range candidates {
+		if r := d.first(Exclude, c); r != nil {
+			d.Excluded = append(d.Excluded, Exclusion{UserID: c.UserID, Rule: r})
+			continue
+		}
+		for _, r := range d.Applied {
+			if r.Action == Prefer && r.Matches(c) {
+				preference[c.UserID]++
+			}
+		}
+		eligible = append(eligible, c)
+	}
+	sort.SliceStable(eligible, func(i, j int) bool {
+		return preference[eligible[i].UserID] > preference[eligible[j].UserID]
+	})
+	for _, c := range eligible {
+		d.Eligible = append(d.Eligible, c.UserID)
+	}
+
+	var picked []model.Candidate
+	for _, r := range d.Applied {
+		if r.Action != Require {
+			continue
+		}
+		req := Requirement{Rule: r}
+		have := 0
+		for _, c := range picked {
+			if r.Matches(c) {
+				have++
+			}
+		}
+		for _, c := range eligible {
+			if have == r.Count {
+				break
+			}
+			if r.Matches(c) && !hasUser(picked, c.UserID) {
+				picked = append(picked, c)
+				req.UserIDs = append(req.UserIDs, c.UserID)
+				have++
+			}
+		}
+		d.Required = append(d.Required, req)
+		if have < r.Count {
+			return d, UnmetError(r)
+		}
+	}
+	return d, nil
+}
+
+// Unmet returns the first require rule applying to pr that reviewers do not
+// satisfy, or nil.
+func (s *Set) Unmet(pr PR, reviewers []model.Candidate) *Rule {
+	for i := range s.Rules {
+		r := &s.Rules[i]
+		if r.Action != Require || !r.Applies(pr) {
+			continue
+		}
+		have := 0
+		for _, c := range reviewers {
+			if r.Matches(c) {
+				have++
+			}
+		}
+		if have < r.Count {
+			return r
+		}
+	}
+	return nil
+}
+
+// Excludes returns the first exclude rule applying to pr that drops c, or nil.
+func (s *Set) Excludes(pr PR, c model.Candidate) *Rule {
+	for i := range s.Rules {
+		r := &s.Rules[i]
+		if r.Action == Exclude && r.Applies(pr) && r.Matches(c) {
+			return r
+		}
+	}
+	return nil
+}
+
+// UnmetError describes r, a require rule that cannot be met.
+func UnmetError(r *Rule) error {
+	return fmt.Errorf("%w: line %d: %s", model.ErrComposition, r.Line, r.Text)
+}
+
+func (d *Decision) first(action Action, c model.Candidate) *Rule {
+	for _, r := range d.Applied {
+		if r.Action == action && r.Matches(c) {
+			return r
+		}
+	}
+	return nil
+}
+
+func hasUser(picked []model.Candidate, id string) bool {
+	for _, c := range picked {
+		if c.UserID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, v string) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,153 @@
+package rules
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"avito-pr-reviewer/internal/model"
+)
+
+const sample = `# Backend rules
+exclude tag=on-call
+exclude user=u5 when author=u1
+require 1 from team=payments when label=Billing
+prefer seniority=senior when label=security and repository=api
+`
+
+var candidates = []model.Candidate{
+	{UserID: "u2", TeamName: "backend", Seniority: model.SeniorityMiddle},
+	{UserID: "u3", TeamName: "backend", Tags: []string{"on-call"}},
+	{UserID: "u4", TeamName: "backend", Seniority: model.SenioritySenior},
+	{UserID: "u5", TeamName: "backend"},
+	{UserID: "p1", TeamName: "payments"},
+	{UserID: "p2", TeamName: "payments"},
+}
+
+func TestParse(t *testing.T) {
+	s, err := Parse(sample)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Rules) != 4 {
+		t.Fatalf("expected 4 rules, got %d", len(s.Rules))
+	}
+	r := s.Rules[2]
+	if r.Line != 4 || r.Action != Require || r.Count != 1 || r.Target != (Term{"team", "payments"}) {
+		t.Errorf("unexpected rule %+v", r)
+	}
+	if !reflect.DeepEqual(r.When, []Term{{"label", "billing"}}) {
+		t.Errorf("labels must be lowercased, got %v", r.When)
+	}
+	if got := s.Rules[3].When; len(got) != 2 {
+		t.Errorf("expected 2 conditions, got %v", got)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, src := range []string{
+		"assign user=u1",
+		"exclude",
+		"exclude user",
+		"exclude name=u1",
+		"exclude seniority=lead",
+		"exclude user=u1 if label=x",
+		"exclude user=u1 when",
+		"exclude user=u1 when label=x or author=u2",
+		"exclude user=u1 when label=x and",
+		"exclude user=u1 when team=x",
+		"require 0 from team=payments",
+		"require 11 from team=payments",
+		"require one from team=payments",
+		"require 1 team=payments",
+	} {
+		if _, err := Parse(src); !errors.Is(err, model.ErrInvalidRules) {
+			t.Errorf("Parse(%q): expected ErrInvalidRules, got %v", src, err)
+		}
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	s, err := Parse(sample)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := s.Evaluate(PR{AuthorID: "u1", Labels: []string{"billing"}}, candidates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d.Applied) != 3 {
+		t.Errorf("expected 3 applied rules, got %d", len(d.Applied))
+	}
+	var excluded []string
+	for _, e := range d.Excluded {
+		excluded = append(excluded, e.UserID)
+	}
+	if !reflect.DeepEqual(excluded, []string{"u3", "u5"}) {
+		t.Errorf("excluded %v", excluded)
+	}
+	if !reflect.DeepEqual(d.Eligible, []string{"u2", "u4", "p1", "p2"}) {
+		t.Errorf("eligible %v", d.Eligible)
+	}
+	if len(d.Required) != 1 || !reflect.DeepEqual(d.Required[0].UserIDs, []string{"p1"}) {
+		t.Errorf("required %+v", d.Required)
+	}
+
+	// Preferred candidates move to the front, the rest keep their order.
+	d, err = s.Evaluate(PR{AuthorID: "u9", RepositoryID: "api", Labels: []string{"security"}}, candidates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(d.Eligible, []string{"u4", "u2", "u5", "p1", "p2"}) {
+		t.Errorf("eligible %v", d.Eligible)
+	}
+}
+
+func TestEvaluateUnmet(t *testing.T) {
+	s, err := Parse("require 3 from team=payments")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := s.Evaluate(PR{}, candidates)
+	if !errors.Is(err, model.ErrComposition) {
+		t.Fatalf("expected ErrComposition, got %v", err)
+	}
+	if len(d.Required) != 1 || len(d.Required[0].UserIDs) != 2 {
+		t.Errorf("required %+v", d.Required)
+	}
+}
+
+func TestUnmet(t *testing.T) {
+	s, err := Parse("require 1 from seniority=senior when label=security")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pr := PR{Labels: []string{"security"}}
+	if r := s.Unmet(pr, candidates[:2]); r == nil || r.Line != 1 {
+		t.Errorf("expected rule 1 unmet, got %v", r)
+	}
+	if r := s.Unmet(pr, candidates[:3]); r != nil {
+		t.Errorf("expected no unmet rule, got %v", r)
+	}
+	if r := s.Unmet(PR{}, nil); r != nil {
+		t.Errorf("rule must not apply without the label, got %v", r)
+	}
+}
+
+func TestTeamMatchesAnyMembership(t *testing.T) {
+	s, err := Parse("require 2 from team=payments")
+	if err != nil {
+		t.Fatal(err)
+	}
+	guild := append([]model.Candidate{}, candidates[:1]...)
+	guild[0].Teams = []string{"backend", "payments"}
+	guild = append(guild, candidates[4])
+	d, err := s.Evaluate(PR{}, guild)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d.Required) != 1 || !reflect.DeepEqual(d.Required[0].UserIDs, []string{"u2", "p1"}) {
+		t.Errorf("required %+v", d.Required)
+	}
+}
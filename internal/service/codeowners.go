@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"avito-pr-reviewer/internal/codeowners"
+	"avito-pr-reviewer/internal/model"
+)
+
+// SetCodeowners registers a CODEOWNERS file for the team's repository, or
+// for all of the team's pull requests without a more specific file if the
+// repository is empty.
+func (s *Service) SetCodeowners(ctx context.Context, c *model.Codeowners) (*model.Codeowners, error) {
+	if _, err := codeowners.Parse(c.Content); err != nil {
+		return nil, err
+	}
+	if _, err := s.store.GetTeam(ctx, c.TeamName); err != nil {
+		return nil, err
+	}
+	if c.RepositoryID != "" {
+		if _, err := s.store.GetRepository(ctx, c.RepositoryID); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.store.SetCodeowners(ctx, c); err != nil {
+		return nil, err
+	}
+	return s.store.GetCodeowners(ctx, c.TeamName, c.RepositoryID)
+}
+
+func (s *Service) GetCodeowners(ctx context.Context, teamName, repositoryID string) (*model.Codeowners, error) {
+	return s.store.GetCodeowners(ctx, teamName, repositoryID)
+}
+
+// codeOwners returns the active users, other than the author, who own any of
+// the pull request's changed files. Owners named by username or email are
+// looked up in the pull request's team only.
+func (s *Service) codeOwners(ctx context.Context, author *model.User, req model.NewPullRequest) ([]string, error) {
+	if len(req.ChangedFiles) == 0 {
+		return nil, nil
+	}
+	c, err := s.store.GetCodeowners(ctx, req.TeamName, req.RepositoryID)
+	if errors.Is(err, model.ErrNotFound) && req.RepositoryID != "" {
+		c, err = s.store.GetCodeowners(ctx, req.TeamName, "")
+	}
+	if errors.Is(err, model.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	file, err := codeowners.Parse(c.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	handles, teams := []string{}, []string{}
+	for _, path := range req.ChangedFiles {
+		for _, o := range file.Owners(path) {
+			owner := codeowners.ParseOwner(o)
+			if owner.Team != "" {
+				teams = append(teams, owner.Team)
+			} else {
+				handles = append(handles, owner.User)
+			}
+		}
+	}
+	if len(handles) == 0 && len(teams) == 0 {
+		return nil, nil
+	}
+	ids, err := s.store.ListActiveOwners(ctx, req.TeamName, handles, teams)
+	if err != nil {
+		return nil, err
+	}
+	owners := ids[:0]
+	for _, id := range ids {
+		if id != author.ID {
+			owners = append(owners, id)
+		}
+	}
+	return owners, nil
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"avito-pr-reviewer/internal/model"
+	"avito-pr-reviewer/internal/rules"
+)
+
+// compositionRule constrains the seniority mix of a pull request's reviewers.
+type compositionRule struct {
+	desc string
+	ok   func(author model.Seniority, reviewers []model.Seniority) bool
+}
+
+var compositionRules = []compositionRule{
+	{
+		desc: "a junior's pull request needs at least one senior reviewer",
+		ok: func(author model.Seniority, reviewers []model.Seniority) bool {
+			return author != model.SeniorityJunior || countLevel(reviewers, model.SenioritySenior) > 0
+		},
+	},
+	{
+		desc: "juniors cannot be the only reviewers",
+		ok: func(_ model.Seniority, reviewers []model.Seniority) bool {
+			return len(reviewers) < 2 || countLevel(reviewers, model.SeniorityJunior) < len(reviewers)
+		},
+	},
+}
+
+func countLevel(levels []model.Seniority, level model.Seniority) int {
+	n := 0
+	for _, l := range levels {
+		if l == level {
+			n++
+		}
+	}
+	return n
+}
+
+// violated returns the first rule that reviewers break, or nil.
+func violated(author model.Seniority, reviewers []model.Seniority) *compositionRule {
+	for i := range compositionRules {
+		if !compositionRules[i].ok(author, reviewers) {
+			return &compositionRules[i]
+		}
+	}
+	return nil
+}
+
+func compositionError(r *compositionRule) error {
+	return fmt.Errorf("%w: %s", model.ErrComposition, r.desc)
+}
+
+func (s *Service) SetUserSeniority(ctx context.Context, userID string, seniority model.Seniority) (*model.User, error) {
+	if !seniority.Valid() {
+		return nil, fmt.Errorf("%w: %q", model.ErrInvalidSeniority, seniority)
+	}
+	if _, err := s.store.GetUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	if err := s.store.SetUserSeniority(ctx, userID, seniority); err != nil {
+		return nil, err
+	}
+	return s.store.GetUser(ctx, userID)
+}
+
+// compose picks the reviewers for a pull request by author: everyone in
+// fixed, then others up to count in total, keeping as close to the ranked
+// order of owners and users as the composition rules allow. If there are
+// owners and fixed has none, one of them is always picked.
+func (s *Service) compose(ctx context.Context, author model.Seniority, count int, fixed, owners, users []string) ([]string, error) {
+	if count == 0 {
+		return []string{}, nil
+	}
+	ids := append(append(append([]string{}, fixed...), owners...), users...)
+	levels, err := s.store.GetSeniorities(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range fixed {
+		if contains(owners, f) {
+			owners = nil
+			break
+		}
+	}
+	pool := users
+	for _, f := range fixed {
+		pool = without(pool, f)
+	}
+
+	if len(owners) == 0 {
+		if picked, ok := pickComposition(author, levels, fixed, pool, max(count, len(fixed))); ok {
+			return picked, nil
+		}
+	}
+	for _, o := range owners {
+		withOwner := append(append([]string{}, fixed...), o)
+		if picked, ok := pickComposition(author, levels, withOwner, without(pool, o), max(count, len(withOwner))); ok {
+			return picked, nil
+		}
+	}
+
+	// Report the rule that the preferred choice breaks.
+	preferred := append([]string{}, fixed...)
+	if len(owners) > 0 {
+		preferred = append(preferred, owners[0])
+		pool = without(pool, owners[0])
+	}
+	preferred = append(preferred, pool[:max(min(len(pool), count-len(preferred)), 0)]...)
+	return nil, compositionError(violated(author, levelsOf(levels, preferred)))
+}
+
+// pickComposition adds reviewers from pool to fixed until there are n, or as
+// many as pool allows, without breaking a composition rule. Of all valid
+// choices it takes the one whose picks come earliest in pool.
+func pickComposition(author model.Seniority, levels map[string]model.Seniority, fixed, pool []string, n int) ([]string, bool) {
+	n = max(min(n-len(fixed), len(pool)), 0)
+
+	// Within a level, earlier candidates are always the better choice, so
+	// only how many to take of each level needs deciding.
+	byLevel := make([][]int, len(model.Seniorities))
+	for i, id := range pool {
+		l := levelIndex(levels[id])
+		byLevel[l] = append(byLevel[l], i)
+	}
+
+	var best []int
+	bestCost := -1
+	take := make([]int, len(model.Seniorities))
+	var try func(level, left int)
+	try = func(level, left int) {
+		if level == len(take)-1 {
+			if left > len(byLevel[level]) {
+				return
+			}
+			take[level] = left
+			picked := make([]int, 0, n)
+			reviewers := levelsOf(levels, fixed)
+			for l, k := range take {
+				picked = append(picked, byLevel[l][:k]...)
+				for range k {
+					reviewers = append(reviewers, model.Seniorities[l])
+				}
+			}
+			if violated(author, reviewers) != nil {
+				return
+			}
+			cost := 0
+			for _, p := range picked {
+				cost += p
+			}
+			if bestCost < 0 || cost < bestCost {
+				best, bestCost = picked, cost
+			}
+			return
+		}
+		for k := 0; k <= left && k <= len(byLevel[level]); k++ {
+			take[level] = k
+			try(level+1, left-k)
+		}
+	}
+	try(0, n)
+
+	if bestCost < 0 {
+		return nil, false
+	}
+	sort.Ints(best)
+	res := append(make([]string, 0, len(fixed)+len(best)), fixed...)
+	for _, p := range best {
+		res = append(res, pool[p])
+	}
+	return res, true
+}
+
+// levelIndex is the position of l in model.Seniorities. Unknown levels count
+// as middle.
+func levelIndex(l model.Seniority) int {
+	for i, s := range model.Seniorities {
+		if s == l {
+			return i
+		}
+	}
+	return levelIndex(model.SeniorityMiddle)
+}
+
+func levelsOf(levels map[string]model.Seniority, ids []string) []model.Seniority {
+	res := make([]model.Seniority, len(ids))
+	for i, id := range ids {
+		res[i] = model.Seniorities[levelIndex(levels[id])]
+	}
+	return res
+}
+
+func without(ids []string, id string) []string {
+	res := make([]string, 0, len(ids))
+	for _, x := range ids {
+		if x != id {
+			res = append(res, x)
+		}
+	}
+	return res
+}
+
+// replacement returns the first of the ranked candidates that can replace
+// oldUserID on pr without breaking a composition or require rule.
+func (s *Service) replacement(ctx context.Context, pr *model.PullRequest, author *model.User, set *rules.Set, oldUserID string, candidates []string) (string, error) {
+	remaining := without(pr.AssignedReviewers, oldUserID)
+	ids := append(append([]string{}, remaining...), candidates...)
+	levels, err := s.store.GetSeniorities(ctx, ids)
+	if err != nil {
+		return "", err
+	}
+	info, err := s.candidates(ctx, set, ids)
+	if err != nil {
+		return "", err
+	}
+	byID := make(map[string]model.Candidate, len(info))
+	for _, c := range info {
+		byID[c.UserID] = c
+	}
+
+	rpr := rules.PR{AuthorID: pr.AuthorID, RepositoryID: pr.RepositoryID, Labels: pr.Labels}
+	var first error
+	for _, c := range candidates {
+		reviewers := append(append([]string{}, remaining...), c)
+		if r := violated(author.Seniority, levelsOf(levels, reviewers)); r != nil {
+			if first == nil {
+				first = compositionError(r)
+			}
+			continue
+		}
+		picked := make([]model.Candidate, len(reviewers))
+		for i, id := range reviewers {
+			picked[i] = byID[id]
+		}
+		if r := set.Unmet(rpr, picked); r != nil {
+			if first == nil {
+				first = rules.UnmetError(r)
+			}
+			continue
+		}
+		return c, nil
+	}
+	return "", first
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"avito-pr-reviewer/internal/model"
+)
+
+// emit persists an event for the stream subscribers. Failures are logged rather
+// than returned: the change itself is already committed and notifications are
+// best effort.
+func (s *Service) emit(ctx context.Context, typ model.EventType, teamName string, userIDs []string, payload model.EventPayload) {
+	if s.after != nil {
+		*s.after = append(*s.after, func(s *Service) { s.emit(ctx, typ, teamName, userIDs, payload) })
+		return
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		log.Printf("events: marshal %s payload: %v", typ, err)
+		return
+	}
+	e := &model.Event{
+		Type:          typ,
+		PullRequestID: payload.PR.ID,
+		TeamName:      teamName,
+		UserIDs:       userIDs,
+		Payload:       data,
+	}
+	if err := s.store.CreateEvent(ctx, e); err != nil {
+		log.Printf("events: create %s for %s: %v", typ, payload.PR.ID, err)
+	}
+}
+
+func (s *Service) emitMerged(ctx context.Context, pr *model.PullRequest) {
+	userIDs := append([]string{pr.AuthorID}, pr.AssignedReviewers...)
+	s.emit(ctx, model.EventPRMerged, pr.TeamName, userIDs, model.EventPayload{PR: pr})
+}
+
+// ListUserEvents returns events addressed to userID with an id greater than afterID.
+func (s *Service) ListUserEvents(ctx context.Context, userID string, afterID int64, limit int) ([]model.Event, error) {
+	return s.store.ListEventsForUser(ctx, userID, afterID, limit)
+}
+
+// ListTeamEvents returns events of teamName with an id greater than afterID.
+func (s *Service) ListTeamEvents(ctx context.Context, teamName string, afterID int64, limit int) ([]model.Event, error) {
+	return s.store.ListEventsForTeam(ctx, teamName, afterID, limit)
+}
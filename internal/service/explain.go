@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"avito-pr-reviewer/internal/model"
+	"avito-pr-reviewer/internal/ranking"
+	"avito-pr-reviewer/internal/rules"
+)
+
+// snapshotPool records the candidate pool of c as it is before the pull
+// request is saved, for explain.
+func (s *Service) snapshotPool(ctx context.Context, authorID string, c *choice) error {
+	var ids []string
+	c.active = make(map[string]bool)
+	for _, team := range c.teams {
+		t, err := s.store.GetTeam(ctx, team)
+		if errors.Is(err, model.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		for _, m := range t.Members {
+			if !contains(ids, m.ID) {
+				ids = append(ids, m.ID)
+				c.active[m.ID] = m.IsActive
+			}
+		}
+	}
+	for _, o := range c.owners {
+		if !contains(ids, o) {
+			ids = append(ids, o)
+			c.active[o] = true
+		}
+	}
+
+	var err error
+	c.pool, err = s.scoredCandidates(ctx, c.at, c.policy.strategy, authorID, ids)
+	if err != nil {
+		return err
+	}
+	if s.preferInHours {
+		sch := s.schedules()
+		c.working = make(map[string]bool, len(ids))
+		for _, id := range ids {
+			c.working[id] = s.calc.InWorkingHours(c.at, sch.forUser(ctx, id))
+		}
+	}
+	return nil
+}
+
+// explain describes the candidate pool that snapshotPool recorded in c and
+// what became of each candidate.
+func (s *Service) explain(author *model.User, set *rules.Set, c *choice) *model.Explanation {
+	info := c.pool
+	rank := make(map[string]int)
+	var required []string
+	if c.decision != nil {
+		for i, id := range c.decision.Eligible {
+			rank[id] = i + 1
+		}
+		for _, r := range c.decision.Required {
+			required = append(required, r.UserIDs...)
+		}
+	}
+
+	e := &model.Explanation{
+		Strategy:      c.policy.strategy,
+		ReviewerCount: c.policy.count,
+		Seed:          c.seed,
+		Candidates:    make([]model.ExplainedCandidate, len(info)),
+	}
+	for i, cand := range info {
+		x := model.ExplainedCandidate{
+			UserID:      cand.UserID,
+			TeamName:    cand.TeamName,
+			Seniority:   cand.Seniority,
+			OpenReviews: cand.OpenReviews,
+			Rank:        rank[cand.UserID],
+			Owner:       contains(c.owners, cand.UserID),
+			Required:    contains(required, cand.UserID),
+			Selected:    contains(c.reviewers, cand.UserID),
+		}
+		switch {
+		case cand.UserID == author.ID:
+			x.Excluded = model.ExcludedAuthor
+		case !c.active[cand.UserID]:
+			x.Excluded = model.ExcludedInactive
+		case contains(c.policy.excluded, cand.UserID):
+			x.Excluded = model.ExcludedRepository
+		case c.policy.leadExcluded(cand.UserID):
+			x.Excluded = model.ExcludedLead
+		default:
+			if r := set.Excludes(c.pr, cand); r != nil {
+				x.Excluded = model.ExcludedRule
+				x.Rule = r.Text
+			}
+		}
+		if score, ok := ranking.Score(c.policy.strategy, cand, c.pr.Labels); ok {
+			x.Score = &score
+		}
+		if working, ok := c.working[cand.UserID]; ok {
+			x.WorkingNow = &working
+		}
+		e.Candidates[i] = x
+	}
+
+	// Eligible candidates first, in ranked order, then the excluded ones.
+	order := func(x model.ExplainedCandidate) int {
+		switch {
+		case x.Excluded != "":
+			return len(info) + 2
+		case x.Rank == 0:
+			return len(info) + 1
+		}
+		return x.Rank
+	}
+	sort.SliceStable(e.Candidates, func(i, j int) bool {
+		return order(e.Candidates[i]) < order(e.Candidates[j])
+	})
+	return e
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"avito-pr-reviewer/internal/model"
+	"avito-pr-reviewer/internal/notifier"
+)
+
+const notifyTimeout = time.Minute
+
+// notify delivers n in the background so a slow chat service never delays the API.
+func (s *Service) notify(ctx context.Context, n notifier.Notification) {
+	if s.notifier == nil {
+		return
+	}
+	if s.after != nil {
+		*s.after = append(*s.after, func(s *Service) { s.notify(ctx, n) })
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), notifyTimeout)
+	go func() {
+		defer cancel()
+		if err := s.notifier.Notify(ctx, n); err != nil {
+			log.Printf("notifier: %s for %s: %v", n.Type, n.UserID, err)
+		}
+	}()
+}
+
+// SetSlackUserID links a user to their Slack member ID for direct messages.
+// An actor may link only themselves or the members of teams they lead.
+func (s *Service) SetSlackUserID(ctx context.Context, actor, userID, slackUserID string) (*model.User, error) {
+	user, err := s.store.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeUser(ctx, actor, user); err != nil {
+		return nil, err
+	}
+	if err := s.store.SetSlackUserID(ctx, userID, slackUserID); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// SetEmail stores the address for email notifications; optOut keeps the
+// address but stops all emails to it. An actor may set only their own address
+// or those of the members of teams they lead.
+func (s *Service) SetEmail(ctx context.Context, actor, userID, email string, optOut bool) (*model.User, error) {
+	u, err := s.store.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeUser(ctx, actor, u); err != nil {
+		return nil, err
+	}
+	if err := s.store.SetUserEmail(ctx, userID, email, optOut); err != nil {
+		return nil, err
+	}
+	return s.store.GetUser(ctx, userID)
+}
@@ -0,0 +1,308 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"avito-pr-reviewer/internal/model"
+	"avito-pr-reviewer/internal/orgfile"
+	"avito-pr-reviewer/internal/store"
+)
+
+// ImportOrg creates and updates the teams and members of an import file. The
+// whole file is checked before anything changes, and it is applied in one
+// transaction, or not at all on a dry run. Import only adds: members, roles
+// and settings the file leaves out are kept. Roles are given by the actor as
+// a lead, so in new teams only by a trusted caller. Problems with the file
+// are returned as orgfile.Errors.
+func (s *Service) ImportOrg(ctx context.Context, actor string, format orgfile.Format, content []byte, dryRun bool) (*model.OrgImport, error) {
+	f, err := orgfile.Parse(format, content)
+	if err != nil {
+		return nil, err
+	}
+	if dryRun {
+		p, err := s.planImport(ctx, s.store, actor, f)
+		if err != nil {
+			return nil, err
+		}
+		return &model.OrgImport{DryRun: true, Changes: p.changes}, nil
+	}
+	// The plan is made in the transaction that carries it out, so that it
+	// matches the rows it changes.
+	var res *model.OrgImport
+	err = s.store.WithinTx(ctx, func(r store.Repository) error {
+		p, err := s.planImport(ctx, r, actor, f)
+		if err != nil {
+			return err
+		}
+		for _, step := range p.steps {
+			if err := step(ctx, r); err != nil {
+				return err
+			}
+		}
+		res = &model.OrgImport{Changes: p.changes}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ExportOrg writes all teams with their members in a format ImportOrg reads.
+func (s *Service) ExportOrg(ctx context.Context, format orgfile.Format) ([]byte, error) {
+	if !format.Valid() {
+		return nil, fmt.Errorf("%w: unknown format %q", model.ErrInvalidImport, format)
+	}
+	names, err := s.store.ListTeams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	f := &orgfile.File{Teams: make([]orgfile.Team, 0, len(names))}
+	for _, name := range names {
+		t, err := s.store.GetTeam(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		ft := orgfile.Team{
+			Name:           t.Name,
+			Parent:         t.Parent,
+			LeadAssignment: t.LeadAssignment,
+			Members:        make([]orgfile.Member, len(t.Members)),
+		}
+		for i, u := range t.Members {
+			active := u.IsActive
+			ft.Members[i] = orgfile.Member{
+				UserID:    u.ID,
+				Username:  u.Username,
+				IsActive:  &active,
+				Seniority: u.Seniority,
+				Role:      u.Role,
+				Primary:   u.TeamName == t.Name,
+			}
+		}
+		f.Teams = append(f.Teams, ft)
+	}
+	var buf bytes.Buffer
+	if err := orgfile.Write(&buf, format, f); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// importPlan is the changes an import makes and the steps that make them, in
+// order. One step may make several changes.
+type importPlan struct {
+	changes []model.OrgChange
+	steps   []func(context.Context, store.Repository) error
+}
+
+func (p *importPlan) add(c model.OrgChange, step func(context.Context, store.Repository) error) {
+	p.changes = append(p.changes, c)
+	if step != nil {
+		p.steps = append(p.steps, step)
+	}
+}
+
+// planImport compares the file with the teams and users in r, and checks
+// that actor may give the roles it sets. Teams are created before any parent
+// is set, so a file may list sub-teams first.
+func (s *Service) planImport(ctx context.Context, r store.Repository, actor string, f *orgfile.File) (*importPlan, error) {
+	teams := make(map[string]*model.Team)
+	getTeam := func(name string) (*model.Team, error) {
+		if t, ok := teams[name]; ok {
+			return t, nil
+		}
+		t, err := r.GetTeam(ctx, name)
+		if errors.Is(err, model.ErrNotFound) {
+			t, err = nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		teams[name] = t
+		return t, nil
+	}
+	inFile := make(map[string]*orgfile.Team, len(f.Teams))
+	for i := range f.Teams {
+		inFile[f.Teams[i].Name] = &f.Teams[i]
+		if _, err := getTeam(f.Teams[i].Name); err != nil {
+			return nil, err
+		}
+	}
+
+	// parentOf is a team's parent once the file is applied.
+	parentOf := func(name string) (string, error) {
+		if t := inFile[name]; t != nil && t.Parent != "" {
+			return t.Parent, nil
+		}
+		t, err := getTeam(name)
+		if err != nil || t == nil {
+			return "", err
+		}
+		return t.Parent, nil
+	}
+	var errs orgfile.Errors
+	for _, t := range f.Teams {
+		if t.Parent == "" {
+			continue
+		}
+		if parent, err := getTeam(t.Parent); err != nil {
+			return nil, err
+		} else if parent == nil && inFile[t.Parent] == nil {
+			errs = append(errs, orgfile.Error{Line: t.Line, Field: "parent_team_name", Message: fmt.Sprintf("unknown team %s", t.Parent)})
+			continue
+		}
+		seen := map[string]bool{t.Name: true}
+		for name := t.Parent; name != ""; {
+			if seen[name] {
+				errs = append(errs, orgfile.Error{Line: t.Line, Field: "parent_team_name", Message: fmt.Sprintf("%v: %s is under %s", model.ErrTeamCycle, t.Parent, name)})
+				break
+			}
+			seen[name] = true
+			next, err := parentOf(name)
+			if err != nil {
+				return nil, err
+			}
+			name = next
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	p := &importPlan{}
+	for _, t := range f.Teams {
+		if teams[t.Name] != nil {
+			continue
+		}
+		name := t.Name
+		p.add(model.OrgChange{Action: model.OrgCreateTeam, TeamName: name}, func(ctx context.Context, r store.Repository) error {
+			return r.CreateTeam(ctx, name, "")
+		})
+	}
+	for _, t := range f.Teams {
+		cur := teams[t.Name]
+		if cur == nil {
+			cur = &model.Team{LeadAssignment: model.LeadsNormal}
+		}
+		name, parent, assign := t.Name, t.Parent, t.LeadAssignment
+		if parent != "" && parent != cur.Parent {
+			p.add(model.OrgChange{Action: model.OrgSetParent, TeamName: name, From: cur.Parent, To: parent}, func(ctx context.Context, r store.Repository) error {
+				_, err := r.SetTeamParent(ctx, name, parent)
+				return err
+			})
+		}
+		if assign != "" && assign != cur.LeadAssignment {
+			p.add(model.OrgChange{Action: model.OrgSetLeadAssignment, TeamName: name, From: string(cur.LeadAssignment), To: string(assign)}, func(ctx context.Context, r store.Repository) error {
+				_, err := r.SetTeamLeadAssignment(ctx, name, assign)
+				return err
+			})
+		}
+	}
+
+	// A user may be listed in several teams, each row giving some of their
+	// details; the file was checked to not contradict itself.
+	var order []string
+	users := make(map[string]*orgfile.Member)
+	for _, t := range f.Teams {
+		for _, m := range t.Members {
+			u, ok := users[m.UserID]
+			if !ok {
+				m := m
+				users[m.UserID] = &m
+				order = append(order, m.UserID)
+				continue
+			}
+			if u.IsActive == nil {
+				u.IsActive = m.IsActive
+			}
+			if u.Seniority == "" {
+				u.Seniority = m.Seniority
+			}
+		}
+	}
+	primary := make(map[string]string, len(users))
+	for _, id := range order {
+		cur, err := r.GetUser(ctx, id)
+		if errors.Is(err, model.ErrNotFound) {
+			cur = nil
+		} else if err != nil {
+			return nil, err
+		}
+		u := users[id]
+		username, active := u.Username, true
+		if u.IsActive != nil {
+			active = *u.IsActive
+		}
+		if cur == nil {
+			p.add(model.OrgChange{Action: model.OrgCreateUser, UserID: id, To: username}, func(ctx context.Context, r store.Repository) error {
+				return r.CreateUser(ctx, id, username, active)
+			})
+			cur = &model.User{IsActive: active}
+		} else {
+			primary[id] = cur.TeamName
+			if u.IsActive == nil {
+				active = cur.IsActive
+			}
+			if username != cur.Username || active != cur.IsActive {
+				step := func(ctx context.Context, r store.Repository) error {
+					return r.CreateUser(ctx, id, username, active)
+				}
+				if username != cur.Username {
+					p.add(model.OrgChange{Action: model.OrgRenameUser, UserID: id, From: cur.Username, To: username}, step)
+					step = nil
+				}
+				if active != cur.IsActive {
+					p.add(model.OrgChange{Action: model.OrgSetActive, UserID: id, From: strconv.FormatBool(cur.IsActive), To: strconv.FormatBool(active)}, step)
+				}
+			}
+		}
+		if seniority := u.Seniority; seniority != "" && seniority != cur.Seniority {
+			p.add(model.OrgChange{Action: model.OrgSetSeniority, UserID: id, From: string(cur.Seniority), To: string(seniority)}, func(ctx context.Context, r store.Repository) error {
+				return r.SetUserSeniority(ctx, id, seniority)
+			})
+		}
+	}
+
+	for _, t := range f.Teams {
+		roles := make(map[string]model.TeamRole)
+		if cur := teams[t.Name]; cur != nil {
+			for _, u := range cur.Members {
+				roles[u.ID] = u.Role
+			}
+		}
+		for _, m := range t.Members {
+			name, id, role := t.Name, m.UserID, m.Role
+			cur, member := roles[id]
+			if !member {
+				cur = model.RoleMember
+				p.add(model.OrgChange{Action: model.OrgAddMember, TeamName: name, UserID: id}, func(ctx context.Context, r store.Repository) error {
+					return r.AddTeamMember(ctx, name, id, false)
+				})
+				if primary[id] == "" {
+					primary[id] = name
+				}
+			}
+			if m.Primary && primary[id] != name {
+				p.add(model.OrgChange{Action: model.OrgSetPrimary, TeamName: name, UserID: id, From: primary[id], To: name}, func(ctx context.Context, r store.Repository) error {
+					return r.AddTeamMember(ctx, name, id, true)
+				})
+				primary[id] = name
+			}
+			if role != "" && role != cur {
+				if err := s.authorize(ctx, actor, name, model.RoleLead); err != nil {
+					return nil, err
+				}
+				p.add(model.OrgChange{Action: model.OrgSetRole, TeamName: name, UserID: id, From: string(cur), To: string(role)}, func(ctx context.Context, r store.Repository) error {
+					_, err := r.SetTeamMemberRole(ctx, name, id, role)
+					return err
+				})
+			}
+		}
+	}
+	return p, nil
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"avito-pr-reviewer/internal/model"
+)
+
+const maxReviewerCount = 10
+
+// policy is how reviewers are chosen for one pull request.
+type policy struct {
+	count        int
+	strategy     model.AssignmentStrategy
+	requireOwner bool
+	excluded     []string
+	// leads of the pull request's team are picked as leadAssignment says.
+	leads          []string
+	leadAssignment model.LeadAssignment
+}
+
+// policyFor returns the server defaults with the overrides of the repository,
+// if any.
+func (s *Service) policyFor(ctx context.Context, repositoryID string) (policy, error) {
+	p := policy{count: model.DefaultReviewerCount, strategy: s.strategy}
+	if repositoryID == "" {
+		return p, nil
+	}
+	repo, err := s.store.GetRepository(ctx, repositoryID)
+	if err != nil {
+		return p, err
+	}
+	if repo.ReviewerCount != nil {
+		p.count = *repo.ReviewerCount
+	}
+	if repo.Strategy != "" {
+		p.strategy = repo.Strategy
+	}
+	p.requireOwner = repo.RequireOwner
+	p.excluded = repo.ExcludedUsers
+	return p, nil
+}
+
+// eligible drops the users the policy excludes.
+func (p policy) eligible(ids []string) []string {
+	res := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !contains(p.excluded, id) && !p.leadExcluded(id) {
+			res = append(res, id)
+		}
+	}
+	return res
+}
+
+func (p policy) leadExcluded(id string) bool {
+	return p.leadAssignment == model.LeadsExcluded && contains(p.leads, id)
+}
+
+// lastResort moves the leads behind everyone else in ranked ids when they are
+// only a last resort.
+func (p policy) lastResort(ids []string) []string {
+	if p.leadAssignment != model.LeadsLastResort {
+		return ids
+	}
+	res := make([]string, 0, len(ids))
+	var leads []string
+	for _, id := range ids {
+		if contains(p.leads, id) {
+			leads = append(leads, id)
+		} else {
+			res = append(res, id)
+		}
+	}
+	return append(res, leads...)
+}
+
+func validateRepository(r *model.Repository) error {
+	if r.Strategy != "" && !r.Strategy.Valid() {
+		return fmt.Errorf("%w: unknown strategy %q", model.ErrInvalidRepository, r.Strategy)
+	}
+	if r.ReviewerCount != nil && (*r.ReviewerCount < 0 || *r.ReviewerCount > maxReviewerCount) {
+		return fmt.Errorf("%w: reviewer_count must be between 0 and %d", model.ErrInvalidRepository, maxReviewerCount)
+	}
+	return nil
+}
+
+func (s *Service) CreateRepository(ctx context.Context, r *model.Repository) (*model.Repository, error) {
+	if err := validateRepository(r); err != nil {
+		return nil, err
+	}
+	if _, err := s.store.GetTeam(ctx, r.TeamName); err != nil {
+		return nil, err
+	}
+	created, err := s.store.CreateRepository(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if !created {
+		return nil, model.ErrRepoExists
+	}
+	return s.store.GetRepository(ctx, r.ID)
+}
+
+func (s *Service) GetRepository(ctx context.Context, id string) (*model.Repository, error) {
+	return s.store.GetRepository(ctx, id)
+}
+
+// ListRepositories lists the repositories of teamName, or all of them if it is empty.
+func (s *Service) ListRepositories(ctx context.Context, teamName string) ([]model.Repository, error) {
+	if teamName != "" {
+		if _, err := s.store.GetTeam(ctx, teamName); err != nil {
+			return nil, err
+		}
+	}
+	return s.store.ListRepositories(ctx, teamName)
+}
+
+// UpdateRepository replaces the repository's name, owning team and policy.
+func (s *Service) UpdateRepository(ctx context.Context, r *model.Repository) (*model.Repository, error) {
+	if err := validateRepository(r); err != nil {
+		return nil, err
+	}
+	if _, err := s.store.GetTeam(ctx, r.TeamName); err != nil {
+		return nil, err
+	}
+	updated, err := s.store.UpdateRepository(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, model.ErrNotFound
+	}
+	return s.store.GetRepository(ctx, r.ID)
+}
+
+// DeleteRepository removes the repository. Its pull requests are kept and
+// fall back to the default policy.
+func (s *Service) DeleteRepository(ctx context.Context, id string) error {
+	deleted, err := s.store.DeleteRepository(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return model.ErrNotFound
+	}
+	return nil
+}
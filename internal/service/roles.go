@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+
+	"avito-pr-reviewer/internal/model"
+)
+
+// SetMemberRole gives a member of the team a role in it. Only a lead of the
+// team or of a department above it may hand out roles.
+func (s *Service) SetMemberRole(ctx context.Context, actor, teamName, userID string, role model.TeamRole) (*model.Team, error) {
+	if !role.Valid() {
+		return nil, fmt.Errorf("%w: %q", model.ErrInvalidRole, role)
+	}
+	if _, err := s.store.GetTeam(ctx, teamName); err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, actor, teamName, model.RoleLead); err != nil {
+		return nil, err
+	}
+	updated, err := s.store.SetTeamMemberRole(ctx, teamName, userID, role)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, fmt.Errorf("%w: %s is not a member of %s", model.ErrNotFound, userID, teamName)
+	}
+	return s.store.GetTeam(ctx, teamName)
+}
+
+// SetLeadAssignment sets how the team's leads take part in automatic
+// assignment. Only a lead may change it.
+func (s *Service) SetLeadAssignment(ctx context.Context, actor, teamName string, a model.LeadAssignment) (*model.Team, error) {
+	if !a.Valid() {
+		return nil, fmt.Errorf("%w: %q", model.ErrInvalidLeadAssign, a)
+	}
+	if _, err := s.store.GetTeam(ctx, teamName); err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, actor, teamName, model.RoleLead); err != nil {
+		return nil, err
+	}
+	if _, err := s.store.SetTeamLeadAssignment(ctx, teamName, a); err != nil {
+		return nil, err
+	}
+	return s.store.GetTeam(ctx, teamName)
+}
+
+type trustedKey struct{}
+
+// Trusted marks calls made with ctx as coming from a trusted caller, such as
+// an operator holding the admin token, which may give roles without an actor.
+func Trusted(ctx context.Context) context.Context {
+	return context.WithValue(ctx, trustedKey{}, true)
+}
+
+// TrustBearer marks ctx as trusted if authorization, the value of an HTTP
+// header or gRPC metadata, is "Bearer <adminToken>". An empty adminToken
+// trusts none.
+func TrustBearer(ctx context.Context, adminToken, authorization string) context.Context {
+	token, ok := strings.CutPrefix(authorization, "Bearer ")
+	if ok && adminToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) == 1 {
+		return Trusted(ctx)
+	}
+	return ctx
+}
+
+// anonymous checks that a call without an actor is trusted.
+func anonymous(ctx context.Context) error {
+	if trusted, _ := ctx.Value(trustedKey{}).(bool); trusted {
+		return nil
+	}
+	return fmt.Errorf("%w: an actor is required", model.ErrForbidden)
+}
+
+// authorize checks that actor holds one of roles in the team or in a
+// department above it. Without an actor only trusted calls may proceed, so
+// operations that stay open to anonymous callers check only a given actor.
+func (s *Service) authorize(ctx context.Context, actor, teamName string, roles ...model.TeamRole) error {
+	if actor == "" {
+		return anonymous(ctx)
+	}
+	held, err := s.store.ListTeamRoles(ctx, teamName, actor)
+	if err != nil {
+		return err
+	}
+	for _, r := range held {
+		for _, want := range roles {
+			if r == want {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("%w: %s may not manage %s", model.ErrForbidden, actor, teamName)
+}
+
+// authorizeUser checks that actor may change u: u themselves or a lead of
+// one of u's teams. Calls without an actor are not checked.
+func (s *Service) authorizeUser(ctx context.Context, actor string, u *model.User) error {
+	if actor == "" || actor == u.ID {
+		return nil
+	}
+	for _, team := range u.Teams {
+		err := s.authorize(ctx, actor, team, model.RoleLead)
+		if !errors.Is(err, model.ErrForbidden) {
+			return err
+		}
+	}
+	return fmt.Errorf("%w: %s leads none of the teams of %s", model.ErrForbidden, actor, u.ID)
+}
+
+// withLeads adds the leads of the pull request's team and how they are
+// assigned to p.
+func (s *Service) withLeads(ctx context.Context, p *policy, teamName string) error {
+	if teamName == "" {
+		return nil
+	}
+	t, err := s.store.GetTeam(ctx, teamName)
+	if err != nil {
+		return err
+	}
+	p.leadAssignment = t.LeadAssignment
+	for _, m := range t.Members {
+		if m.Role == model.RoleLead {
+			p.leads = append(p.leads, m.ID)
+		}
+	}
+	return nil
+}
+
+// escalationLeads returns the leads of the team, or of the nearest
+// department above it that has any.
+func (s *Service) escalationLeads(ctx context.Context, teamName string) ([]string, error) {
+	if teamName == "" {
+		return nil, nil
+	}
+	ancestors, err := s.store.ListTeamAncestors(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	for _, team := range ancestors {
+		leads, err := s.store.ListTeamLeads(ctx, team)
+		if err != nil || len(leads) > 0 {
+			return leads, err
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	"avito-pr-reviewer/internal/model"
+	"avito-pr-reviewer/internal/rules"
+)
+
+// SetTeamRules validates and saves the team's assignment rules.
+func (s *Service) SetTeamRules(ctx context.Context, r *model.TeamRules) (*model.TeamRules, error) {
+	if _, err := rules.Parse(r.Source); err != nil {
+		return nil, err
+	}
+	if _, err := s.store.GetTeam(ctx, r.TeamName); err != nil {
+		return nil, err
+	}
+	if err := s.store.SetTeamRules(ctx, r); err != nil {
+		return nil, err
+	}
+	return s.store.GetTeamRules(ctx, r.TeamName)
+}
+
+func (s *Service) GetTeamRules(ctx context.Context, teamName string) (*model.TeamRules, error) {
+	return s.store.GetTeamRules(ctx, teamName)
+}
+
+// teamRules returns the team's parsed rules; teams without rules get an empty set.
+func (s *Service) teamRules(ctx context.Context, teamName string) (*rules.Set, error) {
+	r, err := s.store.GetTeamRules(ctx, teamName)
+	if errors.Is(err, model.ErrNotFound) {
+		return &rules.Set{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rules.Parse(r.Source)
+}
+
+// candidates describes ids for rule evaluation. Without rules nothing but the
+// ids is needed, which saves the lookups.
+func (s *Service) candidates(ctx context.Context, set *rules.Set, ids []string) ([]model.Candidate, error) {
+	if len(set.Rules) == 0 {
+		res := make([]model.Candidate, len(ids))
+		for i, id := range ids {
+			res[i] = model.Candidate{UserID: id}
+		}
+		return res, nil
+	}
+	return s.store.GetCandidates(ctx, ids)
+}
+
+// addTeamMembers appends the active members of teams, other than the author
+// and those already in ids.
+func (s *Service) addTeamMembers(ctx context.Context, ids []string, teams []string, authorID string) ([]string, error) {
+	for _, team := range teams {
+		members, err := s.store.GetActiveUsersInTeamExcluding(ctx, team, authorID)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range members {
+			if !contains(ids, m) {
+				ids = append(ids, m)
+			}
+		}
+	}
+	return ids, nil
+}
+
+// choice is how the reviewers of a new pull request were chosen, as far as
+// it got.
+type choice struct {
+	// teams are the teams the candidates were drawn from.
+	teams  []string
+	policy policy
+	pr     rules.PR
+	seed   int64
+	// at is when the candidates' working hours are checked.
+	at time.Time
+	// owners are all active code owners, before exclusions.
+	owners    []string
+	decision  *rules.Decision
+	reviewers []string
+	// pool, active and working are the candidate pool as snapshotPool found
+	// it, when an explanation was asked for.
+	pool    []model.Candidate
+	active  map[string]bool
+	working map[string]bool
+}
+
+// prTeam resolves the team a new pull request draws reviewers from: the one
+// asked for, else the repository's team, else the author's primary team.
+func (s *Service) prTeam(ctx context.Context, author *model.User, req model.NewPullRequest) (string, error) {
+	if req.TeamName != "" {
+		if _, err := s.store.GetTeam(ctx, req.TeamName); err != nil {
+			return "", err
+		}
+		return req.TeamName, nil
+	}
+	if req.RepositoryID != "" {
+		repo, err := s.store.GetRepository(ctx, req.RepositoryID)
+		if err != nil {
+			return "", err
+		}
+		if repo.TeamName != "" {
+			return repo.TeamName, nil
+		}
+	}
+	return author.TeamName, nil
+}
+
+// chooseReviewers picks the reviewers of a new pull request by author from
+// req.TeamName, which prTeam has resolved.
+func (s *Service) chooseReviewers(ctx context.Context, author *model.User, req model.NewPullRequest, set *rules.Set) (*choice, error) {
+	c := &choice{
+		pr:   rules.PR{AuthorID: author.ID, RepositoryID: req.RepositoryID, Labels: req.Labels},
+		seed: s.seedFor(req.Seed),
+		at:   req.CreatedAt,
+	}
+	if c.at.IsZero() {
+		// Postgres keeps microseconds, so the stored time replays exactly.
+		c.at = time.Now().Truncate(time.Microsecond)
+	}
+	rng := rand.New(rand.NewSource(c.seed))
+	var err error
+	c.policy, err = s.policyFor(ctx, req.RepositoryID)
+	if err != nil {
+		return c, err
+	}
+	if err := s.withLeads(ctx, &c.policy, req.TeamName); err != nil {
+		return c, err
+	}
+	p := c.policy
+
+	c.teams = append([]string{req.TeamName}, set.Teams(c.pr)...)
+	users, err := s.store.GetActiveUsersInTeamExcluding(ctx, req.TeamName, author.ID)
+	if err != nil {
+		return c, model.ErrNotFound
+	}
+	if users, err = s.addTeamMembers(ctx, users, c.teams[1:], author.ID); err != nil {
+		return c, err
+	}
+	users = p.eligible(users)
+	if len(users) < p.count {
+		// A sub-team too small for the policy borrows from its sibling teams.
+		siblings, err := s.store.ListSiblingTeams(ctx, req.TeamName)
+		if err != nil {
+			return c, err
+		}
+		if users, err = s.addTeamMembers(ctx, users, siblings, author.ID); err != nil {
+			return c, err
+		}
+		users = p.eligible(users)
+		c.teams = append(c.teams, siblings...)
+	}
+
+	c.owners, err = s.codeOwners(ctx, author, req)
+	if err != nil {
+		return c, err
+	}
+	if req.Explain {
+		if err := s.snapshotPool(ctx, author.ID, c); err != nil {
+			return c, err
+		}
+	}
+	ownerInfo, err := s.candidates(ctx, set, p.eligible(c.owners))
+	if err != nil {
+		return c, err
+	}
+	owners := make([]string, 0, len(ownerInfo))
+	for _, o := range ownerInfo {
+		if set.Excludes(c.pr, o) == nil {
+			owners = append(owners, o.UserID)
+		}
+	}
+	if len(owners) == 0 && p.requireOwner {
+		return c, model.ErrNoOwner
+	}
+
+	if err := s.rank(ctx, rng, c.at, p.strategy, author.ID, req.Labels, owners); err != nil {
+		return c, err
+	}
+	if err := s.rank(ctx, rng, c.at, p.strategy, author.ID, req.Labels, users); err != nil {
+		return c, err
+	}
+	owners, users = p.lastResort(owners), p.lastResort(users)
+	info, err := s.candidates(ctx, set, users)
+	if err != nil {
+		return c, err
+	}
+	c.decision, err = set.Evaluate(c.pr, info)
+	if err != nil {
+		return c, err
+	}
+
+	var required []string
+	for _, r := range c.decision.Required {
+		required = append(required, r.UserIDs...)
+	}
+	c.reviewers, err = s.compose(ctx, author.Seniority, p.count, required, owners, c.decision.Eligible)
+	return c, err
+}
+
+// EvaluateRules explains how reviewers would be chosen for a pull request
+// like req, without creating it. A non-nil source is evaluated instead of the
+// team's saved rules.
+func (s *Service) EvaluateRules(ctx context.Context, req model.NewPullRequest, source *string) (*model.RuleEvaluation, error) {
+	labels, err := normalizeTags(req.Labels)
+	if err != nil {
+		return nil, err
+	}
+	req.Labels = labels
+	author, err := s.store.GetUser(ctx, req.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+	if req.TeamName, err = s.prTeam(ctx, author, req); err != nil {
+		return nil, err
+	}
+	var set *rules.Set
+	if source != nil {
+		set, err = rules.Parse(*source)
+	} else {
+		set, err = s.teamRules(ctx, req.TeamName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.chooseReviewers(ctx, author, req, set)
+	res := &model.RuleEvaluation{TeamName: req.TeamName, Seed: c.seed, Reviewers: c.reviewers}
+	switch {
+	case errors.Is(err, model.ErrComposition), errors.Is(err, model.ErrNoOwner):
+		res.Error = err.Error()
+	case err != nil:
+		return nil, err
+	}
+
+	for i := range set.Rules {
+		r := &set.Rules[i]
+		res.Rules = append(res.Rules, model.RuleResult{Line: r.Line, Rule: r.Text, Applied: r.Applies(c.pr)})
+	}
+	if d := c.decision; d != nil {
+		for _, e := range d.Excluded {
+			res.Excluded = append(res.Excluded, model.RuleExclusion{UserID: e.UserID, Line: e.Rule.Line, Rule: e.Rule.Text})
+		}
+		for _, r := range d.Required {
+			res.Required = append(res.Required, model.RuleRequirement{Line: r.Rule.Line, Rule: r.Rule.Text, UserIDs: r.UserIDs})
+		}
+	}
+	return res, nil
+}
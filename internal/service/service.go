@@ -1,33 +1,124 @@
 package service
 
 import (
+	"avito-pr-reviewer/internal/businesstime"
 	"avito-pr-reviewer/internal/model"
+	"avito-pr-reviewer/internal/notifier"
+	"avito-pr-reviewer/internal/rules"
 	"avito-pr-reviewer/internal/store"
-	"avito-pr-reviewer/internal/util"
 	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
 )
 
 type Service struct {
-	store store.Repository
+	store         store.Repository
+	notifier      notifier.Notifier
+	calc          *businesstime.Calculator
+	schedule      businesstime.Schedule
+	preferInHours bool
+	strategy      model.AssignmentStrategy
+	pairingWindow time.Duration
+
+	randMu *sync.Mutex
+	rand   *rand.Rand
+
+	// after collects the events and notifications of a transaction, see inTx.
+	after *[]func(*Service)
+}
+
+type Option func(*Service)
+
+// WithNotifier sends reviewers a message whenever they are assigned to a pull
+// request. Several notifiers all receive every message.
+func WithNotifier(n notifier.Notifier) Option {
+	return func(s *Service) {
+		switch cur := s.notifier.(type) {
+		case nil:
+			s.notifier = n
+		case notifier.Multi:
+			s.notifier = append(cur, n)
+		default:
+			s.notifier = notifier.Multi{cur, n}
+		}
+	}
 }
 
-func New(store store.Repository) *Service {
-	return &Service{store: store}
+func New(store store.Repository, opts ...Option) *Service {
+	s := &Service{
+		store:         store,
+		calc:          businesstime.NewCalculator(nil),
+		schedule:      businesstime.Default,
+		strategy:      model.StrategyRandom,
+		pairingWindow: 30 * 24 * time.Hour,
+		randMu:        new(sync.Mutex),
+		rand:          rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// inTx runs fn with a copy of s that works within a store transaction. The
+// events and notifications fn causes are sent once the transaction commits.
+func (s *Service) inTx(ctx context.Context, fn func(tx *Service) error) error {
+	var after []func(*Service)
+	err := s.store.WithinTx(ctx, func(r store.Repository) error {
+		tx := *s
+		tx.store = r
+		tx.after = &after
+		return fn(&tx)
+	})
+	if err != nil {
+		return err
+	}
+	for _, f := range after {
+		f(s)
+	}
+	return nil
 }
 
 func (s *Service) GetUser(ctx context.Context, userID string) (*model.User, error) {
 	return s.store.GetUser(ctx, userID)
 }
 
-func (s *Service) CreateTeam(ctx context.Context, name string, members []model.User) error {
-	err := s.store.CreateTeam(ctx, name)
+// CreateTeam creates a team, under t.Parent if set, and its members. Existing
+// users keep their other teams; a member whose TeamName names the new team
+// makes it their primary. The new team has no leads yet, so only a lead of
+// t.Parent or a trusted caller may give members roles.
+func (s *Service) CreateTeam(ctx context.Context, actor string, t model.Team) error {
+	if t.Parent != "" {
+		if _, err := s.store.GetTeam(ctx, t.Parent); err != nil {
+			return err
+		}
+	}
+	for _, m := range t.Members {
+		if m.Role != "" {
+			if err := s.authorize(ctx, actor, t.Parent, model.RoleLead); err != nil {
+				return err
+			}
+			break
+		}
+	}
+	if t.LeadAssignment != "" && !t.LeadAssignment.Valid() {
+		return fmt.Errorf("%w: %q", model.ErrInvalidLeadAssign, t.LeadAssignment)
+	}
+	err := s.store.CreateTeam(ctx, t.Name, t.Parent)
 	if err != nil {
 		return model.ErrTeamExists
 	}
+	if t.LeadAssignment != "" {
+		if _, err := s.store.SetTeamLeadAssignment(ctx, t.Name, t.LeadAssignment); err != nil {
+			return err
+		}
+	}
 
-	for _, m := range members {
-		err := s.store.CreateUser(ctx, m.ID, m.Username, name, m.IsActive)
-		if err != nil {
+	for _, m := range t.Members {
+		if err := s.addMember(ctx, t.Name, m); err != nil {
 			return err
 		}
 	}
@@ -38,46 +129,82 @@ func (s *Service) GetTeam(ctx context.Context, name string) (*model.Team, error)
 	return s.store.GetTeam(ctx, name)
 }
 
-func (s *Service) SetActive(ctx context.Context, userID string, isActive bool) error {
-	_, err := s.store.GetUser(ctx, userID)
+// SetActive turns a user's reviews on or off. An actor may change only
+// themselves or the members of teams they lead.
+func (s *Service) SetActive(ctx context.Context, actor, userID string, isActive bool) error {
+	u, err := s.store.GetUser(ctx, userID)
 	if err != nil {
 		return model.ErrNotFound
 	}
+	if err := s.authorizeUser(ctx, actor, u); err != nil {
+		return err
+	}
 
 	return s.store.SetUserActive(ctx, userID, isActive)
 }
 
-func (s *Service) CreatePR(ctx context.Context, id, name, authorID string) (*model.PullRequest, error) {
-	author, err := s.store.GetUser(ctx, authorID)
+// CreatePR assigns reviewers from the pull request's team according to the
+// repository's policy, the team's rules and the composition rules. If the
+// changed files have code owners, one of the reviewers is always an owner.
+// With req.Explain it also tells how the reviewers were chosen.
+func (s *Service) CreatePR(ctx context.Context, req model.NewPullRequest) (*model.PullRequest, *model.Explanation, error) {
+	var err error
+	req.Labels, err = normalizeTags(req.Labels)
 	if err != nil {
-		return nil, model.ErrNotFound
+		return nil, nil, err
 	}
-
-	users, err := s.store.GetActiveUsersInTeamExcluding(ctx, author.TeamName, authorID)
+	author, err := s.store.GetUser(ctx, req.AuthorID)
 	if err != nil {
-		return nil, model.ErrNotFound
+		return nil, nil, model.ErrNotFound
 	}
-
-	reviewers := make([]string, 0, 2)
-	for _, u := range users {
-		reviewers = append(reviewers, u)
+	if req.TeamName, err = s.prTeam(ctx, author, req); err != nil {
+		return nil, nil, err
 	}
-
-	if len(reviewers) > 2 {
-		util.Shuffle(reviewers)
-		reviewers = reviewers[:2]
+	set, err := s.teamRules(ctx, req.TeamName)
+	if err != nil {
+		return nil, nil, err
+	}
+	c, err := s.chooseReviewers(ctx, author, req, set)
+	if err != nil {
+		return nil, nil, err
+	}
+	reviewers := c.reviewers
+	var e *model.Explanation
+	if req.Explain {
+		e = s.explain(author, set, c)
 	}
 
-	err = s.store.CreatePR(ctx, id, name, authorID, reviewers)
+	err = s.store.CreatePR(ctx, &model.PullRequest{
+		ID:                req.ID,
+		Name:              req.Name,
+		AuthorID:          req.AuthorID,
+		AssignedReviewers: reviewers,
+		Labels:            req.Labels,
+		RepositoryID:      req.RepositoryID,
+		AssignmentSeed:    &c.seed,
+		TeamName:          req.TeamName,
+		CreatedAt:         c.at,
+	})
 	if err != nil {
-		return nil, model.ErrPRExists
+		return nil, nil, model.ErrPRExists
+	}
+	if len(reviewers) > 0 {
+		if err := s.store.AddReviewAssignments(ctx, req.ID, reviewers); err != nil {
+			return nil, nil, err
+		}
 	}
 
-	pr, err := s.store.GetPR(ctx, id)
+	pr, err := s.store.GetPR(ctx, req.ID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return pr, nil
+	if len(pr.AssignedReviewers) > 0 {
+		s.emit(ctx, model.EventReviewAssigned, pr.TeamName, pr.AssignedReviewers, model.EventPayload{PR: pr})
+	}
+	for _, r := range pr.AssignedReviewers {
+		s.notify(ctx, notifier.Notification{Type: model.EventReviewAssigned, UserID: r, PR: pr})
+	}
+	return pr, e, nil
 }
 
 func (s *Service) MergePR(ctx context.Context, prID string) (*model.PullRequest, error) {
@@ -97,10 +224,30 @@ func (s *Service) MergePR(ctx context.Context, prID string) (*model.PullRequest,
 	if err != nil {
 		return nil, err
 	}
+	s.emitMerged(ctx, pr)
 	return pr, nil
 }
 
-func (s *Service) ReassignReviewer(ctx context.Context, prID, oldUserID string) (newUserID string, prOut *model.PullRequest, err error) {
+// ReassignReviewer replaces oldUserID on the pull request. An actor may hand
+// off their own review; anyone else's needs a lead or maintainer of the pull
+// request's team.
+func (s *Service) ReassignReviewer(ctx context.Context, actor, prID, oldUserID string) (newUserID string, prOut *model.PullRequest, err error) {
+	if actor != "" && actor != oldUserID {
+		pr, err := s.store.GetPR(ctx, prID)
+		if err != nil {
+			return "", nil, model.ErrNotFound
+		}
+		if err := s.authorize(ctx, actor, pr.TeamName, model.RoleLead, model.RoleMaintainer); err != nil {
+			return "", nil, err
+		}
+	}
+	return s.reassign(ctx, prID, oldUserID, model.ReassignManual, nil)
+}
+
+// reassign replaces oldUserID on the pull request and records why. A non-nil
+// claim is checked in the transaction that makes the change; if it reports
+// false, nothing is changed and model.ErrNotAssigned is returned.
+func (s *Service) reassign(ctx context.Context, prID, oldUserID string, reason model.ReassignReason, claim func(store.Repository) (bool, error)) (newUserID string, prOut *model.PullRequest, err error) {
 	pr, err := s.store.GetPR(ctx, prID)
 	if err != nil {
 		return "", nil, model.ErrNotFound
@@ -120,38 +267,68 @@ func (s *Service) ReassignReviewer(ctx context.Context, prID, oldUserID string)
 		return "", nil, model.ErrNotAssigned
 	}
 
-	oldUser, err := s.store.GetUser(ctx, oldUserID)
-	if err != nil {
+	if _, err := s.store.GetUser(ctx, oldUserID); err != nil {
 		return "", nil, model.ErrNotFound
 	}
 
-	candidates, err := s.store.GetActiveUsersInTeamExcluding(ctx, oldUser.TeamName, oldUserID)
+	author, err := s.store.GetUser(ctx, pr.AuthorID)
 	if err != nil {
 		return "", nil, err
 	}
+	set, err := s.teamRules(ctx, pr.TeamName)
+	if err != nil {
+		return "", nil, err
+	}
+	rpr := rules.PR{AuthorID: pr.AuthorID, RepositoryID: pr.RepositoryID, Labels: pr.Labels}
 
-	available := make([]string, 0)
-	for _, c := range candidates {
-		isAssigned := false
-		for _, r := range pr.AssignedReviewers {
-			if c == r {
-				isAssigned = true
-				break
-			}
+	candidates, err := s.store.GetActiveUsersInTeamExcluding(ctx, pr.TeamName, oldUserID)
+	if err != nil {
+		return "", nil, err
+	}
+	if candidates, err = s.addTeamMembers(ctx, candidates, set.Teams(rpr), pr.AuthorID); err != nil {
+		return "", nil, err
+	}
+	p, err := s.policyFor(ctx, pr.RepositoryID)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := s.withLeads(ctx, &p, pr.TeamName); err != nil {
+		return "", nil, err
+	}
+	available := replacements(p.eligible(candidates), pr, oldUserID)
+	if len(available) == 0 {
+		// A sub-team with nobody left borrows from its sibling teams.
+		siblings, err := s.store.ListSiblingTeams(ctx, pr.TeamName)
+		if err != nil {
+			return "", nil, err
 		}
-		if !isAssigned && c != oldUserID {
-			available = append(available, c)
+		borrowed, err := s.addTeamMembers(ctx, nil, siblings, pr.AuthorID)
+		if err != nil {
+			return "", nil, err
 		}
+		available = replacements(p.eligible(borrowed), pr, oldUserID)
 	}
 
-	if len(available) == 0 {
+	seed, err := s.reassignSeed(ctx, pr)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := s.rank(ctx, rand.New(rand.NewSource(seed)), time.Now(), p.strategy, pr.AuthorID, pr.Labels, available); err != nil {
+		return "", nil, err
+	}
+	available = p.lastResort(available)
+	info, err := s.candidates(ctx, set, available)
+	if err != nil {
+		return "", nil, err
+	}
+	// Require rules are checked for each replacement instead.
+	d, _ := set.Evaluate(rpr, info)
+	if len(d.Eligible) == 0 {
 		return "", nil, model.ErrNoCandidate
 	}
-
-	newUserID = available[0]
-	if len(available) > 1 {
-		util.Shuffle(available)
-		newUserID = available[0]
+	newUserID, err = s.replacement(ctx, pr, author, set, oldUserID, d.Eligible)
+	if err != nil {
+		return "", nil, err
 	}
 
 	newReviewers := make([]string, len(pr.AssignedReviewers))
@@ -163,15 +340,63 @@ func (s *Service) ReassignReviewer(ctx context.Context, prID, oldUserID string)
 		}
 	}
 
-	err = s.store.UpdatePRReviewers(ctx, prID, newReviewers)
+	err = s.store.WithinTx(ctx, func(r store.Repository) error {
+		if claim != nil {
+			claimed, err := claim(r)
+			if err != nil {
+				return err
+			}
+			if !claimed {
+				return model.ErrNotAssigned
+			}
+		}
+		if err := r.UpdatePRReviewers(ctx, prID, newReviewers); err != nil {
+			return err
+		}
+		if err := r.DeleteReviewAssignment(ctx, prID, oldUserID); err != nil {
+			return err
+		}
+		if err := r.AddReviewAssignments(ctx, prID, []string{newUserID}); err != nil {
+			return err
+		}
+		return r.CreateReassignment(ctx, &model.Reassignment{
+			PullRequestID: prID,
+			OldReviewerID: oldUserID,
+			NewReviewerID: newUserID,
+			Reason:        reason,
+		})
+	})
 	if err != nil {
 		return "", nil, err
 	}
 
 	pr.AssignedReviewers = newReviewers
+	s.emit(ctx, model.EventReviewReassigned, pr.TeamName, []string{oldUserID, newUserID}, model.EventPayload{
+		PR:            pr,
+		OldReviewerID: oldUserID,
+		ReplacedBy:    newUserID,
+		Reason:        string(reason),
+	})
+	s.notify(ctx, notifier.Notification{
+		Type:          model.EventReviewReassigned,
+		UserID:        newUserID,
+		PR:            pr,
+		OldReviewerID: oldUserID,
+	})
 	return newUserID, pr, nil
 }
 
+// replacements returns the candidates who may replace oldUserID on pr.
+func replacements(candidates []string, pr *model.PullRequest, oldUserID string) []string {
+	available := make([]string, 0)
+	for _, c := range candidates {
+		if !contains(pr.AssignedReviewers, c) && c != oldUserID {
+			available = append(available, c)
+		}
+	}
+	return available
+}
+
 func (s *Service) GetUserReviews(ctx context.Context, userID string) ([]model.PullRequest, error) {
 	_, err := s.store.GetUser(ctx, userID)
 	if err != nil {
@@ -181,10 +406,33 @@ func (s *Service) GetUserReviews(ctx context.Context, userID string) ([]model.Pu
 	return s.store.GetPRsByReviewer(ctx, userID)
 }
 
-func (s *Service) GetStats(ctx context.Context) (map[string]int64, error) {
-	return s.store.GetPRCountByReviewer(ctx)
+// GetStats counts open reviews per reviewer. A teamName limits them to the
+// pull requests of the team and its sub-teams.
+func (s *Service) GetStats(ctx context.Context, teamName string) (map[string]int64, error) {
+	if teamName != "" {
+		if _, err := s.store.GetTeam(ctx, teamName); err != nil {
+			return nil, err
+		}
+	}
+	return s.store.GetPRCountByReviewer(ctx, teamName)
 }
 
-func (s *Service) MassDeactivate(ctx context.Context, teamName string, userIDs []string) error {
+// MassDeactivate deactivates the users. An actor must lead a team of each of
+// them; unknown users are skipped.
+func (s *Service) MassDeactivate(ctx context.Context, actor, teamName string, userIDs []string) error {
+	if actor != "" {
+		for _, id := range userIDs {
+			u, err := s.store.GetUser(ctx, id)
+			if errors.Is(err, model.ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if err := s.authorizeUser(ctx, actor, u); err != nil {
+				return err
+			}
+		}
+	}
 	return s.store.DeactivateUsers(ctx, userIDs)
 }
@@ -0,0 +1,787 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"avito-pr-reviewer/internal/businesstime"
+	"avito-pr-reviewer/internal/model"
+	"avito-pr-reviewer/internal/orgfile"
+	"avito-pr-reviewer/internal/store"
+)
+
+// fakeStore keeps just enough in memory to create pull requests and reassign
+// their reviewers. Other methods panic through the nil Repository.
+type fakeStore struct {
+	store.Repository
+	users         map[string]*model.User
+	prs           map[string]*model.PullRequest
+	reassignments map[string][]model.Reassignment
+	// parents maps sub-teams to their departments.
+	parents map[string]string
+	// roles maps teams to the roles of members other than plain ones.
+	roles          map[string]map[string]model.TeamRole
+	leadAssignment map[string]model.LeadAssignment
+	stale          []model.StaleCandidate
+	// claimed holds the stale reviews another replica has claimed, as
+	// "pr/reviewer".
+	claimed map[string]bool
+	tags    map[string][]string
+}
+
+func newFakeStore(team string, ids ...string) *fakeStore {
+	f := &fakeStore{
+		users:          make(map[string]*model.User),
+		prs:            make(map[string]*model.PullRequest),
+		reassignments:  make(map[string][]model.Reassignment),
+		parents:        make(map[string]string),
+		roles:          make(map[string]map[string]model.TeamRole),
+		leadAssignment: make(map[string]model.LeadAssignment),
+		tags:           make(map[string][]string),
+	}
+	for _, id := range ids {
+		f.users[id] = &model.User{ID: id, Username: id, TeamName: team, Teams: []string{team}, IsActive: true, Seniority: model.SeniorityMiddle}
+	}
+	return f
+}
+
+func (f *fakeStore) GetUser(_ context.Context, id string) (*model.User, error) {
+	u, ok := f.users[id]
+	if !ok {
+		return nil, model.ErrNotFound
+	}
+	res := *u
+	return &res, nil
+}
+
+func (f *fakeStore) GetActiveUsersInTeamExcluding(_ context.Context, teamName, excludeUserID string) ([]string, error) {
+	var ids []string
+	for _, u := range f.users {
+		if contains(u.Teams, teamName) && u.IsActive && u.ID != excludeUserID {
+			ids = append(ids, u.ID)
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+func (f *fakeStore) GetTeamRules(context.Context, string) (*model.TeamRules, error) {
+	return nil, model.ErrNotFound
+}
+
+func (f *fakeStore) GetSeniorities(_ context.Context, ids []string) (map[string]model.Seniority, error) {
+	res := make(map[string]model.Seniority, len(ids))
+	for _, id := range ids {
+		if u, ok := f.users[id]; ok {
+			res[id] = u.Seniority
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeStore) CreatePR(_ context.Context, pr *model.PullRequest) error {
+	if _, ok := f.prs[pr.ID]; ok {
+		return model.ErrPRExists
+	}
+	res := *pr
+	res.Status = model.StatusOpen
+	f.prs[pr.ID] = &res
+	return nil
+}
+
+func (f *fakeStore) GetPR(_ context.Context, id string) (*model.PullRequest, error) {
+	pr, ok := f.prs[id]
+	if !ok {
+		return nil, model.ErrNotFound
+	}
+	res := *pr
+	res.AssignedReviewers = append([]string{}, pr.AssignedReviewers...)
+	return &res, nil
+}
+
+func (f *fakeStore) UpdatePRReviewers(_ context.Context, id string, reviewers []string) error {
+	f.prs[id].AssignedReviewers = reviewers
+	return nil
+}
+
+func (f *fakeStore) ListReassignments(_ context.Context, prID string) ([]model.Reassignment, error) {
+	return f.reassignments[prID], nil
+}
+
+func (f *fakeStore) CreateReassignment(_ context.Context, r *model.Reassignment) error {
+	f.reassignments[r.PullRequestID] = append(f.reassignments[r.PullRequestID], *r)
+	return nil
+}
+
+func (f *fakeStore) GetCandidates(_ context.Context, ids []string) ([]model.Candidate, error) {
+	res := make([]model.Candidate, len(ids))
+	for i, id := range ids {
+		u := f.users[id]
+		res[i] = model.Candidate{UserID: id, TeamName: u.TeamName, Seniority: u.Seniority, Tags: f.tags[id]}
+		for _, pr := range f.prs {
+			if pr.Status == model.StatusOpen && contains(pr.AssignedReviewers, id) {
+				res[i].OpenReviews++
+			}
+		}
+	}
+	return res, nil
+}
+
+// GetPairings treats every pull request as created now.
+func (f *fakeStore) GetPairings(_ context.Context, authorID string, ids []string, _ time.Time) (map[string][]time.Time, error) {
+	res := make(map[string][]time.Time)
+	for _, pr := range f.prs {
+		if pr.AuthorID != authorID {
+			continue
+		}
+		for _, r := range pr.AssignedReviewers {
+			if contains(ids, r) {
+				res[r] = append(res[r], time.Now())
+			}
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeStore) MergePR(_ context.Context, id string) error {
+	f.prs[id].Status = model.StatusMerged
+	return nil
+}
+
+func (f *fakeStore) ListOpenReviewsInTeam(_ context.Context, userID, teamName string) ([]string, error) {
+	var ids []string
+	for _, pr := range f.prs {
+		if pr.Status == model.StatusOpen && contains(pr.AssignedReviewers, userID) && pr.TeamName == teamName {
+			ids = append(ids, pr.ID)
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+func (f *fakeStore) RemoveTeamMember(_ context.Context, teamName, userID string) (bool, error) {
+	u, ok := f.users[userID]
+	if !ok || !contains(u.Teams, teamName) {
+		return false, nil
+	}
+	u.Teams = without(u.Teams, teamName)
+	u.TeamName = ""
+	if len(u.Teams) > 0 {
+		u.TeamName = u.Teams[0]
+	}
+	return true, nil
+}
+
+func (f *fakeStore) GetTeam(_ context.Context, name string) (*model.Team, error) {
+	t := &model.Team{Name: name, Parent: f.parents[name], LeadAssignment: f.leadAssignment[name]}
+	for _, u := range f.users {
+		if contains(u.Teams, name) {
+			m := *u
+			m.Role = f.roles[name][u.ID]
+			t.Members = append(t.Members, m)
+		}
+	}
+	return t, nil
+}
+
+func (f *fakeStore) ListTeamRoles(_ context.Context, teamName, userID string) ([]model.TeamRole, error) {
+	var roles []model.TeamRole
+	for team := teamName; team != ""; team = f.parents[team] {
+		if r, ok := f.roles[team][userID]; ok {
+			roles = append(roles, r)
+		}
+	}
+	return roles, nil
+}
+
+func (f *fakeStore) SetUserActive(_ context.Context, userID string, isActive bool) error {
+	f.users[userID].IsActive = isActive
+	return nil
+}
+
+func (f *fakeStore) ListSiblingTeams(_ context.Context, name string) ([]string, error) {
+	var names []string
+	for team, parent := range f.parents {
+		if team != name && parent != "" && parent == f.parents[name] {
+			names = append(names, team)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (f *fakeStore) ListStaleCandidates(context.Context, string) ([]model.StaleCandidate, error) {
+	return f.stale, nil
+}
+
+func (f *fakeStore) ClaimStaleReview(_ context.Context, prID, reviewerID string, _ time.Time) (bool, error) {
+	return !f.claimed[prID+"/"+reviewerID], nil
+}
+
+// WithinTx runs fn on the store itself; the fake does not roll back.
+func (f *fakeStore) WithinTx(_ context.Context, fn func(store.Repository) error) error {
+	return fn(f)
+}
+
+func (f *fakeStore) GetTeamWorkingHours(context.Context, string) (*model.WorkingHours, error) {
+	return nil, nil
+}
+
+func (f *fakeStore) AddReviewAssignments(context.Context, string, []string) error { return nil }
+func (f *fakeStore) DeleteReviewAssignment(context.Context, string, string) error { return nil }
+func (f *fakeStore) CreateEvent(context.Context, *model.Event) error              { return nil }
+func (f *fakeStore) SetSlackUserID(context.Context, string, string) error         { return nil }
+func (f *fakeStore) SetUserEmail(context.Context, string, string, bool) error     { return nil }
+
+func newTestService(seed int64) (*Service, *fakeStore) {
+	f := newFakeStore("backend", "u1", "u2", "u3", "u4", "u5", "u6")
+	return New(f, WithRandSource(rand.NewSource(seed))), f
+}
+
+func TestCreatePRPicksBySeed(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newTestService(1)
+	seed := int64(42)
+
+	pr, _, err := svc.CreatePR(ctx, model.NewPullRequest{ID: "pr-1", Name: "one", AuthorID: "u1", Seed: &seed})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"u4", "u5"}; !reflect.DeepEqual(pr.AssignedReviewers, want) {
+		t.Errorf("reviewers = %v, want %v", pr.AssignedReviewers, want)
+	}
+	if pr.AssignmentSeed == nil || *pr.AssignmentSeed != seed {
+		t.Errorf("stored seed = %v, want %d", pr.AssignmentSeed, seed)
+	}
+
+	// The same seed repeats the choice, whatever the service's own source.
+	other, _ := newTestService(7)
+	again, _, err := other.CreatePR(ctx, model.NewPullRequest{ID: "pr-1", Name: "one", AuthorID: "u1", Seed: &seed})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(again.AssignedReviewers, pr.AssignedReviewers) {
+		t.Errorf("replay picked %v, want %v", again.AssignedReviewers, pr.AssignedReviewers)
+	}
+	if len(f.prs) != 1 {
+		t.Errorf("store has %d pull requests", len(f.prs))
+	}
+}
+
+func TestCreatePRSeedsFromSource(t *testing.T) {
+	ctx := context.Background()
+	a, _ := newTestService(1)
+	b, _ := newTestService(1)
+
+	for _, id := range []string{"pr-1", "pr-2", "pr-3"} {
+		x, _, err := a.CreatePR(ctx, model.NewPullRequest{ID: id, Name: id, AuthorID: "u1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		y, _, err := b.CreatePR(ctx, model.NewPullRequest{ID: id, Name: id, AuthorID: "u1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *x.AssignmentSeed != *y.AssignmentSeed || !reflect.DeepEqual(x.AssignedReviewers, y.AssignedReviewers) {
+			t.Errorf("%s: %d %v vs %d %v", id, *x.AssignmentSeed, x.AssignedReviewers, *y.AssignmentSeed, y.AssignedReviewers)
+		}
+	}
+}
+
+func TestCreatePRExplainsPoolBeforeAssignment(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(1)
+	seed := int64(42)
+
+	pr, e, err := svc.CreatePR(ctx, model.NewPullRequest{ID: "pr-1", Name: "one", AuthorID: "u1", Seed: &seed, Explain: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var selected []string
+	for _, c := range e.Candidates {
+		if c.Selected {
+			selected = append(selected, c.UserID)
+			if c.OpenReviews != 0 {
+				t.Errorf("%s has %d open reviews, want the count before assignment", c.UserID, c.OpenReviews)
+			}
+		}
+	}
+	sort.Strings(selected)
+	if !reflect.DeepEqual(selected, pr.AssignedReviewers) {
+		t.Errorf("selected = %v, want %v", selected, pr.AssignedReviewers)
+	}
+}
+
+func TestEvaluateRulesReplaysAssignment(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(3)
+
+	pr, _, err := svc.CreatePR(ctx, model.NewPullRequest{ID: "pr-1", Name: "one", AuthorID: "u1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	eval, err := svc.EvaluateRules(ctx, model.NewPullRequest{AuthorID: "u1", Seed: pr.AssignmentSeed, CreatedAt: pr.CreatedAt}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if eval.Seed != *pr.AssignmentSeed || !reflect.DeepEqual(eval.Reviewers, pr.AssignedReviewers) {
+		t.Errorf("evaluation = %d %v, want %d %v", eval.Seed, eval.Reviewers, *pr.AssignmentSeed, pr.AssignedReviewers)
+	}
+}
+
+func TestEvaluateRulesReplaysWorkingHours(t *testing.T) {
+	ctx := context.Background()
+	// Only u5 works, on Mondays from 9 to 18.
+	never := businesstime.Schedule{Location: time.UTC}
+	f := newFakeStore("backend", "u1", "u2", "u3", "u4", "u5")
+	f.users["u5"].WorkingHours = &model.WorkingHours{Timezone: "UTC", Start: "09:00", End: "18:00", Days: []string{"mon"}}
+	svc := New(f, WithRandSource(rand.NewSource(1)), WithBusinessTime(businesstime.NewCalculator(nil), never), WithWorkingHoursPreference())
+	monday := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+
+	pr, _, err := svc.CreatePR(ctx, model.NewPullRequest{ID: "pr-1", Name: "one", AuthorID: "u1", CreatedAt: monday})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pr.CreatedAt.Equal(monday) || pr.AssignedReviewers[0] != "u5" {
+		t.Fatalf("created at %v with %v, want u5 first", pr.CreatedAt, pr.AssignedReviewers)
+	}
+	for _, at := range []time.Time{monday, monday.Add(-24 * time.Hour)} {
+		eval, err := svc.EvaluateRules(ctx, model.NewPullRequest{AuthorID: "u1", Seed: pr.AssignmentSeed, CreatedAt: at}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if replayed := reflect.DeepEqual(eval.Reviewers, pr.AssignedReviewers); replayed != at.Equal(monday) {
+			t.Errorf("at %v: reviewers = %v, created with %v", at, eval.Reviewers, pr.AssignedReviewers)
+		}
+	}
+}
+
+func TestReassignFollowsPRSeed(t *testing.T) {
+	ctx := context.Background()
+	seed := int64(42)
+	var picks [][]string
+	for _, source := range []int64{1, 2} {
+		svc, _ := newTestService(source)
+		if _, _, err := svc.CreatePR(ctx, model.NewPullRequest{ID: "pr-1", Name: "one", AuthorID: "u1", Seed: &seed}); err != nil {
+			t.Fatal(err)
+		}
+		first, _, err := svc.ReassignReviewer(ctx, "", "pr-1", "u4")
+		if err != nil {
+			t.Fatal(err)
+		}
+		second, _, err := svc.ReassignReviewer(ctx, "", "pr-1", "u5")
+		if err != nil {
+			t.Fatal(err)
+		}
+		picks = append(picks, []string{first, second})
+	}
+	if want := []string{"u3", "u1"}; !reflect.DeepEqual(picks[0], want) {
+		t.Errorf("picked %v, want %v", picks[0], want)
+	}
+	if !reflect.DeepEqual(picks[0], picks[1]) {
+		t.Errorf("services picked %v and %v", picks[0], picks[1])
+	}
+}
+
+func TestKnowledgeSpreadRotatesReviewers(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeStore("backend", "u1", "u2", "u3", "u4")
+	svc := New(f, WithStrategy(model.StrategyKnowledgeSpread), WithRandSource(rand.NewSource(1)))
+
+	// Merging keeps load out of it: only the pairings decide.
+	var picks []string
+	for _, id := range []string{"pr-1", "pr-2", "pr-3", "pr-4"} {
+		seed := int64(5)
+		pr, _, err := svc.CreatePR(ctx, model.NewPullRequest{ID: id, Name: id, AuthorID: "u1", Seed: &seed})
+		if err != nil {
+			t.Fatal(err)
+		}
+		picks = append(picks, pr.AssignedReviewers...)
+		if _, err := svc.MergePR(ctx, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := []string{"u2", "u3", "u4", "u2", "u3", "u4", "u2", "u3"}
+	if !reflect.DeepEqual(picks, want) {
+		t.Errorf("picked %v, want %v", picks, want)
+	}
+}
+
+func TestSkillStrategyPrefersMatchesThenWorkingHours(t *testing.T) {
+	ctx := context.Background()
+	// u2 and u5 know the database; u2 is busy with two reviews, but one
+	// matching tag outweighs them.
+	setup := func(opts ...Option) (*Service, *fakeStore) {
+		f := newFakeStore("backend", "u1", "u2", "u3", "u4", "u5")
+		f.tags["u2"] = []string{"db"}
+		f.tags["u5"] = []string{"db"}
+		for _, id := range []string{"busy-1", "busy-2"} {
+			f.prs[id] = &model.PullRequest{ID: id, AuthorID: "u4", Status: model.StatusOpen, AssignedReviewers: []string{"u2"}}
+		}
+		opts = append([]Option{WithStrategy(model.StrategySkill), WithRandSource(rand.NewSource(1))}, opts...)
+		return New(f, opts...), f
+	}
+	create := func(svc *Service) []string {
+		pr, _, err := svc.CreatePR(ctx, model.NewPullRequest{ID: "pr-1", Name: "db", AuthorID: "u1", Labels: []string{"db"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return pr.AssignedReviewers
+	}
+
+	svc, _ := setup()
+	if got, want := create(svc), []string{"u5", "u2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("reviewers = %v, want %v", got, want)
+	}
+
+	// Nobody works by default; u2 and u3 work around the clock. Those working
+	// now go first, in the order the skill ranking gave them.
+	never := businesstime.Schedule{Location: time.UTC}
+	svc, f := setup(WithBusinessTime(businesstime.NewCalculator(nil), never), WithWorkingHoursPreference())
+	always := &model.WorkingHours{Timezone: "UTC", Start: "00:00", End: "24:00", Days: []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}}
+	f.users["u2"].WorkingHours = always
+	f.users["u3"].WorkingHours = always
+	if got, want := create(svc), []string{"u2", "u3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("reviewers in working hours = %v, want %v", got, want)
+	}
+}
+
+func TestRemoveTeamMemberOpenReviews(t *testing.T) {
+	ctx := context.Background()
+	seed := int64(42)
+	for _, tt := range []struct {
+		policy model.OpenReviewPolicy
+		want   []string
+		err    error
+	}{
+		{model.OpenReviewsReject, []string{"u4", "u5"}, model.ErrOpenReviews},
+		{model.OpenReviewsReassign, []string{"u3", "u5"}, nil},
+		{model.OpenReviewsUnassign, []string{"u5"}, nil},
+	} {
+		svc, f := newTestService(1)
+		if _, _, err := svc.CreatePR(ctx, model.NewPullRequest{ID: "pr-1", Name: "one", AuthorID: "u1", Seed: &seed}); err != nil {
+			t.Fatal(err)
+		}
+		_, err := svc.RemoveTeamMember(ctx, "", "backend", "u4", tt.policy)
+		if !errors.Is(err, tt.err) {
+			t.Fatalf("%s: err = %v, want %v", tt.policy, err, tt.err)
+		}
+		if got := f.prs["pr-1"].AssignedReviewers; !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: reviewers = %v, want %v", tt.policy, got, tt.want)
+		}
+		if left := !contains(f.users["u4"].Teams, "backend"); left != (tt.err == nil) {
+			t.Errorf("%s: u4 teams = %v", tt.policy, f.users["u4"].Teams)
+		}
+		if tt.policy == model.OpenReviewsReassign && f.reassignments["pr-1"][0].Reason != model.ReassignTeamChange {
+			t.Errorf("reassignment = %+v", f.reassignments["pr-1"])
+		}
+	}
+}
+
+func TestCreatePRDrawsFromItsTeam(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newTestService(1)
+	f.users["u1"].Teams = []string{"backend", "mobile"}
+	for _, id := range []string{"m1", "m2"} {
+		f.users[id] = &model.User{ID: id, Username: id, TeamName: "mobile", Teams: []string{"mobile"}, IsActive: true, Seniority: model.SeniorityMiddle}
+	}
+
+	pr, _, err := svc.CreatePR(ctx, model.NewPullRequest{ID: "pr-1", Name: "one", AuthorID: "u1", TeamName: "mobile"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := pr.AssignedReviewers; pr.TeamName != "mobile" || len(r) != 2 || !contains(r, "m1") || !contains(r, "m2") {
+		t.Errorf("team = %q, reviewers = %v, want m1 and m2 from mobile", pr.TeamName, pr.AssignedReviewers)
+	}
+
+	pr, _, err = svc.CreatePR(ctx, model.NewPullRequest{ID: "pr-2", Name: "two", AuthorID: "u1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pr.TeamName != "backend" || contains(pr.AssignedReviewers, "m1") || contains(pr.AssignedReviewers, "m2") {
+		t.Errorf("team = %q, reviewers = %v, want the primary team", pr.TeamName, pr.AssignedReviewers)
+	}
+}
+
+func TestSmallTeamBorrowsFromSiblings(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeStore("payments", "p1", "p2")
+	f.users["s1"] = &model.User{ID: "s1", Username: "s1", TeamName: "search", Teams: []string{"search"}, IsActive: true, Seniority: model.SeniorityMiddle}
+	f.parents["payments"], f.parents["search"] = "backend", "backend"
+	svc := New(f, WithRandSource(rand.NewSource(1)))
+
+	pr, _, err := svc.CreatePR(ctx, model.NewPullRequest{ID: "pr-1", Name: "one", AuthorID: "p1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := pr.AssignedReviewers; len(r) != 2 || !contains(r, "p2") || !contains(r, "s1") {
+		t.Fatalf("reviewers = %v, want p2 and s1 from the sibling team", r)
+	}
+	f.users["s2"] = &model.User{ID: "s2", Username: "s2", TeamName: "search", Teams: []string{"search"}, IsActive: true, Seniority: model.SeniorityMiddle}
+	// With the author away too, nobody in payments can replace p2.
+	f.users["p1"].IsActive = false
+	if newID, _, err := svc.ReassignReviewer(ctx, "", "pr-1", "p2"); err != nil || newID != "s2" {
+		t.Errorf("replacement = %q, %v, want s2", newID, err)
+	}
+}
+
+func TestLeadAssignment(t *testing.T) {
+	ctx := context.Background()
+	for _, tt := range []struct {
+		assignment model.LeadAssignment
+		// want are the reviewers out of u2, the lead, u3 and u4.
+		want []string
+	}{
+		{model.LeadsNormal, []string{"u2", "u3"}},
+		{model.LeadsLastResort, []string{"u3", "u4"}},
+		{model.LeadsExcluded, []string{"u3", "u4"}},
+	} {
+		svc, f := newTestService(1)
+		f.roles["backend"] = map[string]model.TeamRole{"u2": model.RoleLead}
+		f.leadAssignment["backend"] = tt.assignment
+		f.users["u5"].IsActive, f.users["u6"].IsActive = false, false
+
+		pr, _, err := svc.CreatePR(ctx, model.NewPullRequest{ID: "pr-1", Name: "one", AuthorID: "u1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		sort.Strings(pr.AssignedReviewers)
+		if !reflect.DeepEqual(pr.AssignedReviewers, tt.want) {
+			t.Errorf("%s: reviewers = %v, want %v", tt.assignment, pr.AssignedReviewers, tt.want)
+		}
+
+		// With only u3 left, a last resort lead steps in; an excluded one does not.
+		f.users["u4"].IsActive = false
+		pr, _, err = svc.CreatePR(ctx, model.NewPullRequest{ID: "pr-2", Name: "two", AuthorID: "u1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := contains(pr.AssignedReviewers, "u2"); got == (tt.assignment == model.LeadsExcluded) {
+			t.Errorf("%s: reviewers = %v", tt.assignment, pr.AssignedReviewers)
+		}
+	}
+}
+
+func TestRolesAuthorizeActors(t *testing.T) {
+	ctx := context.Background()
+	seed := int64(42)
+	svc, f := newTestService(1)
+	f.roles["backend"] = map[string]model.TeamRole{"u2": model.RoleMaintainer, "u3": model.RoleLead}
+	if _, _, err := svc.CreatePR(ctx, model.NewPullRequest{ID: "pr-1", Name: "one", AuthorID: "u1", Seed: &seed}); err != nil {
+		t.Fatal(err)
+	}
+
+	// pr-1 is reviewed by u4 and u5.
+	if _, _, err := svc.ReassignReviewer(ctx, "u6", "pr-1", "u4"); !errors.Is(err, model.ErrForbidden) {
+		t.Errorf("member reassigning: err = %v, want %v", err, model.ErrForbidden)
+	}
+	for _, actor := range []string{"u4", "u2"} {
+		if _, _, err := svc.ReassignReviewer(ctx, actor, "pr-1", f.prs["pr-1"].AssignedReviewers[0]); err != nil {
+			t.Errorf("%s reassigning: %v", actor, err)
+		}
+	}
+
+	if err := svc.SetActive(ctx, "u2", "u6", false); !errors.Is(err, model.ErrForbidden) {
+		t.Errorf("maintainer deactivating: err = %v, want %v", err, model.ErrForbidden)
+	}
+	if err := svc.SetActive(ctx, "u3", "u6", false); err != nil || f.users["u6"].IsActive {
+		t.Errorf("lead deactivating: err = %v, active = %v", err, f.users["u6"].IsActive)
+	}
+	if err := svc.SetActive(ctx, "u6", "u6", true); err != nil {
+		t.Errorf("deactivating oneself: %v", err)
+	}
+
+	// Calls without an actor are not checked.
+	if err := svc.SetActive(ctx, "", "u6", false); err != nil || f.users["u6"].IsActive {
+		t.Errorf("anonymous deactivating: err = %v, active = %v", err, f.users["u6"].IsActive)
+	}
+	if _, _, err := svc.ReassignReviewer(ctx, "", "pr-1", f.prs["pr-1"].AssignedReviewers[0]); err != nil {
+		t.Errorf("anonymous reassigning: %v", err)
+	}
+
+	// Contacts are set by the users themselves or their leads.
+	if _, err := svc.SetSlackUserID(ctx, "u6", "u5", "U5"); !errors.Is(err, model.ErrForbidden) {
+		t.Errorf("member linking another to Slack: err = %v, want %v", err, model.ErrForbidden)
+	}
+	for _, actor := range []string{"u5", "u3"} {
+		if _, err := svc.SetSlackUserID(ctx, actor, "u5", "U5"); err != nil {
+			t.Errorf("%s linking u5 to Slack: %v", actor, err)
+		}
+	}
+	if _, err := svc.SetEmail(ctx, "u6", "u5", "u5@example.com", false); !errors.Is(err, model.ErrForbidden) {
+		t.Errorf("member setting another's email: err = %v, want %v", err, model.ErrForbidden)
+	}
+	for _, actor := range []string{"u5", "u3"} {
+		if _, err := svc.SetEmail(ctx, actor, "u5", "u5@example.com", false); err != nil {
+			t.Errorf("%s setting u5's email: %v", actor, err)
+		}
+	}
+
+	// Leads run team-wide operations; members may remove only themselves.
+	if _, err := svc.RenameTeam(ctx, "u2", "backend", "platform"); !errors.Is(err, model.ErrForbidden) {
+		t.Errorf("maintainer renaming: err = %v, want %v", err, model.ErrForbidden)
+	}
+	if _, err := svc.DeleteTeam(ctx, "u6", "backend", model.OpenReviewsUnassign); !errors.Is(err, model.ErrForbidden) {
+		t.Errorf("member deleting: err = %v, want %v", err, model.ErrForbidden)
+	}
+	if _, err := svc.RemoveTeamMember(ctx, "u6", "backend", "u5", model.OpenReviewsReject); !errors.Is(err, model.ErrForbidden) {
+		t.Errorf("member removing another: err = %v, want %v", err, model.ErrForbidden)
+	}
+	if _, err := svc.ReassignStale(ctx, "u6", "backend", time.Now(), true); !errors.Is(err, model.ErrForbidden) {
+		t.Errorf("member reassigning stale reviews: err = %v, want %v", err, model.ErrForbidden)
+	}
+
+	// Roles are given by leads when adding members too.
+	lead := model.User{ID: "u7", Username: "u7", Role: model.RoleLead}
+	if _, err := svc.AddTeamMember(ctx, "u2", "backend", lead); !errors.Is(err, model.ErrForbidden) {
+		t.Errorf("maintainer adding a lead: err = %v, want %v", err, model.ErrForbidden)
+	}
+	if err := svc.CreateTeam(ctx, "u3", model.Team{Name: "mobile", Members: []model.User{lead}}); !errors.Is(err, model.ErrForbidden) {
+		t.Errorf("creating a team with a lead: err = %v, want %v", err, model.ErrForbidden)
+	}
+
+	// A department's lead leads its sub-teams too.
+	f.parents["backend"] = "engineering"
+	f.roles["engineering"] = map[string]model.TeamRole{"d1": model.RoleLead}
+	if err := svc.authorize(ctx, "d1", "backend", model.RoleLead); err != nil {
+		t.Errorf("department lead: %v", err)
+	}
+}
+
+func TestImportOrgDryRun(t *testing.T) {
+	ctx := context.Background()
+	svc, f := newTestService(1)
+	file := `teams:
+  - team_name: backend
+    parent_team_name: engineering
+    members:
+      - user_id: u1
+        username: Alice
+      - user_id: u2
+        username: u2
+        is_active: false
+      - user_id: u7
+        username: Gus
+        role: lead
+`
+	// Only a lead of backend, or a trusted caller, may make u7 its lead.
+	f.roles["backend"] = map[string]model.TeamRole{"u3": model.RoleLead}
+	for _, actor := range []string{"", "u2"} {
+		if _, err := svc.ImportOrg(ctx, actor, orgfile.YAML, []byte(file), true); !errors.Is(err, model.ErrForbidden) {
+			t.Errorf("actor %q: err = %v, want %v", actor, err, model.ErrForbidden)
+		}
+	}
+
+	// A dry run must not open a transaction, which the fake store lacks.
+	res, err := svc.ImportOrg(ctx, "u3", orgfile.YAML, []byte(file), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []model.OrgChange{
+		{Action: model.OrgSetParent, TeamName: "backend", To: "engineering"},
+		{Action: model.OrgRenameUser, UserID: "u1", From: "u1", To: "Alice"},
+		{Action: model.OrgSetActive, UserID: "u2", From: "true", To: "false"},
+		{Action: model.OrgCreateUser, UserID: "u7", To: "Gus"},
+		{Action: model.OrgAddMember, TeamName: "backend", UserID: "u7"},
+		{Action: model.OrgSetRole, TeamName: "backend", UserID: "u7", From: "member", To: "lead"},
+	}
+	if !res.DryRun || !reflect.DeepEqual(res.Changes, want) {
+		t.Errorf("changes = %+v, want %+v", res.Changes, want)
+	}
+	if f.users["u1"].Username != "u1" || f.users["u7"] != nil {
+		t.Errorf("dry run changed users")
+	}
+
+	f.parents["engineering"] = "backend"
+	_, err = svc.ImportOrg(Trusted(ctx), "", orgfile.YAML, []byte(file), true)
+	var errs orgfile.Errors
+	if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Line != 2 || !strings.Contains(errs[0].Message, "engineering is under backend") {
+		t.Errorf("cycle: err = %v", err)
+	}
+}
+
+func TestReassignStale(t *testing.T) {
+	ctx := context.Background()
+	// A Monday afternoon: reviews assigned at 10:00 are five working hours old.
+	now := time.Date(2026, 10, 19, 15, 0, 0, 0, time.UTC)
+	policy := model.StalePolicy{TeamName: "backend", StaleAfterHours: 2, MaxReassignments: 1}
+	setup := func(p model.StalePolicy) (*Service, *fakeStore) {
+		svc, f := newTestService(1)
+		for _, id := range []string{"pr-1", "pr-2"} {
+			if _, _, err := svc.CreatePR(ctx, model.NewPullRequest{ID: id, Name: id, AuthorID: "u1"}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		one, two := f.prs["pr-1"].AssignedReviewers, f.prs["pr-2"].AssignedReviewers
+		assigned := now.Add(-5 * time.Hour)
+		f.stale = []model.StaleCandidate{
+			{PullRequestID: "pr-1", ReviewerID: one[0], AssignedAt: assigned, Policy: p},
+			// Only one reassignment per pull request, including this run's.
+			{PullRequestID: "pr-1", ReviewerID: one[1], AssignedAt: assigned, Policy: p},
+			{PullRequestID: "pr-2", ReviewerID: two[0], AssignedAt: assigned, Policy: p, Reassignments: 1},
+			// Not stale yet.
+			{PullRequestID: "pr-2", ReviewerID: two[1], AssignedAt: now.Add(-time.Hour), Policy: p},
+		}
+		return svc, f
+	}
+	outcomes := func(actions []model.StaleAction) []model.StaleOutcome {
+		res := make([]model.StaleOutcome, len(actions))
+		for i, a := range actions {
+			res[i] = a.Outcome
+		}
+		return res
+	}
+
+	svc, f := setup(policy)
+	before := append([]string(nil), f.prs["pr-1"].AssignedReviewers...)
+	actions, err := svc.ReassignStale(ctx, "", "", now, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []model.StaleOutcome{model.StaleReassigned, model.StaleCapReached, model.StaleCapReached}
+	if got := outcomes(actions); !reflect.DeepEqual(got, want) {
+		t.Fatalf("outcomes = %v, want %v", got, want)
+	}
+	if contains(f.prs["pr-1"].AssignedReviewers, before[0]) || !contains(f.prs["pr-1"].AssignedReviewers, actions[0].ReplacedBy) {
+		t.Errorf("pr-1 reviewers = %v, replaced %s by %s", f.prs["pr-1"].AssignedReviewers, before[0], actions[0].ReplacedBy)
+	}
+
+	// A dry run policy, or a dry run call, only reports.
+	for _, tt := range []struct {
+		policy model.StalePolicy
+		dryRun bool
+	}{{model.StalePolicy{TeamName: "backend", StaleAfterHours: 2, MaxReassignments: 1, DryRun: true}, false}, {policy, true}} {
+		svc, f := setup(tt.policy)
+		before := append([]string(nil), f.prs["pr-1"].AssignedReviewers...)
+		actions, err := svc.ReassignStale(ctx, "", "", now, tt.dryRun)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []model.StaleOutcome{model.StaleWouldReassign, model.StaleCapReached, model.StaleCapReached}
+		if got := outcomes(actions); !reflect.DeepEqual(got, want) {
+			t.Errorf("dry run %v: outcomes = %v, want %v", tt.dryRun, got, want)
+		}
+		if !reflect.DeepEqual(f.prs["pr-1"].AssignedReviewers, before) || len(f.reassignments["pr-1"]) != 0 {
+			t.Errorf("dry run %v changed pr-1: %v", tt.dryRun, f.prs["pr-1"].AssignedReviewers)
+		}
+	}
+
+	// A review claimed by another replica is left to it.
+	svc, f = setup(policy)
+	before = append([]string(nil), f.prs["pr-1"].AssignedReviewers...)
+	f.claimed = map[string]bool{"pr-1/" + before[0]: true}
+	actions, err = svc.ReassignStale(ctx, "", "", now, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []model.StaleOutcome{model.StaleReassigned, model.StaleCapReached}
+	if got := outcomes(actions); !reflect.DeepEqual(got, want) || actions[0].ReviewerID != before[1] {
+		t.Errorf("outcomes = %v for %v, want %v for %s", got, actions, want, before[1])
+	}
+}
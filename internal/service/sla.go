@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"avito-pr-reviewer/internal/businesstime"
+	"avito-pr-reviewer/internal/model"
+	"avito-pr-reviewer/internal/notifier"
+)
+
+func (s *Service) SetTeamSLA(ctx context.Context, sla model.TeamSLA) error {
+	if _, err := s.store.GetTeam(ctx, sla.TeamName); err != nil {
+		return err
+	}
+	return s.store.SetTeamSLA(ctx, sla)
+}
+
+func (s *Service) GetTeamSLA(ctx context.Context, teamName string) (*model.TeamSLA, error) {
+	return s.store.GetTeamSLA(ctx, teamName)
+}
+
+// RespondToReview records the reviewer's first response, which stops the SLA clock.
+func (s *Service) RespondToReview(ctx context.Context, prID, reviewerID string) (*model.PullRequest, error) {
+	pr, err := s.store.GetPR(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+	if pr.Status == model.StatusMerged {
+		return nil, model.ErrPRMerged
+	}
+	if !contains(pr.AssignedReviewers, reviewerID) {
+		return nil, model.ErrNotAssigned
+	}
+	if _, err := s.store.RespondToReview(ctx, prID, reviewerID); err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+// dueAt is when a reviewer assigned at assignedAt must have responded. Only
+// the reviewer's working hours count towards the SLA.
+func (s *Service) dueAt(assignedAt time.Time, sla model.TeamSLA, sch businesstime.Schedule) time.Time {
+	return s.calc.Add(assignedAt, time.Duration(sla.FirstResponseHours)*time.Hour, sch)
+}
+
+// ListSLABreaches returns assignments that are past their response deadline at
+// now. A teamName covers its sub-teams too; an empty one lists breaches of
+// all teams.
+func (s *Service) ListSLABreaches(ctx context.Context, teamName string, now time.Time) ([]model.SLABreach, error) {
+	pending, err := s.store.ListPendingReviews(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	breaches := make([]model.SLABreach, 0)
+	sch := s.schedules()
+	reviewers := make([]string, 0, len(pending))
+	seen := make(map[string]bool, len(pending))
+	for _, p := range pending {
+		if !seen[p.ReviewerID] {
+			seen[p.ReviewerID] = true
+			reviewers = append(reviewers, p.ReviewerID)
+		}
+	}
+	sch.preload(ctx, reviewers)
+	for _, p := range pending {
+		due := s.dueAt(p.AssignedAt, p.SLA, sch.forUser(ctx, p.ReviewerID))
+		if now.After(due) {
+			breaches = append(breaches, model.SLABreach{PendingReview: p, DueAt: due})
+		}
+	}
+	return breaches, nil
+}
+
+// EscalateSLABreaches emits an escalation event for every new breach, tells
+// the team's leads and, if the team asked for it, hands the review to another
+// reviewer.
+func (s *Service) EscalateSLABreaches(ctx context.Context, now time.Time) error {
+	breaches, err := s.ListSLABreaches(ctx, "", now)
+	if err != nil {
+		return err
+	}
+	for _, b := range breaches {
+		if b.EscalatedAt != nil {
+			continue
+		}
+		// Claiming the escalation keeps replicas from escalating twice.
+		claimed, err := s.store.EscalateReview(ctx, b.PullRequestID, b.ReviewerID)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			continue
+		}
+
+		pr, err := s.store.GetPR(ctx, b.PullRequestID)
+		if err != nil {
+			return err
+		}
+		due := b.DueAt
+		leads, err := s.escalationLeads(ctx, pr.TeamName)
+		if err != nil {
+			return err
+		}
+		leads = without(leads, b.ReviewerID)
+		s.emit(ctx, model.EventSLABreached, b.SLA.TeamName, append([]string{b.ReviewerID, pr.AuthorID}, leads...), model.EventPayload{
+			PR:         pr,
+			ReviewerID: b.ReviewerID,
+			DueAt:      &due,
+		})
+		for _, l := range leads {
+			s.notify(ctx, notifier.Notification{
+				Type:       model.EventSLABreached,
+				UserID:     l,
+				PR:         pr,
+				ReviewerID: b.ReviewerID,
+				DueAt:      &due,
+			})
+		}
+
+		if !b.SLA.AutoReassign {
+			continue
+		}
+		newID, _, err := s.reassign(ctx, b.PullRequestID, b.ReviewerID, model.ReassignSLA, nil)
+		switch {
+		case errors.Is(err, model.ErrNoCandidate):
+			log.Printf("sla: no replacement for %s on %s", b.ReviewerID, b.PullRequestID)
+		case err != nil:
+			log.Printf("sla: reassign %s on %s: %v", b.ReviewerID, b.PullRequestID, err)
+		default:
+			log.Printf("sla: reassigned %s on %s to %s", b.ReviewerID, b.PullRequestID, newID)
+		}
+	}
+	return nil
+}
+
+// MonitorSLA checks for SLA breaches every interval until ctx is done.
+func (s *Service) MonitorSLA(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.EscalateSLABreaches(ctx, time.Now()); err != nil {
+				log.Printf("sla: %v", err)
+			}
+		}
+	}
+}
+
+func contains(list []string, v string) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
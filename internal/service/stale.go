@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"avito-pr-reviewer/internal/businesstime"
+	"avito-pr-reviewer/internal/model"
+	"avito-pr-reviewer/internal/store"
+)
+
+func (s *Service) SetStalePolicy(ctx context.Context, p model.StalePolicy) error {
+	if _, err := s.store.GetTeam(ctx, p.TeamName); err != nil {
+		return err
+	}
+	return s.store.SetStalePolicy(ctx, p)
+}
+
+func (s *Service) GetStalePolicy(ctx context.Context, teamName string) (*model.StalePolicy, error) {
+	return s.store.GetStalePolicy(ctx, teamName)
+}
+
+func (s *Service) ListReassignments(ctx context.Context, prID string) ([]model.Reassignment, error) {
+	if _, err := s.store.GetPR(ctx, prID); err != nil {
+		return nil, err
+	}
+	return s.store.ListReassignments(ctx, prID)
+}
+
+// staleAt is when a reviewer assigned at assignedAt counts as stale, counting
+// only the reviewer's working hours.
+func (s *Service) staleAt(assignedAt time.Time, p model.StalePolicy, sch businesstime.Schedule) time.Time {
+	return s.calc.Add(assignedAt, time.Duration(p.StaleAfterHours)*time.Hour, sch)
+}
+
+// ReassignStale applies the stale policies of teamName and its sub-teams, or
+// of every team if it is empty, and reports what was done. With dryRun, or for teams whose policy
+// is a dry run, nothing is changed. An actor must lead teamName.
+func (s *Service) ReassignStale(ctx context.Context, actor, teamName string, now time.Time, dryRun bool) ([]model.StaleAction, error) {
+	if actor != "" {
+		if err := s.authorize(ctx, actor, teamName, model.RoleLead); err != nil {
+			return nil, err
+		}
+	}
+	candidates, err := s.store.ListStaleCandidates(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	actions := make([]model.StaleAction, 0)
+	// Reassignments made during this run count towards the per-PR cap too.
+	done := make(map[string]int)
+	sch := s.schedules()
+	for _, c := range candidates {
+		if now.Before(s.staleAt(c.AssignedAt, c.Policy, sch.forUser(ctx, c.ReviewerID))) {
+			continue
+		}
+		action := model.StaleAction{
+			PullRequestID: c.PullRequestID,
+			TeamName:      c.Policy.TeamName,
+			ReviewerID:    c.ReviewerID,
+		}
+
+		switch {
+		case c.Reassignments+done[c.PullRequestID] >= c.Policy.MaxReassignments:
+			action.Outcome = model.StaleCapReached
+		case dryRun || c.Policy.DryRun:
+			action.Outcome = model.StaleWouldReassign
+			done[c.PullRequestID]++
+		default:
+			// Claiming the review keeps replicas from reassigning it twice.
+			claim := func(r store.Repository) (bool, error) {
+				return r.ClaimStaleReview(ctx, c.PullRequestID, c.ReviewerID, c.AssignedAt)
+			}
+			newID, _, err := s.reassign(ctx, c.PullRequestID, c.ReviewerID, model.ReassignStale, claim)
+			switch {
+			case errors.Is(err, model.ErrNoCandidate), errors.Is(err, model.ErrComposition):
+				action.Outcome = model.StaleNoCandidate
+			case errors.Is(err, model.ErrNotAssigned), errors.Is(err, model.ErrPRMerged):
+				// Changed since the candidates were listed, or claimed by another replica.
+				continue
+			case err != nil:
+				return actions, err
+			default:
+				action.Outcome = model.StaleReassigned
+				action.ReplacedBy = newID
+				done[c.PullRequestID]++
+			}
+		}
+		actions = append(actions, action)
+	}
+	return actions, nil
+}
+
+// MonitorStale applies stale policies every interval until ctx is done.
+func (s *Service) MonitorStale(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			actions, err := s.ReassignStale(ctx, "", "", time.Now(), false)
+			if err != nil {
+				log.Printf("stale: %v", err)
+			}
+			for _, a := range actions {
+				if a.Outcome != model.StaleCapReached {
+					log.Printf("stale: %s: %s on %s (team %s)", a.Outcome, a.ReviewerID, a.PullRequestID, a.TeamName)
+				}
+			}
+		}
+	}
+}
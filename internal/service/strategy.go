@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"avito-pr-reviewer/internal/model"
+	"avito-pr-reviewer/internal/ranking"
+	"avito-pr-reviewer/internal/util"
+)
+
+// WithStrategy sets how reviewers are chosen among the eligible candidates
+// unless a repository overrides it. The default is model.StrategyRandom.
+func WithStrategy(strategy model.AssignmentStrategy) Option {
+	return func(s *Service) {
+		s.strategy = strategy
+	}
+}
+
+// WithRandSource sets where the seeds of pull requests' random choices come
+// from. The default is seeded with the current time.
+func WithRandSource(src rand.Source) Option {
+	return func(s *Service) {
+		s.rand = rand.New(src)
+	}
+}
+
+// seedFor returns seed if set, or else a new one.
+func (s *Service) seedFor(seed *int64) int64 {
+	if seed != nil {
+		return *seed
+	}
+	s.randMu.Lock()
+	defer s.randMu.Unlock()
+	return s.rand.Int63()
+}
+
+// reassignSeed derives the seed of pr's next reassignment from its assignment
+// seed, so that reassignments can be repeated too.
+func (s *Service) reassignSeed(ctx context.Context, pr *model.PullRequest) (int64, error) {
+	if pr.AssignmentSeed == nil {
+		return s.seedFor(nil), nil
+	}
+	done, err := s.store.ListReassignments(ctx, pr.ID)
+	if err != nil {
+		return 0, err
+	}
+	return *pr.AssignmentSeed + int64(len(done)) + 1, nil
+}
+
+// WithPairingWindow sets how long a review of the same author counts against
+// a candidate under the knowledge spread strategy. The default is 30 days.
+func WithPairingWindow(d time.Duration) Option {
+	return func(s *Service) {
+		s.pairingWindow = d
+	}
+}
+
+// rank orders candidates for a pull request by authorID with the given
+// labels as of at, best first. Ties are broken by rng.
+func (s *Service) rank(ctx context.Context, rng *rand.Rand, at time.Time, strategy model.AssignmentStrategy, authorID string, labels []string, candidates []string) error {
+	if len(candidates) < 2 {
+		return nil
+	}
+	util.Shuffle(rng, candidates)
+	if strategy != model.StrategyRandom {
+		if err := s.rankByScore(ctx, at, strategy, authorID, labels, candidates); err != nil {
+			return err
+		}
+	}
+	s.preferWorkingNow(ctx, candidates, at)
+	return nil
+}
+
+// rankByScore sorts candidates by their score under strategy. Equal scores
+// keep their random order.
+func (s *Service) rankByScore(ctx context.Context, at time.Time, strategy model.AssignmentStrategy, authorID string, labels []string, candidates []string) error {
+	info, err := s.scoredCandidates(ctx, at, strategy, authorID, candidates)
+	if err != nil {
+		return err
+	}
+	ranking.Sort(strategy, labels, info)
+	for i, c := range info {
+		candidates[i] = c.UserID
+	}
+	return nil
+}
+
+// scoredCandidates describes ids with all that strategy scores them by at at.
+func (s *Service) scoredCandidates(ctx context.Context, at time.Time, strategy model.AssignmentStrategy, authorID string, ids []string) ([]model.Candidate, error) {
+	info, err := s.store.GetCandidates(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if strategy != model.StrategyKnowledgeSpread {
+		return info, nil
+	}
+	pairings, err := s.store.GetPairings(ctx, authorID, ids, at.Add(-s.pairingWindow))
+	if err != nil {
+		return nil, err
+	}
+	for i := range info {
+		info[i].Pairing = ranking.Pairing(pairings[info[i].UserID], at, s.pairingWindow)
+	}
+	return info, nil
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"avito-pr-reviewer/internal/model"
+)
+
+var tagPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_.+-]{0,63}$`)
+
+// normalizeTags lowercases, validates and deduplicates user tags and pull
+// request labels so that they compare equal regardless of spelling.
+func normalizeTags(tags []string) ([]string, error) {
+	seen := make(map[string]bool, len(tags))
+	res := make([]string, 0, len(tags))
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if !tagPattern.MatchString(t) {
+			return nil, fmt.Errorf("%w: %q", model.ErrInvalidTag, t)
+		}
+		if !seen[t] {
+			seen[t] = true
+			res = append(res, t)
+		}
+	}
+	sort.Strings(res)
+	return res, nil
+}
+
+func (s *Service) AddUserTags(ctx context.Context, userID string, tags []string) (*model.User, error) {
+	return s.updateTags(ctx, userID, tags, s.store.AddUserTags)
+}
+
+func (s *Service) RemoveUserTags(ctx context.Context, userID string, tags []string) (*model.User, error) {
+	return s.updateTags(ctx, userID, tags, s.store.RemoveUserTags)
+}
+
+// SetUserTags replaces all of the user's tags.
+func (s *Service) SetUserTags(ctx context.Context, userID string, tags []string) (*model.User, error) {
+	return s.updateTags(ctx, userID, tags, s.store.SetUserTags)
+}
+
+func (s *Service) updateTags(ctx context.Context, userID string, tags []string, update func(context.Context, string, []string) error) (*model.User, error) {
+	tags, err := normalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.store.GetUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	if err := update(ctx, userID, tags); err != nil {
+		return nil, err
+	}
+	return s.store.GetUser(ctx, userID)
+}
+
+// ListTags returns every tag in use with the number of users carrying it,
+// limited to teamName's members unless it is empty.
+func (s *Service) ListTags(ctx context.Context, teamName string) ([]model.TagCount, error) {
+	if teamName != "" {
+		if _, err := s.store.GetTeam(ctx, teamName); err != nil {
+			return nil, err
+		}
+	}
+	return s.store.ListTags(ctx, teamName)
+}
@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"avito-pr-reviewer/internal/model"
+)
+
+// RenameTeam renames a team with its settings, members and events. Team
+// selectors in the rules of other teams still name the old team. An actor
+// must lead the team.
+func (s *Service) RenameTeam(ctx context.Context, actor, name, newName string) (*model.Team, error) {
+	if actor != "" {
+		if err := s.authorize(ctx, actor, name, model.RoleLead); err != nil {
+			return nil, err
+		}
+	}
+	if newName != name {
+		if _, err := s.store.GetTeam(ctx, newName); err == nil {
+			return nil, model.ErrTeamExists
+		} else if !errors.Is(err, model.ErrNotFound) {
+			return nil, err
+		}
+		renamed, err := s.store.RenameTeam(ctx, name, newName)
+		if err != nil {
+			return nil, err
+		}
+		if !renamed {
+			return nil, model.ErrNotFound
+		}
+	}
+	return s.store.GetTeam(ctx, newName)
+}
+
+// DeleteTeam deletes a team with its settings. Its members lose the
+// membership, and those for whom it was primary fall back to their oldest
+// other team. Its sub-teams become top-level teams. The team's open pull
+// requests are refused with OpenReviewsReject; with OpenReviewsUnassign the
+// members are dropped from their reviews and the pull requests stay open
+// without a team. An actor must lead the team.
+func (s *Service) DeleteTeam(ctx context.Context, actor, name string, policy model.OpenReviewPolicy) (*model.TeamDeletion, error) {
+	if policy != model.OpenReviewsReject && policy != model.OpenReviewsUnassign {
+		return nil, model.ErrInvalidPolicy
+	}
+	if actor != "" {
+		if err := s.authorize(ctx, actor, name, model.RoleLead); err != nil {
+			return nil, err
+		}
+	}
+	var res *model.TeamDeletion
+	err := s.inTx(ctx, func(tx *Service) error {
+		var err error
+		res, err = tx.deleteTeam(ctx, name, policy)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *Service) deleteTeam(ctx context.Context, name string, policy model.OpenReviewPolicy) (*model.TeamDeletion, error) {
+	team, err := s.store.GetTeam(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	prs, err := s.store.ListTeamOpenPRs(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(prs) > 0 && policy == model.OpenReviewsReject {
+		ids := make([]string, len(prs))
+		for i, pr := range prs {
+			ids[i] = pr.ID
+		}
+		return nil, fmt.Errorf("%w: %s", model.ErrTeamHasOpenPRs, strings.Join(ids, ", "))
+	}
+
+	res := &model.TeamDeletion{TeamName: name, Members: make([]string, len(team.Members)), Unassigned: []string{}}
+	members := make(map[string]bool, len(team.Members))
+	for i, m := range team.Members {
+		res.Members[i] = m.ID
+		members[m.ID] = true
+	}
+	for _, pr := range prs {
+		unassigned := false
+		for _, r := range pr.AssignedReviewers {
+			if !members[r] {
+				continue
+			}
+			if err := s.unassign(ctx, pr.ID, r); err != nil {
+				return nil, err
+			}
+			unassigned = true
+		}
+		if unassigned {
+			res.Unassigned = append(res.Unassigned, pr.ID)
+		}
+	}
+
+	deleted, err := s.store.DeleteTeam(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return nil, model.ErrNotFound
+	}
+	return res, nil
+}
+
+// SetTeamParent moves a team under parent, whose settings it then inherits
+// where it has none of its own. An empty parent makes it a top-level team.
+func (s *Service) SetTeamParent(ctx context.Context, name, parent string) (*model.Team, error) {
+	if _, err := s.store.GetTeam(ctx, name); err != nil {
+		return nil, err
+	}
+	if parent != "" {
+		if _, err := s.store.GetTeam(ctx, parent); err != nil {
+			return nil, fmt.Errorf("%w: parent team %s", err, parent)
+		}
+		ancestors, err := s.store.ListTeamAncestors(ctx, parent)
+		if err != nil {
+			return nil, err
+		}
+		if contains(ancestors, name) {
+			return nil, fmt.Errorf("%w: %s is under %s", model.ErrTeamCycle, parent, name)
+		}
+	}
+	if _, err := s.store.SetTeamParent(ctx, name, parent); err != nil {
+		return nil, err
+	}
+	return s.store.GetTeam(ctx, name)
+}
+
+// GetTeamTree returns the team with all its sub-teams and their counts.
+func (s *Service) GetTeamTree(ctx context.Context, name string) (*model.TeamTree, error) {
+	return s.store.GetTeamTree(ctx, name)
+}
+
+// AddTeamMember adds m to a team, creating the user or updating its name
+// and activity. Their other teams are kept; m.TeamName set to the team makes
+// it their primary one, and m.Role, if set, is their role in it, which only
+// a lead may give.
+func (s *Service) AddTeamMember(ctx context.Context, actor, teamName string, m model.User) (*model.Team, error) {
+	if _, err := s.store.GetTeam(ctx, teamName); err != nil {
+		return nil, err
+	}
+	if m.Role != "" {
+		if err := s.authorize(ctx, actor, teamName, model.RoleLead); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.addMember(ctx, teamName, m); err != nil {
+		return nil, err
+	}
+	return s.store.GetTeam(ctx, teamName)
+}
+
+func (s *Service) addMember(ctx context.Context, teamName string, m model.User) error {
+	if m.Role != "" && !m.Role.Valid() {
+		return fmt.Errorf("%w: %q", model.ErrInvalidRole, m.Role)
+	}
+	if err := s.store.CreateUser(ctx, m.ID, m.Username, m.IsActive); err != nil {
+		return err
+	}
+	if err := s.store.AddTeamMember(ctx, teamName, m.ID, m.TeamName == teamName); err != nil {
+		return err
+	}
+	if m.Role != "" {
+		if _, err := s.store.SetTeamMemberRole(ctx, teamName, m.ID, m.Role); err != nil {
+			return err
+		}
+	}
+	if m.Seniority != "" {
+		return s.store.SetUserSeniority(ctx, m.ID, m.Seniority)
+	}
+	return nil
+}
+
+// RemoveTeamMember takes the user out of the team. Their open reviews of the
+// team's pull requests are handled by policy. An actor may remove only
+// themselves or the members of teams they lead.
+func (s *Service) RemoveTeamMember(ctx context.Context, actor, teamName, userID string, policy model.OpenReviewPolicy) (*model.Team, error) {
+	if !policy.Valid() {
+		return nil, model.ErrInvalidPolicy
+	}
+	if _, err := s.store.GetUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	if actor != "" && actor != userID {
+		if err := s.authorize(ctx, actor, teamName, model.RoleLead); err != nil {
+			return nil, err
+		}
+	}
+	var team *model.Team
+	err := s.inTx(ctx, func(tx *Service) error {
+		var err error
+		team, err = tx.removeTeamMember(ctx, teamName, userID, policy)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return team, nil
+}
+
+func (s *Service) removeTeamMember(ctx context.Context, teamName, userID string, policy model.OpenReviewPolicy) (*model.Team, error) {
+	u, err := s.store.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !contains(u.Teams, teamName) {
+		return nil, fmt.Errorf("%w: %s is not a member of %s", model.ErrNotFound, userID, teamName)
+	}
+	if err := s.leave(ctx, userID, teamName, policy); err != nil {
+		return nil, err
+	}
+	removed, err := s.store.RemoveTeamMember(ctx, teamName, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !removed {
+		return nil, model.ErrNotFound
+	}
+	return s.store.GetTeam(ctx, teamName)
+}
+
+// leave handles the open reviews userID holds on the pull requests of
+// teamName before they leave it. It must run while they are
+// still a member, so that replacements come from the team.
+func (s *Service) leave(ctx context.Context, userID, teamName string, policy model.OpenReviewPolicy) error {
+	prIDs, err := s.store.ListOpenReviewsInTeam(ctx, userID, teamName)
+	if err != nil || len(prIDs) == 0 {
+		return err
+	}
+	switch policy {
+	case model.OpenReviewsReject:
+		return fmt.Errorf("%w: %s reviews %s in %s", model.ErrOpenReviews, userID, strings.Join(prIDs, ", "), teamName)
+	case model.OpenReviewsReassign:
+		for _, id := range prIDs {
+			if _, _, err := s.reassign(ctx, id, userID, model.ReassignTeamChange, nil); err != nil {
+				return fmt.Errorf("reassign %s: %w", id, err)
+			}
+		}
+	case model.OpenReviewsUnassign:
+		for _, id := range prIDs {
+			if err := s.unassign(ctx, id, userID); err != nil {
+				return err
+			}
+		}
+	default:
+		return model.ErrInvalidPolicy
+	}
+	return nil
+}
+
+// unassign drops userID from the reviewers of a pull request without a
+// replacement.
+func (s *Service) unassign(ctx context.Context, prID, userID string) error {
+	pr, err := s.store.GetPR(ctx, prID)
+	if err != nil {
+		return err
+	}
+	reviewers := make([]string, 0, len(pr.AssignedReviewers))
+	for _, r := range pr.AssignedReviewers {
+		if r != userID {
+			reviewers = append(reviewers, r)
+		}
+	}
+	if err := s.store.UpdatePRReviewers(ctx, prID, reviewers); err != nil {
+		return err
+	}
+	if err := s.store.DeleteReviewAssignment(ctx, prID, userID); err != nil {
+		return err
+	}
+	pr.AssignedReviewers = reviewers
+	s.emit(ctx, model.EventReviewUnassigned, pr.TeamName, []string{userID}, model.EventPayload{
+		PR:            pr,
+		OldReviewerID: userID,
+		Reason:        string(model.ReassignTeamChange),
+	})
+	return nil
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"avito-pr-reviewer/internal/businesstime"
+	"avito-pr-reviewer/internal/model"
+)
+
+// WithBusinessTime sets the holiday calendar and the schedule used for users
+// and teams without working hours of their own.
+func WithBusinessTime(calc *businesstime.Calculator, schedule businesstime.Schedule) Option {
+	return func(s *Service) {
+		s.calc = calc
+		s.schedule = schedule
+	}
+}
+
+// WithWorkingHoursPreference makes assignment pick reviewers who are inside
+// their working hours whenever there are any.
+func WithWorkingHoursPreference() Option {
+	return func(s *Service) {
+		s.preferInHours = true
+	}
+}
+
+// SetUserWorkingHours overrides the team's working hours for one user. A nil
+// wh makes the user follow the team again.
+func (s *Service) SetUserWorkingHours(ctx context.Context, userID string, wh *model.WorkingHours) (*model.User, error) {
+	if wh != nil {
+		if _, err := businesstime.Parse(*wh); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := s.store.GetUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	if err := s.store.SetUserWorkingHours(ctx, userID, wh); err != nil {
+		return nil, err
+	}
+	return s.store.GetUser(ctx, userID)
+}
+
+// SetTeamWorkingHours sets the working hours of the team's members. A nil wh
+// falls back to the server default.
+func (s *Service) SetTeamWorkingHours(ctx context.Context, teamName string, wh *model.WorkingHours) error {
+	if wh != nil {
+		if _, err := businesstime.Parse(*wh); err != nil {
+			return err
+		}
+	}
+	if _, err := s.store.GetTeamWorkingHours(ctx, teamName); err != nil {
+		return err
+	}
+	return s.store.SetTeamWorkingHours(ctx, teamName, wh)
+}
+
+// GetTeamWorkingHours returns nil if the team uses the server default.
+func (s *Service) GetTeamWorkingHours(ctx context.Context, teamName string) (*model.WorkingHours, error) {
+	return s.store.GetTeamWorkingHours(ctx, teamName)
+}
+
+// schedules resolves working schedules, user first, then team, then the
+// server default, and remembers them for the lifetime of one operation.
+type schedules struct {
+	svc   *Service
+	users map[string]businesstime.Schedule
+	teams map[string]businesstime.Schedule
+}
+
+func (s *Service) schedules() *schedules {
+	return &schedules{
+		svc:   s,
+		users: make(map[string]businesstime.Schedule),
+		teams: make(map[string]businesstime.Schedule),
+	}
+}
+
+func (c *schedules) forUser(ctx context.Context, userID string) businesstime.Schedule {
+	if sch, ok := c.users[userID]; ok {
+		return sch
+	}
+	sch := c.svc.schedule
+	u, err := c.svc.store.GetUser(ctx, userID)
+	switch {
+	case err != nil:
+		log.Printf("working hours: user %s: %v", userID, err)
+	case u.WorkingHours != nil:
+		sch = c.parse(*u.WorkingHours, sch)
+	default:
+		sch = c.forTeam(ctx, u.TeamName)
+	}
+	c.users[userID] = sch
+	return sch
+}
+
+// preload resolves the schedules of userIDs with one lookup of the users.
+func (c *schedules) preload(ctx context.Context, userIDs []string) {
+	users, err := c.svc.store.GetUsers(ctx, userIDs)
+	if err != nil {
+		log.Printf("working hours: users: %v", err)
+		return
+	}
+	for _, u := range users {
+		if u.WorkingHours != nil {
+			c.users[u.ID] = c.parse(*u.WorkingHours, c.svc.schedule)
+		} else {
+			c.users[u.ID] = c.forTeam(ctx, u.TeamName)
+		}
+	}
+}
+
+func (c *schedules) forTeam(ctx context.Context, teamName string) businesstime.Schedule {
+	if sch, ok := c.teams[teamName]; ok {
+		return sch
+	}
+	sch := c.svc.schedule
+	wh, err := c.svc.store.GetTeamWorkingHours(ctx, teamName)
+	switch {
+	case err != nil:
+		log.Printf("working hours: team %s: %v", teamName, err)
+	case wh != nil:
+		sch = c.parse(*wh, sch)
+	}
+	c.teams[teamName] = sch
+	return sch
+}
+
+// parse falls back to def for hours stored before a time zone was dropped
+// from the server's tz database.
+func (c *schedules) parse(wh model.WorkingHours, def businesstime.Schedule) businesstime.Schedule {
+	sch, err := businesstime.Parse(wh)
+	if err != nil {
+		log.Printf("working hours: %v", err)
+		return def
+	}
+	return sch
+}
+
+// preferWorkingNow moves the candidates who are inside their working hours at now
+// to the front, otherwise keeping their order.
+func (s *Service) preferWorkingNow(ctx context.Context, candidates []string, now time.Time) {
+	if !s.preferInHours {
+		return
+	}
+	sch := s.schedules()
+	working := make(map[string]bool, len(candidates))
+	for _, c := range candidates {
+		working[c] = s.calc.InWorkingHours(now, sch.forUser(ctx, c))
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return working[candidates[i]] && !working[candidates[j]]
+	})
+}
@@ -0,0 +1,365 @@
+// Package simulate replays pull request history through an assignment
+// strategy in memory, to see how reviews would have been spread before a team
+// switches strategies.
+//
+// Candidates are the author's teammates who are active now. Repository
+// policies, code owners, team rules, composition rules and working hours are
+// not simulated. A recorded reassignment is replayed only if its old reviewer
+// also holds the review in the simulation.
+package simulate
+
+import (
+	"math/rand"
+	"sort"
+	"time"
+
+	"avito-pr-reviewer/internal/model"
+	"avito-pr-reviewer/internal/ranking"
+)
+
+type Options struct {
+	Strategy model.AssignmentStrategy
+	// Reviewers is how many reviewers each pull request gets.
+	Reviewers int
+	// Team limits the simulation to the team's pull requests.
+	Team string
+	// PairingWindow is how long a review of the same author counts against
+	// a candidate under the knowledge spread strategy.
+	PairingWindow time.Duration
+	// Rand shuffles candidates; nil means a fixed seed.
+	Rand *rand.Rand
+}
+
+type ReviewerStats struct {
+	UserID        string `json:"user_id"`
+	TeamName      string `json:"team_name"`
+	Assigned      int    `json:"assigned"`
+	MaxConcurrent int    `json:"max_concurrent"`
+	// Reassigned counts the reviews moved away from the reviewer.
+	Reassigned int `json:"reassigned"`
+}
+
+type Report struct {
+	// Strategy is empty for the recorded assignments.
+	Strategy     model.AssignmentStrategy `json:"strategy,omitempty"`
+	PullRequests int                      `json:"pull_requests"`
+	// Understaffed counts pull requests that got fewer reviewers than asked for.
+	Understaffed  int     `json:"understaffed"`
+	Reassignments int     `json:"reassignments"`
+	MaxConcurrent int     `json:"max_concurrent"`
+	Gini          float64 `json:"gini"`
+	// Reviewers are all possible reviewers, most assigned first.
+	Reviewers []ReviewerStats `json:"reviewers"`
+}
+
+type eventKind int
+
+// Events at the same time apply in this order.
+const (
+	created eventKind = iota
+	reassigned
+	merged
+)
+
+type event struct {
+	at   time.Time
+	kind eventKind
+	pr   *model.PullRequest
+	r    *model.Reassignment
+}
+
+// assigner decides who reviews in a replay.
+type assigner interface {
+	assign(pr *model.PullRequest) []string
+	// reassign returns who replaces r.OldReviewerID, if anyone.
+	reassign(pr *model.PullRequest, r *model.Reassignment, current []string) (string, bool)
+}
+
+// Run replays h with reviewers chosen by opts.Strategy.
+func Run(h *model.History, opts Options) *Report {
+	if opts.Rand == nil {
+		opts.Rand = rand.New(rand.NewSource(1))
+	}
+	r := newReplay(h, opts.Team)
+	sim := &simulated{replay: r, opts: opts}
+	rep := r.run(sim)
+	rep.Strategy = opts.Strategy
+	rep.Understaffed = sim.understaffed
+	return rep
+}
+
+// Recorded replays the reviewers that h actually assigned, for comparison.
+func Recorded(h *model.History, team string) *Report {
+	r := newReplay(h, team)
+	return r.run(recorded{r})
+}
+
+// Gini is the Gini coefficient of values: 0 when all are equal, approaching
+// 1 when one holds everything.
+func Gini(values []int) float64 {
+	sorted := append([]int{}, values...)
+	sort.Ints(sorted)
+	var sum, weighted float64
+	for i, v := range sorted {
+		sum += float64(v)
+		weighted += float64(i+1) * float64(v)
+	}
+	if sum == 0 {
+		return 0
+	}
+	n := float64(len(sorted))
+	return 2*weighted/(n*sum) - (n+1)/n
+}
+
+type replay struct {
+	users map[string]*model.User
+	prs   []*model.PullRequest
+	// reassignments by pull request, oldest first.
+	reassignments map[string][]*model.Reassignment
+
+	reviewers map[string][]string
+	open      map[string]int
+	stats     map[string]*ReviewerStats
+	// pairings are when each reviewer was assigned to each author.
+	pairings map[string]map[string][]time.Time
+	now      time.Time
+}
+
+func newReplay(h *model.History, team string) *replay {
+	r := &replay{
+		users:         make(map[string]*model.User, len(h.Users)),
+		reassignments: make(map[string][]*model.Reassignment),
+		reviewers:     make(map[string][]string),
+		open:          make(map[string]int),
+		stats:         make(map[string]*ReviewerStats),
+		pairings:      make(map[string]map[string][]time.Time),
+	}
+	for i := range h.Users {
+		r.users[h.Users[i].ID] = &h.Users[i]
+	}
+	for i := range h.PullRequests {
+		pr := &h.PullRequests[i]
+		if _, ok := r.users[pr.AuthorID]; !ok || (team != "" && r.team(pr) != team) {
+			continue
+		}
+		r.prs = append(r.prs, pr)
+	}
+	for i := range h.Reassignments {
+		ra := &h.Reassignments[i]
+		r.reassignments[ra.PullRequestID] = append(r.reassignments[ra.PullRequestID], ra)
+	}
+	return r
+}
+
+// team returns the team pr draws reviewers from. Pull requests recorded
+// without one use the author's primary team.
+func (r *replay) team(pr *model.PullRequest) string {
+	if pr.TeamName != "" {
+		return pr.TeamName
+	}
+	return r.users[pr.AuthorID].TeamName
+}
+
+// pool returns the active members of pr's team, other than the author, who
+// may review. Pull requests without a team have none.
+func (r *replay) pool(pr *model.PullRequest) []string {
+	team := r.team(pr)
+	if team == "" {
+		return nil
+	}
+	var ids []string
+	for _, u := range r.users {
+		if u.IsActive && (u.TeamName == team || contains(u.Teams, team)) && u.ID != pr.AuthorID {
+			ids = append(ids, u.ID)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (r *replay) run(a assigner) *Report {
+	var events []event
+	for _, pr := range r.prs {
+		events = append(events, event{at: pr.CreatedAt, kind: created, pr: pr})
+		for _, ra := range r.reassignments[pr.ID] {
+			events = append(events, event{at: ra.CreatedAt, kind: reassigned, pr: pr, r: ra})
+		}
+		if pr.MergedAt != nil {
+			events = append(events, event{at: *pr.MergedAt, kind: merged, pr: pr})
+		}
+		for _, id := range r.pool(pr) {
+			r.stat(id)
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].at.Equal(events[j].at) {
+			return events[i].at.Before(events[j].at)
+		}
+		return events[i].kind < events[j].kind
+	})
+
+	rep := &Report{PullRequests: len(r.prs)}
+	for _, e := range events {
+		r.now = e.at
+		switch e.kind {
+		case created:
+			ids := a.assign(e.pr)
+			r.reviewers[e.pr.ID] = ids
+			for _, id := range ids {
+				r.take(e.pr, id)
+			}
+		case reassigned:
+			current := r.reviewers[e.pr.ID]
+			if !contains(current, e.r.OldReviewerID) {
+				continue
+			}
+			next, ok := a.reassign(e.pr, e.r, current)
+			if !ok {
+				continue
+			}
+			rep.Reassignments++
+			r.stat(e.r.OldReviewerID).Reassigned++
+			r.open[e.r.OldReviewerID]--
+			updated := make([]string, 0, len(current))
+			for _, id := range current {
+				if id != e.r.OldReviewerID {
+					updated = append(updated, id)
+				}
+			}
+			if next != "" {
+				updated = append(updated, next)
+				r.take(e.pr, next)
+			}
+			r.reviewers[e.pr.ID] = updated
+		case merged:
+			for _, id := range r.reviewers[e.pr.ID] {
+				r.open[id]--
+			}
+		}
+	}
+
+	assigned := make([]int, 0, len(r.stats))
+	for _, s := range r.stats {
+		rep.Reviewers = append(rep.Reviewers, *s)
+		rep.MaxConcurrent = max(rep.MaxConcurrent, s.MaxConcurrent)
+		assigned = append(assigned, s.Assigned)
+	}
+	sort.Slice(rep.Reviewers, func(i, j int) bool {
+		a, b := rep.Reviewers[i], rep.Reviewers[j]
+		if a.Assigned != b.Assigned {
+			return a.Assigned > b.Assigned
+		}
+		return a.UserID < b.UserID
+	})
+	rep.Gini = Gini(assigned)
+	return rep
+}
+
+func (r *replay) stat(id string) *ReviewerStats {
+	s, ok := r.stats[id]
+	if !ok {
+		s = &ReviewerStats{UserID: id}
+		if u, ok := r.users[id]; ok {
+			s.TeamName = u.TeamName
+		}
+		r.stats[id] = s
+	}
+	return s
+}
+
+// take assigns id to review pr.
+func (r *replay) take(pr *model.PullRequest, id string) {
+	r.open[id]++
+	s := r.stat(id)
+	s.Assigned++
+	s.MaxConcurrent = max(s.MaxConcurrent, r.open[id])
+	if r.pairings[pr.AuthorID] == nil {
+		r.pairings[pr.AuthorID] = make(map[string][]time.Time)
+	}
+	r.pairings[pr.AuthorID][id] = append(r.pairings[pr.AuthorID][id], r.now)
+}
+
+// simulated picks reviewers with a strategy, by the simulated load.
+type simulated struct {
+	*replay
+	opts         Options
+	understaffed int
+}
+
+func (s *simulated) assign(pr *model.PullRequest) []string {
+	ranked := s.rank(pr, s.pool(pr))
+	if len(ranked) < s.opts.Reviewers {
+		s.understaffed++
+	}
+	return ranked[:min(s.opts.Reviewers, len(ranked))]
+}
+
+func (s *simulated) reassign(pr *model.PullRequest, r *model.Reassignment, current []string) (string, bool) {
+	var available []string
+	for _, id := range s.pool(pr) {
+		if !contains(current, id) {
+			available = append(available, id)
+		}
+	}
+	ranked := s.rank(pr, available)
+	if len(ranked) == 0 {
+		return "", false
+	}
+	return ranked[0], true
+}
+
+func (s *simulated) rank(pr *model.PullRequest, ids []string) []string {
+	candidates := make([]model.Candidate, len(ids))
+	for i, id := range ids {
+		u := s.users[id]
+		candidates[i] = model.Candidate{
+			UserID:      id,
+			TeamName:    u.TeamName,
+			Tags:        u.Tags,
+			OpenReviews: s.open[id],
+			Seniority:   u.Seniority,
+			Pairing:     ranking.Pairing(s.pairings[pr.AuthorID][id], s.now, s.opts.PairingWindow),
+		}
+	}
+	s.opts.Rand.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	ranking.Sort(s.opts.Strategy, pr.Labels, candidates)
+	res := make([]string, len(candidates))
+	for i, c := range candidates {
+		res[i] = c.UserID
+	}
+	return res
+}
+
+// recorded repeats what happened. A pull request's first reviewers are its
+// final ones with its reassignments undone.
+type recorded struct {
+	*replay
+}
+
+func (r recorded) assign(pr *model.PullRequest) []string {
+	ids := append([]string{}, pr.AssignedReviewers...)
+	ras := r.reassignments[pr.ID]
+	for i := len(ras) - 1; i >= 0; i-- {
+		for j, id := range ids {
+			if id == ras[i].NewReviewerID {
+				ids[j] = ras[i].OldReviewerID
+			}
+		}
+	}
+	return ids
+}
+
+func (recorded) reassign(_ *model.PullRequest, r *model.Reassignment, _ []string) (string, bool) {
+	return r.NewReviewerID, true
+}
+
+func contains(list []string, v string) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
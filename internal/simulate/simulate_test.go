@@ -0,0 +1,171 @@
+package simulate
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"avito-pr-reviewer/internal/model"
+)
+
+func TestGini(t *testing.T) {
+	tests := []struct {
+		values []int
+		want   float64
+	}{
+		{nil, 0},
+		{[]int{0, 0}, 0},
+		{[]int{3, 3, 3}, 0},
+		{[]int{0, 0, 0, 4}, 0.75},
+		{[]int{1, 2, 3, 4}, 0.25},
+	}
+	for _, tt := range tests {
+		if got := Gini(tt.values); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("Gini(%v) = %v, want %v", tt.values, got, tt.want)
+		}
+	}
+}
+
+var start = time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
+
+func at(hours int) time.Time {
+	return start.Add(time.Duration(hours) * time.Hour)
+}
+
+func mergedAt(hours int) *time.Time {
+	t := at(hours)
+	return &t
+}
+
+func history() *model.History {
+	return &model.History{
+		Users: []model.User{
+			{ID: "a", TeamName: "backend", IsActive: true},
+			{ID: "b", TeamName: "backend", IsActive: true},
+			{ID: "c", TeamName: "backend", IsActive: true},
+			{ID: "d", TeamName: "backend", IsActive: false},
+			{ID: "x", TeamName: "frontend", IsActive: true},
+		},
+		PullRequests: []model.PullRequest{
+			{ID: "pr-1", AuthorID: "a", AssignedReviewers: []string{"b"}, CreatedAt: at(0), MergedAt: mergedAt(5)},
+			{ID: "pr-2", AuthorID: "a", AssignedReviewers: []string{"b"}, CreatedAt: at(1), MergedAt: mergedAt(6)},
+			{ID: "pr-3", AuthorID: "a", AssignedReviewers: []string{"c"}, CreatedAt: at(2)},
+			{ID: "pr-4", AuthorID: "x", AssignedReviewers: []string{}, CreatedAt: at(3)},
+		},
+		Reassignments: []model.Reassignment{
+			{PullRequestID: "pr-3", OldReviewerID: "b", NewReviewerID: "c", CreatedAt: at(4)},
+		},
+	}
+}
+
+func stats(rep *Report, id string) ReviewerStats {
+	for _, s := range rep.Reviewers {
+		if s.UserID == id {
+			return s
+		}
+	}
+	return ReviewerStats{}
+}
+
+func TestRunLeastLoaded(t *testing.T) {
+	rep := Run(history(), Options{Strategy: model.StrategyLeastLoaded, Reviewers: 1, Team: "backend"})
+
+	if rep.PullRequests != 3 {
+		t.Fatalf("pull requests = %d, want 3", rep.PullRequests)
+	}
+	// b and c alternate, and d is inactive. pr-3 is reassigned only if it
+	// went to b.
+	b, c := stats(rep, "b"), stats(rep, "c")
+	if b.Assigned+c.Assigned != 3+rep.Reassignments || b.MaxConcurrent > 2 || c.MaxConcurrent > 2 {
+		t.Errorf("b = %+v, c = %+v", b, c)
+	}
+	if rep.Reassignments != b.Reassigned {
+		t.Errorf("reassignments = %d, b reassigned %d", rep.Reassignments, b.Reassigned)
+	}
+	if d := stats(rep, "d"); d != (ReviewerStats{}) {
+		t.Errorf("inactive d got %+v", d)
+	}
+	if len(rep.Reviewers) != 2 {
+		t.Errorf("reviewers = %+v, want b and c", rep.Reviewers)
+	}
+}
+
+func TestRunIsDeterministic(t *testing.T) {
+	opts := Options{Strategy: model.StrategyRandom, Reviewers: 1}
+	first := Run(history(), opts)
+	for range 5 {
+		again := Run(history(), opts)
+		for i := range first.Reviewers {
+			if first.Reviewers[i] != again.Reviewers[i] {
+				t.Fatalf("runs differ: %+v vs %+v", first.Reviewers, again.Reviewers)
+			}
+		}
+	}
+}
+
+func TestRunUnderstaffed(t *testing.T) {
+	rep := Run(history(), Options{Strategy: model.StrategyLeastLoaded, Reviewers: 3})
+	// Backend pull requests have two candidates, frontend ones none.
+	if rep.Understaffed != 4 {
+		t.Errorf("understaffed = %d, want 4", rep.Understaffed)
+	}
+}
+
+func TestRecorded(t *testing.T) {
+	rep := Recorded(history(), "backend")
+
+	// pr-3 went to b first and was moved to c.
+	b := stats(rep, "b")
+	if b.Assigned != 3 || b.MaxConcurrent != 3 || b.Reassigned != 1 {
+		t.Errorf("b = %+v", b)
+	}
+	c := stats(rep, "c")
+	if c.Assigned != 1 || c.MaxConcurrent != 1 || c.Reassigned != 0 {
+		t.Errorf("c = %+v", c)
+	}
+	if rep.Reassignments != 1 || rep.MaxConcurrent != 3 {
+		t.Errorf("reassignments = %d, max concurrent = %d", rep.Reassignments, rep.MaxConcurrent)
+	}
+	if want := Gini([]int{3, 1}); rep.Gini != want {
+		t.Errorf("gini = %v, want %v", rep.Gini, want)
+	}
+}
+
+func TestRunKnowledgeSpread(t *testing.T) {
+	h := &model.History{Users: []model.User{
+		{ID: "a", TeamName: "backend", IsActive: true},
+		{ID: "b", TeamName: "backend", IsActive: true},
+		{ID: "c", TeamName: "backend", IsActive: true},
+		{ID: "d", TeamName: "backend", IsActive: true},
+	}}
+	// Each pull request is merged before the next, so load never decides.
+	for i := range 6 {
+		h.PullRequests = append(h.PullRequests, model.PullRequest{
+			ID: string(rune('1' + i)), AuthorID: "a", CreatedAt: at(2 * i), MergedAt: mergedAt(2*i + 1),
+		})
+	}
+	rep := Run(h, Options{Strategy: model.StrategyKnowledgeSpread, Reviewers: 1, PairingWindow: 30 * 24 * time.Hour})
+	for _, id := range []string{"b", "c", "d"} {
+		if s := stats(rep, id); s.Assigned != 2 {
+			t.Errorf("%s = %+v, want 2 reviews", id, s)
+		}
+	}
+	if rep.Gini != 0 {
+		t.Errorf("gini = %v, want 0", rep.Gini)
+	}
+}
+
+func TestRunUsesPullRequestTeam(t *testing.T) {
+	h := history()
+	// x also belongs to backend, and pr-4 was opened for it.
+	h.Users[4].Teams = []string{"frontend", "backend"}
+	h.PullRequests[3].TeamName = "backend"
+
+	rep := Run(h, Options{Strategy: model.StrategyLeastLoaded, Reviewers: 1, Team: "backend"})
+	if rep.PullRequests != 4 || rep.Understaffed != 0 {
+		t.Errorf("pull requests = %d, understaffed = %d, want 4 and 0", rep.PullRequests, rep.Understaffed)
+	}
+	if x := stats(rep, "x"); x.Assigned == 0 {
+		t.Errorf("x was never picked for backend: %+v", rep.Reviewers)
+	}
+}
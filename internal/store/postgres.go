@@ -4,14 +4,21 @@ import (
 	"avito-pr-reviewer/internal/model"
 	"avito-pr-reviewer/internal/store/queries"
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type PostgresStore struct {
-	q *queries.Queries
+	pool *pgxpool.Pool
+	// tx is set inside WithinTx.
+	tx pgx.Tx
+	q  *queries.Queries
 }
 
 func NewPostgresStore(ctx context.Context, dsn string) (*PostgresStore, error) {
@@ -22,60 +29,330 @@ func NewPostgresStore(ctx context.Context, dsn string) (*PostgresStore, error) {
 	if err := pool.Ping(ctx); err != nil {
 		return nil, fmt.Errorf("failed to ping db: %w", err)
 	}
-	return &PostgresStore{q: queries.New(pool)}, nil
+	return &PostgresStore{pool: pool, q: queries.New(pool)}, nil
+}
+
+// notFound maps a missing row to model.ErrNotFound so callers don't depend on pgx.
+func notFound(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.ErrNotFound
+	}
+	return err
 }
 
 func (s *PostgresStore) Close() {
-	
+	s.pool.Close()
+}
+
+func (s *PostgresStore) WithinTx(ctx context.Context, fn func(Repository) error) error {
+	var db interface {
+		Begin(context.Context) (pgx.Tx, error)
+	} = s.pool
+	if s.tx != nil {
+		// A transaction within a transaction is a savepoint.
+		db = s.tx
+	}
+	return pgx.BeginFunc(ctx, db, func(tx pgx.Tx) error {
+		return fn(&PostgresStore{pool: s.pool, tx: tx, q: s.q.WithTx(tx)})
+	})
 }
 
-func (s *PostgresStore) CreateTeam(ctx context.Context, name string) error {
-	return s.q.CreateTeam(ctx, name)
+func (s *PostgresStore) CreateTeam(ctx context.Context, name, parent string) error {
+	return s.q.CreateTeam(ctx, queries.CreateTeamParams{
+		Name:       name,
+		ParentName: pgtype.Text{String: parent, Valid: parent != ""},
+	})
 }
 
 func (s *PostgresStore) GetTeam(ctx context.Context, name string) (*model.Team, error) {
-	_, err := s.q.GetTeam(ctx, name)
+	t, err := s.q.GetTeam(ctx, name)
 	if err != nil {
-		return nil, err
+		return nil, notFound(err)
 	}
 	users, err := s.q.GetUsersByTeam(ctx, name)
 	if err != nil {
 		return nil, err
 	}
+	ids := make([]string, len(users))
+	for i, u := range users {
+		ids[i] = u.ID
+	}
+	tags, err := s.userTags(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	teams, primary, err := s.userTeams(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
 	members := make([]model.User, len(users))
 	for i, u := range users {
 		members[i] = model.User{
-			ID:       u.ID,
-			Username: u.Username,
-			TeamName: u.TeamName,
-			IsActive: u.IsActive,
+			ID:           u.ID,
+			Username:     u.Username,
+			TeamName:     primary[u.ID],
+			Teams:        teams[u.ID],
+			IsActive:     u.IsActive,
+			Email:        u.Email.String,
+			EmailOptOut:  u.EmailOptOut,
+			WorkingHours: toWorkingHours(u.Timezone, u.WorkStart, u.WorkEnd, u.WorkDays),
+			Tags:         tags[u.ID],
+			Seniority:    model.Seniority(u.Seniority),
+			Role:         model.TeamRole(u.Role),
+		}
+	}
+	return &model.Team{
+		Name:           name,
+		Members:        members,
+		Parent:         t.ParentName.String,
+		LeadAssignment: model.LeadAssignment(t.LeadAssignment),
+	}, nil
+}
+
+// ListTeams returns the names of all teams.
+func (s *PostgresStore) ListTeams(ctx context.Context) ([]string, error) {
+	return s.q.ListTeams(ctx)
+}
+
+func (s *PostgresStore) SetTeamLeadAssignment(ctx context.Context, name string, a model.LeadAssignment) (bool, error) {
+	n, err := s.q.SetTeamLeadAssignment(ctx, queries.SetTeamLeadAssignmentParams{Name: name, LeadAssignment: string(a)})
+	return n > 0, err
+}
+
+func (s *PostgresStore) SetTeamMemberRole(ctx context.Context, teamName, userID string, role model.TeamRole) (bool, error) {
+	n, err := s.q.SetTeamMemberRole(ctx, queries.SetTeamMemberRoleParams{
+		TeamName: teamName,
+		UserID:   userID,
+		Role:     string(role),
+	})
+	return n > 0, err
+}
+
+func (s *PostgresStore) ListTeamLeads(ctx context.Context, teamName string) ([]string, error) {
+	return s.q.ListTeamLeads(ctx, teamName)
+}
+
+// ListTeamRoles returns the roles the user holds in the team and its
+// ancestors.
+func (s *PostgresStore) ListTeamRoles(ctx context.Context, teamName, userID string) ([]model.TeamRole, error) {
+	rows, err := s.q.ListTeamRoles(ctx, queries.ListTeamRolesParams{TeamName: teamName, UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	roles := make([]model.TeamRole, len(rows))
+	for i, r := range rows {
+		roles[i] = model.TeamRole(r)
+	}
+	return roles, nil
+}
+
+// SetTeamParent moves a team under parent, or makes it a top-level team if
+// parent is empty.
+func (s *PostgresStore) SetTeamParent(ctx context.Context, name, parent string) (bool, error) {
+	n, err := s.q.SetTeamParent(ctx, queries.SetTeamParentParams{
+		Name:       name,
+		ParentName: pgtype.Text{String: parent, Valid: parent != ""},
+	})
+	return n > 0, err
+}
+
+// ListTeamAncestors returns the team and its ancestors, nearest first.
+func (s *PostgresStore) ListTeamAncestors(ctx context.Context, name string) ([]string, error) {
+	return s.q.ListTeamAncestors(ctx, name)
+}
+
+// ListSiblingTeams returns the other teams with the same parent. Top-level
+// teams have no siblings.
+func (s *PostgresStore) ListSiblingTeams(ctx context.Context, name string) ([]string, error) {
+	return s.q.ListSiblingTeams(ctx, name)
+}
+
+// GetTeamTree returns the team with its sub-teams and their member and open
+// pull request counts.
+func (s *PostgresStore) GetTeamTree(ctx context.Context, name string) (*model.TeamTree, error) {
+	rows, err := s.q.GetTeamSubtree(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, model.ErrNotFound
+	}
+	// Rows come by depth: walking them backwards, a node's sub-teams are all
+	// attached before the node is copied into its parent.
+	nodes := make(map[string]*model.TeamTree, len(rows))
+	for _, r := range rows {
+		nodes[r.Name] = &model.TeamTree{
+			Name:         r.Name,
+			Parent:       r.ParentName.String,
+			Members:      int(r.Members),
+			OpenPRs:      int(r.OpenPrs),
+			TotalMembers: int(r.TotalMembers),
+			TotalOpenPRs: int(r.TotalOpenPrs),
 		}
 	}
-	return &model.Team{Name: name, Members: members}, nil
+	for i := len(rows) - 1; i > 0; i-- {
+		n := nodes[rows[i].Name]
+		p := nodes[n.Parent]
+		p.SubTeams = append([]model.TeamTree{*n}, p.SubTeams...)
+	}
+	return nodes[rows[0].Name], nil
 }
 
-func (s *PostgresStore) CreateUser(ctx context.Context, id, username, teamName string, isActive bool) error {
+// RenameTeam renames a team along with everything that refers to it,
+// including its events.
+func (s *PostgresStore) RenameTeam(ctx context.Context, name, newName string) (bool, error) {
+	n, err := s.q.RenameTeam(ctx, queries.RenameTeamParams{NewName: newName, Name: name})
+	return n > 0, err
+}
+
+// DeleteTeam deletes a team with its settings and memberships. Members for
+// whom it was the primary team get their oldest remaining team instead.
+func (s *PostgresStore) DeleteTeam(ctx context.Context, name string) (bool, error) {
+	members, err := s.q.ListTeamMemberIDs(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	n, err := s.q.DeleteTeam(ctx, name)
+	if err != nil || n == 0 {
+		return false, err
+	}
+	return true, s.q.PromotePrimaryTeams(ctx, members)
+}
+
+// AddTeamMember adds the user to the team. The first team of a user is their
+// primary one; primary makes this one primary instead.
+func (s *PostgresStore) AddTeamMember(ctx context.Context, teamName, userID string, primary bool) error {
+	err := s.q.AddTeamMembership(ctx, queries.AddTeamMembershipParams{UserID: userID, TeamName: teamName})
+	if err != nil || !primary {
+		return err
+	}
+	// Two statements: the one-primary index is checked row by row.
+	if err := s.q.ClearPrimaryTeam(ctx, queries.ClearPrimaryTeamParams{UserID: userID, TeamName: teamName}); err != nil {
+		return err
+	}
+	return s.q.SetPrimaryTeam(ctx, queries.SetPrimaryTeamParams{UserID: userID, TeamName: teamName})
+}
+
+// RemoveTeamMember removes the user from the team. If it was their primary
+// team, their oldest remaining team becomes primary.
+func (s *PostgresStore) RemoveTeamMember(ctx context.Context, teamName, userID string) (bool, error) {
+	n, err := s.q.RemoveTeamMember(ctx, queries.RemoveTeamMemberParams{ID: userID, TeamName: teamName})
+	if err != nil || n == 0 {
+		return false, err
+	}
+	return true, s.q.PromotePrimaryTeams(ctx, []string{userID})
+}
+
+// userTeams returns the teams of each user, primary first, and their
+// primary teams.
+func (s *PostgresStore) userTeams(ctx context.Context, userIDs []string) (map[string][]string, map[string]string, error) {
+	rows, err := s.q.ListTeamMemberships(ctx, userIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	teams := make(map[string][]string)
+	primary := make(map[string]string)
+	for _, r := range rows {
+		teams[r.UserID] = append(teams[r.UserID], r.TeamName)
+		if r.IsPrimary {
+			primary[r.UserID] = r.TeamName
+		}
+	}
+	return teams, primary, nil
+}
+
+// ListOpenReviewsInTeam returns the open pull requests of the team that
+// userID reviews.
+func (s *PostgresStore) ListOpenReviewsInTeam(ctx context.Context, userID, teamName string) ([]string, error) {
+	return s.q.ListOpenReviewsInTeam(ctx, queries.ListOpenReviewsInTeamParams{UserID: userID, TeamName: teamName})
+}
+
+// ListTeamOpenPRs returns the open pull requests of the team, with only their
+// IDs, authors and reviewers.
+func (s *PostgresStore) ListTeamOpenPRs(ctx context.Context, teamName string) ([]model.PullRequest, error) {
+	rows, err := s.q.ListTeamOpenPRs(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]model.PullRequest, len(rows))
+	for i, r := range rows {
+		res[i] = model.PullRequest{
+			ID:                r.ID,
+			AuthorID:          r.AuthorID,
+			Status:            model.StatusOpen,
+			AssignedReviewers: r.AssignedReviewers,
+			TeamName:          teamName,
+		}
+	}
+	return res, nil
+}
+
+func (s *PostgresStore) CreateUser(ctx context.Context, id, username string, isActive bool) error {
 	return s.q.CreateUser(ctx, queries.CreateUserParams{
 		ID:       id,
 		Username: username,
-		TeamName: teamName,
 		IsActive: isActive,
 	})
 }
 
 func (s *PostgresStore) GetUser(ctx context.Context, id string) (*model.User, error) {
 	u, err := s.q.GetUser(ctx, id)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	tags, err := s.userTags(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+	teams, primary, err := s.userTeams(ctx, []string{id})
 	if err != nil {
 		return nil, err
 	}
 	return &model.User{
-		ID:       u.ID,
-		Username: u.Username,
-		TeamName: u.TeamName,
-		IsActive: u.IsActive,
+		ID:           u.ID,
+		Username:     u.Username,
+		TeamName:     primary[id],
+		Teams:        teams[id],
+		IsActive:     u.IsActive,
+		Email:        u.Email.String,
+		EmailOptOut:  u.EmailOptOut,
+		WorkingHours: toWorkingHours(u.Timezone, u.WorkStart, u.WorkEnd, u.WorkDays),
+		Tags:         tags[id],
+		Seniority:    model.Seniority(u.Seniority),
 	}, nil
 }
 
+func (s *PostgresStore) GetUsers(ctx context.Context, ids []string) ([]model.User, error) {
+	users, err := s.q.GetUsers(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := s.userTags(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	teams, primary, err := s.userTeams(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]model.User, len(users))
+	for i, u := range users {
+		res[i] = model.User{
+			ID:           u.ID,
+			Username:     u.Username,
+			TeamName:     primary[u.ID],
+			Teams:        teams[u.ID],
+			IsActive:     u.IsActive,
+			Email:        u.Email.String,
+			EmailOptOut:  u.EmailOptOut,
+			WorkingHours: toWorkingHours(u.Timezone, u.WorkStart, u.WorkEnd, u.WorkDays),
+			Tags:         tags[u.ID],
+			Seniority:    model.Seniority(u.Seniority),
+		}
+	}
+	return res, nil
+}
+
 func (s *PostgresStore) GetActiveUsersInTeamExcluding(ctx context.Context, teamName, excludeUserID string) ([]string, error) {
 	return s.q.GetActiveUsersInTeamExcluding(ctx, queries.GetActiveUsersInTeamExcludingParams{
 		TeamName: teamName,
@@ -83,20 +360,38 @@ func (s *PostgresStore) GetActiveUsersInTeamExcluding(ctx context.Context, teamN
 	})
 }
 
-func (s *PostgresStore) CreatePR(ctx context.Context, id, name, authorID string, reviewers []string) error {
+func (s *PostgresStore) CreatePR(ctx context.Context, pr *model.PullRequest) error {
+	labels := pr.Labels
+	if labels == nil {
+		labels = []string{}
+	}
+	var seed pgtype.Int8
+	if pr.AssignmentSeed != nil {
+		seed = pgtype.Int8{Int64: *pr.AssignmentSeed, Valid: true}
+	}
 	return s.q.CreatePR(ctx, queries.CreatePRParams{
-		ID:                id,
-		Name:              name,
-		AuthorID:          authorID,
-		AssignedReviewers: reviewers,
+		ID:                pr.ID,
+		Name:              pr.Name,
+		AuthorID:          pr.AuthorID,
+		AssignedReviewers: pr.AssignedReviewers,
+		Labels:            labels,
+		RepositoryID:      pgtype.Text{String: pr.RepositoryID, Valid: pr.RepositoryID != ""},
+		AssignmentSeed:    seed,
+		TeamName:          pgtype.Text{String: pr.TeamName, Valid: pr.TeamName != ""},
+		CreatedAt:         pgtype.Timestamptz{Time: pr.CreatedAt, Valid: !pr.CreatedAt.IsZero()},
 	})
 }
 
 func (s *PostgresStore) GetPR(ctx context.Context, id string) (*model.PullRequest, error) {
 	pr, err := s.q.GetPR(ctx, id)
 	if err != nil {
-		return nil, err
+		return nil, notFound(err)
 	}
+	res := toPullRequest(pr)
+	return &res, nil
+}
+
+func toPullRequest(pr queries.PullRequest) model.PullRequest {
 	var createdAt time.Time
 	if pr.CreatedAt.Valid {
 		createdAt = pr.CreatedAt.Time
@@ -106,15 +401,23 @@ func (s *PostgresStore) GetPR(ctx context.Context, id string) (*model.PullReques
 		t := pr.MergedAt.Time
 		mergedAt = &t
 	}
-	return &model.PullRequest{
+	var seed *int64
+	if pr.AssignmentSeed.Valid {
+		seed = &pr.AssignmentSeed.Int64
+	}
+	return model.PullRequest{
 		ID:                pr.ID,
 		Name:              pr.Name,
 		AuthorID:          pr.AuthorID,
 		Status:            model.Status(pr.Status),
 		AssignedReviewers: pr.AssignedReviewers,
+		Labels:            pr.Labels,
+		RepositoryID:      pr.RepositoryID.String,
+		TeamName:          pr.TeamName.String,
+		AssignmentSeed:    seed,
 		CreatedAt:         createdAt,
 		MergedAt:          mergedAt,
-	}, nil
+	}
 }
 
 func (s *PostgresStore) MergePR(ctx context.Context, id string) error {
@@ -133,7 +436,7 @@ func (s *PostgresStore) GetPRsByReviewer(ctx context.Context, reviewerID string)
 	if err != nil {
 		return nil, err
 	}
-	var res []model.PullRequest
+	res := make([]model.PullRequest, 0, len(rows))
 	for _, r := range rows {
 		res = append(res, model.PullRequest{
 			ID:       r.ID,
@@ -145,8 +448,10 @@ func (s *PostgresStore) GetPRsByReviewer(ctx context.Context, reviewerID string)
 	return res, nil
 }
 
-func (s *PostgresStore) GetPRCountByReviewer(ctx context.Context) (map[string]int64, error) {
-	rows, err := s.q.GetPRCountByReviewer(ctx)
+// GetPRCountByReviewer counts open pull requests per reviewer, for those of
+// the team and its sub-teams only if teamName is set.
+func (s *PostgresStore) GetPRCountByReviewer(ctx context.Context, teamName string) (map[string]int64, error) {
+	rows, err := s.q.GetPRCountByReviewer(ctx, teamName)
 	if err != nil {
 		return nil, err
 	}
@@ -193,3 +498,760 @@ func (s *PostgresStore) SetUserActive(ctx context.Context, userID string, isActi
 		IsActive: isActive,
 	})
 }
+
+func (s *PostgresStore) SetUserSeniority(ctx context.Context, userID string, seniority model.Seniority) error {
+	return s.q.SetUserSeniority(ctx, queries.SetUserSeniorityParams{
+		ID:        userID,
+		Seniority: string(seniority),
+	})
+}
+
+func (s *PostgresStore) ReserveIdempotencyKey(ctx context.Context, key, path, requestHash string, expiresAt time.Time) (bool, error) {
+	n, err := s.q.ReserveIdempotencyKey(ctx, queries.ReserveIdempotencyKeyParams{
+		Key:         key,
+		Path:        path,
+		RequestHash: requestHash,
+		ExpiresAt:   pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	})
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (s *PostgresStore) GetIdempotencyKey(ctx context.Context, key, path string) (*model.IdempotencyRecord, error) {
+	k, err := s.q.GetIdempotencyKey(ctx, queries.GetIdempotencyKeyParams{Key: key, Path: path})
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &model.IdempotencyRecord{
+		Key:          k.Key,
+		Path:         k.Path,
+		RequestHash:  k.RequestHash,
+		StatusCode:   int(k.StatusCode.Int32),
+		ResponseBody: k.ResponseBody,
+		Completed:    k.Completed,
+		ExpiresAt:    k.ExpiresAt.Time,
+	}, nil
+}
+
+func (s *PostgresStore) CompleteIdempotencyKey(ctx context.Context, key, path string, statusCode int, body []byte) error {
+	return s.q.CompleteIdempotencyKey(ctx, queries.CompleteIdempotencyKeyParams{
+		Key:          key,
+		Path:         path,
+		StatusCode:   pgtype.Int4{Int32: int32(statusCode), Valid: true},
+		ResponseBody: body,
+	})
+}
+
+func (s *PostgresStore) DeleteIdempotencyKey(ctx context.Context, key, path string) error {
+	return s.q.DeleteIdempotencyKey(ctx, queries.DeleteIdempotencyKeyParams{Key: key, Path: path})
+}
+
+func (s *PostgresStore) DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error) {
+	return s.q.DeleteExpiredIdempotencyKeys(ctx)
+}
+
+func (s *PostgresStore) TakeRateLimitToken(ctx context.Context, key string, rate, burst float64) (float64, bool, error) {
+	row, err := s.q.TakeRateLimitToken(ctx, queries.TakeRateLimitTokenParams{
+		Key:   key,
+		Burst: burst,
+		Rate:  rate,
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	return row.Tokens, row.Allowed, nil
+}
+
+func (s *PostgresStore) DeleteStaleRateLimitBuckets(ctx context.Context, before time.Time) (int64, error) {
+	return s.q.DeleteStaleRateLimitBuckets(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+}
+
+const eventsChannel = "review_events"
+
+func toModelEvent(e queries.Event) model.Event {
+	return model.Event{
+		ID:            e.ID,
+		Type:          model.EventType(e.Type),
+		PullRequestID: e.PullRequestID,
+		TeamName:      e.TeamName,
+		UserIDs:       e.UserIds,
+		Payload:       e.Payload,
+		CreatedAt:     e.CreatedAt.Time,
+	}
+}
+
+func (s *PostgresStore) CreateEvent(ctx context.Context, e *model.Event) error {
+	row, err := s.q.CreateEvent(ctx, queries.CreateEventParams{
+		Type:          string(e.Type),
+		PullRequestID: e.PullRequestID,
+		TeamName:      e.TeamName,
+		UserIds:       e.UserIDs,
+		Payload:       e.Payload,
+	})
+	if err != nil {
+		return err
+	}
+	e.ID = row.ID
+	e.CreatedAt = row.CreatedAt.Time
+	return nil
+}
+
+func (s *PostgresStore) GetEvent(ctx context.Context, id int64) (*model.Event, error) {
+	e, err := s.q.GetEvent(ctx, id)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	res := toModelEvent(e)
+	return &res, nil
+}
+
+func (s *PostgresStore) ListEventsForUser(ctx context.Context, userID string, afterID int64, limit int) ([]model.Event, error) {
+	rows, err := s.q.ListEventsForUser(ctx, queries.ListEventsForUserParams{
+		AfterID:   afterID,
+		UserID:    userID,
+		MaxEvents: int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]model.Event, len(rows))
+	for i, e := range rows {
+		res[i] = toModelEvent(e)
+	}
+	return res, nil
+}
+
+func (s *PostgresStore) ListEventsForTeam(ctx context.Context, teamName string, afterID int64, limit int) ([]model.Event, error) {
+	rows, err := s.q.ListEventsForTeam(ctx, queries.ListEventsForTeamParams{
+		AfterID:   afterID,
+		TeamName:  teamName,
+		MaxEvents: int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]model.Event, len(rows))
+	for i, e := range rows {
+		res[i] = toModelEvent(e)
+	}
+	return res, nil
+}
+
+func (s *PostgresStore) DeleteEventsBefore(ctx context.Context, before time.Time) (int64, error) {
+	return s.q.DeleteEventsBefore(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+}
+
+// ListenEvents holds a dedicated connection subscribed to event notifications
+// and calls handle with the id of every inserted event until ctx is done or the
+// connection fails.
+func (s *PostgresStore) ListenEvents(ctx context.Context, handle func(id int64)) error {
+	conn, err := s.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// The connection goes back to the pool, so it must stop listening.
+		conn.Exec(context.Background(), "UNLISTEN "+eventsChannel)
+		conn.Release()
+	}()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+eventsChannel); err != nil {
+		return err
+	}
+	for {
+		n, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		id, err := strconv.ParseInt(n.Payload, 10, 64)
+		if err != nil {
+			continue
+		}
+		handle(id)
+	}
+}
+
+func (s *PostgresStore) GetSlackUserID(ctx context.Context, userID string) (string, error) {
+	id, err := s.q.GetSlackUserID(ctx, userID)
+	if err != nil {
+		return "", notFound(err)
+	}
+	return id, nil
+}
+
+func (s *PostgresStore) SetSlackUserID(ctx context.Context, userID, slackUserID string) error {
+	return s.q.SetSlackUserID(ctx, queries.SetSlackUserIDParams{UserID: userID, SlackUserID: slackUserID})
+}
+
+func (s *PostgresStore) SetUserEmail(ctx context.Context, userID, email string, optOut bool) error {
+	return s.q.SetUserEmail(ctx, queries.SetUserEmailParams{
+		ID:          userID,
+		Email:       pgtype.Text{String: email, Valid: email != ""},
+		EmailOptOut: optOut,
+	})
+}
+
+func (s *PostgresStore) ListDigestRecipients(ctx context.Context) ([]model.DigestEntry, error) {
+	rows, err := s.q.ListDigestRecipients(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]model.DigestEntry, len(rows))
+	for i, r := range rows {
+		res[i] = model.DigestEntry{
+			UserID:          r.ID,
+			Username:        r.Username,
+			Email:           r.Email,
+			OpenReviews:     int(r.OpenReviews),
+			OldestCreatedAt: r.OldestCreatedAt.Time,
+			WorkingHours:    toWorkingHours(r.Timezone, r.WorkStart, r.WorkEnd, r.WorkDays),
+		}
+		if r.SentOn.Valid {
+			res[i].LastSentOn = r.SentOn.Time
+		}
+	}
+	return res, nil
+}
+
+func (s *PostgresStore) ClaimEmailDigest(ctx context.Context, userID string, day time.Time) (bool, error) {
+	n, err := s.q.ClaimEmailDigest(ctx, queries.ClaimEmailDigestParams{
+		UserID: userID,
+		Today:  pgtype.Date{Time: day, Valid: true},
+	})
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (s *PostgresStore) ReleaseEmailDigest(ctx context.Context, userID string, day time.Time) error {
+	return s.q.ReleaseEmailDigest(ctx, queries.ReleaseEmailDigestParams{
+		UserID: userID,
+		SentOn: pgtype.Date{Time: day, Valid: true},
+	})
+}
+
+func (s *PostgresStore) SetTeamSLA(ctx context.Context, sla model.TeamSLA) error {
+	return s.q.UpsertTeamSLA(ctx, queries.UpsertTeamSLAParams{
+		TeamName:           sla.TeamName,
+		FirstResponseHours: int32(sla.FirstResponseHours),
+		AutoReassign:       sla.AutoReassign,
+	})
+}
+
+func (s *PostgresStore) GetTeamSLA(ctx context.Context, teamName string) (*model.TeamSLA, error) {
+	sla, err := s.q.GetTeamSLA(ctx, teamName)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &model.TeamSLA{
+		TeamName:           teamName,
+		FirstResponseHours: int(sla.FirstResponseHours),
+		AutoReassign:       sla.AutoReassign,
+		InheritedFrom:      inheritedFrom(teamName, sla.TeamName),
+	}, nil
+}
+
+func (s *PostgresStore) AddReviewAssignments(ctx context.Context, prID string, reviewerIDs []string) error {
+	return s.q.AddReviewAssignments(ctx, queries.AddReviewAssignmentsParams{
+		PullRequestID: prID,
+		ReviewerIds:   reviewerIDs,
+	})
+}
+
+func (s *PostgresStore) DeleteReviewAssignment(ctx context.Context, prID, reviewerID string) error {
+	return s.q.DeleteReviewAssignment(ctx, queries.DeleteReviewAssignmentParams{
+		PullRequestID: prID,
+		ReviewerID:    reviewerID,
+	})
+}
+
+func (s *PostgresStore) RespondToReview(ctx context.Context, prID, reviewerID string) (bool, error) {
+	n, err := s.q.RespondToReview(ctx, queries.RespondToReviewParams{
+		PullRequestID: prID,
+		ReviewerID:    reviewerID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (s *PostgresStore) EscalateReview(ctx context.Context, prID, reviewerID string) (bool, error) {
+	n, err := s.q.EscalateReview(ctx, queries.EscalateReviewParams{
+		PullRequestID: prID,
+		ReviewerID:    reviewerID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (s *PostgresStore) ClaimStaleReview(ctx context.Context, prID, reviewerID string, assignedAt time.Time) (bool, error) {
+	n, err := s.q.ClaimStaleReview(ctx, queries.ClaimStaleReviewParams{
+		PullRequestID: prID,
+		ReviewerID:    reviewerID,
+		AssignedAt:    pgtype.Timestamptz{Time: assignedAt, Valid: true},
+	})
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (s *PostgresStore) ListPendingReviews(ctx context.Context, teamName string) ([]model.PendingReview, error) {
+	rows, err := s.q.ListPendingReviews(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]model.PendingReview, len(rows))
+	for i, r := range rows {
+		res[i] = model.PendingReview{
+			PullRequestID:   r.PullRequestID,
+			PullRequestName: r.PullRequestName,
+			ReviewerID:      r.ReviewerID,
+			AssignedAt:      r.AssignedAt.Time,
+			SLA: model.TeamSLA{
+				TeamName:           r.TeamName,
+				FirstResponseHours: int(r.FirstResponseHours),
+				AutoReassign:       r.AutoReassign,
+			},
+		}
+		if r.EscalatedAt.Valid {
+			escalatedAt := r.EscalatedAt.Time
+			res[i].EscalatedAt = &escalatedAt
+		}
+	}
+	return res, nil
+}
+
+func (s *PostgresStore) SetStalePolicy(ctx context.Context, p model.StalePolicy) error {
+	return s.q.UpsertStalePolicy(ctx, queries.UpsertStalePolicyParams{
+		TeamName:         p.TeamName,
+		StaleAfterHours:  int32(p.StaleAfterHours),
+		MaxReassignments: int32(p.MaxReassignments),
+		DryRun:           p.DryRun,
+	})
+}
+
+func (s *PostgresStore) GetStalePolicy(ctx context.Context, teamName string) (*model.StalePolicy, error) {
+	p, err := s.q.GetStalePolicy(ctx, teamName)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &model.StalePolicy{
+		TeamName:         teamName,
+		StaleAfterHours:  int(p.StaleAfterHours),
+		MaxReassignments: int(p.MaxReassignments),
+		DryRun:           p.DryRun,
+		InheritedFrom:    inheritedFrom(teamName, p.TeamName),
+	}, nil
+}
+
+func (s *PostgresStore) ListStaleCandidates(ctx context.Context, teamName string) ([]model.StaleCandidate, error) {
+	rows, err := s.q.ListStaleCandidates(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]model.StaleCandidate, len(rows))
+	for i, r := range rows {
+		res[i] = model.StaleCandidate{
+			PullRequestID: r.PullRequestID,
+			ReviewerID:    r.ReviewerID,
+			AssignedAt:    r.AssignedAt.Time,
+			Policy: model.StalePolicy{
+				TeamName:         r.TeamName,
+				StaleAfterHours:  int(r.StaleAfterHours),
+				MaxReassignments: int(r.MaxReassignments),
+				DryRun:           r.DryRun,
+			},
+			Reassignments: int(r.StaleReassignments),
+		}
+	}
+	return res, nil
+}
+
+func (s *PostgresStore) CreateReassignment(ctx context.Context, r *model.Reassignment) error {
+	return s.q.CreateReassignment(ctx, queries.CreateReassignmentParams{
+		PullRequestID: r.PullRequestID,
+		OldReviewerID: r.OldReviewerID,
+		NewReviewerID: r.NewReviewerID,
+		Reason:        string(r.Reason),
+	})
+}
+
+func (s *PostgresStore) ListReassignments(ctx context.Context, prID string) ([]model.Reassignment, error) {
+	rows, err := s.q.ListReassignments(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]model.Reassignment, len(rows))
+	for i, r := range rows {
+		res[i] = toReassignment(r)
+	}
+	return res, nil
+}
+
+func toReassignment(r queries.Reassignment) model.Reassignment {
+	return model.Reassignment{
+		ID:            r.ID,
+		PullRequestID: r.PullRequestID,
+		OldReviewerID: r.OldReviewerID,
+		NewReviewerID: r.NewReviewerID,
+		Reason:        model.ReassignReason(r.Reason),
+		CreatedAt:     r.CreatedAt.Time,
+	}
+}
+
+// GetHistory returns all users, and the pull requests created since since
+// along with their reassignments, oldest first.
+func (s *PostgresStore) GetHistory(ctx context.Context, since time.Time) (*model.History, error) {
+	users, err := s.q.ListUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(users))
+	for i, u := range users {
+		ids[i] = u.ID
+	}
+	tags, err := s.userTags(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	teams, primary, err := s.userTeams(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	ts := pgtype.Timestamptz{Time: since, Valid: true}
+	prs, err := s.q.ListPullRequestsSince(ctx, ts)
+	if err != nil {
+		return nil, err
+	}
+	reassignments, err := s.q.ListReassignmentsSince(ctx, ts)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &model.History{
+		Users:         make([]model.User, len(users)),
+		PullRequests:  make([]model.PullRequest, len(prs)),
+		Reassignments: make([]model.Reassignment, len(reassignments)),
+	}
+	for i, u := range users {
+		h.Users[i] = model.User{
+			ID:        u.ID,
+			Username:  u.Username,
+			TeamName:  primary[u.ID],
+			Teams:     teams[u.ID],
+			IsActive:  u.IsActive,
+			Tags:      tags[u.ID],
+			Seniority: model.Seniority(u.Seniority),
+		}
+	}
+	for i, pr := range prs {
+		h.PullRequests[i] = toPullRequest(pr)
+	}
+	for i, r := range reassignments {
+		h.Reassignments[i] = toReassignment(r)
+	}
+	return h, nil
+}
+
+// toWorkingHours returns nil for rows without their own working hours.
+func toWorkingHours(tz, start, end pgtype.Text, days []string) *model.WorkingHours {
+	if !tz.Valid {
+		return nil
+	}
+	return &model.WorkingHours{Timezone: tz.String, Start: start.String, End: end.String, Days: days}
+}
+
+func workingHoursParams(wh *model.WorkingHours) (tz, start, end pgtype.Text, days []string) {
+	if wh == nil {
+		return
+	}
+	return pgtype.Text{String: wh.Timezone, Valid: true},
+		pgtype.Text{String: wh.Start, Valid: true},
+		pgtype.Text{String: wh.End, Valid: true},
+		wh.Days
+}
+
+func (s *PostgresStore) SetUserWorkingHours(ctx context.Context, userID string, wh *model.WorkingHours) error {
+	tz, start, end, days := workingHoursParams(wh)
+	return s.q.SetUserWorkingHours(ctx, queries.SetUserWorkingHoursParams{
+		ID:        userID,
+		Timezone:  tz,
+		WorkStart: start,
+		WorkEnd:   end,
+		WorkDays:  days,
+	})
+}
+
+func (s *PostgresStore) SetTeamWorkingHours(ctx context.Context, teamName string, wh *model.WorkingHours) error {
+	tz, start, end, days := workingHoursParams(wh)
+	return s.q.SetTeamWorkingHours(ctx, queries.SetTeamWorkingHoursParams{
+		Name:      teamName,
+		Timezone:  tz,
+		WorkStart: start,
+		WorkEnd:   end,
+		WorkDays:  days,
+	})
+}
+
+func (s *PostgresStore) GetTeamWorkingHours(ctx context.Context, teamName string) (*model.WorkingHours, error) {
+	r, err := s.q.GetTeamWorkingHours(ctx, teamName)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return toWorkingHours(r.Timezone, r.WorkStart, r.WorkEnd, r.WorkDays), nil
+}
+
+func (s *PostgresStore) SetCodeowners(ctx context.Context, c *model.Codeowners) error {
+	return s.q.UpsertCodeowners(ctx, queries.UpsertCodeownersParams{
+		TeamName:     c.TeamName,
+		RepositoryID: c.RepositoryID,
+		Content:      c.Content,
+	})
+}
+
+func (s *PostgresStore) GetCodeowners(ctx context.Context, teamName, repositoryID string) (*model.Codeowners, error) {
+	r, err := s.q.GetCodeowners(ctx, queries.GetCodeownersParams{TeamName: teamName, RepositoryID: repositoryID})
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &model.Codeowners{
+		TeamName:     r.TeamName,
+		RepositoryID: r.RepositoryID,
+		Content:      r.Content,
+		UpdatedAt:    r.UpdatedAt.Time,
+	}, nil
+}
+
+// ListActiveOwners resolves CODEOWNERS handles to active users. A user id
+// matches anywhere, but a username or email only among the members of
+// teamName and of the owning teams, as neither is unique across the org.
+func (s *PostgresStore) ListActiveOwners(ctx context.Context, teamName string, handles, teams []string) ([]string, error) {
+	return s.q.ListActiveOwners(ctx, queries.ListActiveOwnersParams{Handles: handles, TeamName: teamName, Teams: teams})
+}
+
+// userTags returns the tags of each of the users.
+func (s *PostgresStore) userTags(ctx context.Context, userIDs []string) (map[string][]string, error) {
+	rows, err := s.q.ListUserTags(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string][]string)
+	for _, r := range rows {
+		tags[r.UserID] = append(tags[r.UserID], r.Tag)
+	}
+	return tags, nil
+}
+
+func (s *PostgresStore) AddUserTags(ctx context.Context, userID string, tags []string) error {
+	return s.q.AddUserTags(ctx, queries.AddUserTagsParams{UserID: userID, Tags: tags})
+}
+
+func (s *PostgresStore) RemoveUserTags(ctx context.Context, userID string, tags []string) error {
+	return s.q.RemoveUserTags(ctx, queries.RemoveUserTagsParams{UserID: userID, Tags: tags})
+}
+
+// SetUserTags replaces the user's tags. Adding before removing keeps the
+// user's tags intact if the second statement fails.
+func (s *PostgresStore) SetUserTags(ctx context.Context, userID string, tags []string) error {
+	if err := s.q.AddUserTags(ctx, queries.AddUserTagsParams{UserID: userID, Tags: tags}); err != nil {
+		return err
+	}
+	return s.q.RemoveUserTagsExcept(ctx, queries.RemoveUserTagsExceptParams{UserID: userID, Tags: tags})
+}
+
+func (s *PostgresStore) ListTags(ctx context.Context, teamName string) ([]model.TagCount, error) {
+	rows, err := s.q.ListTags(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]model.TagCount, len(rows))
+	for i, r := range rows {
+		res[i] = model.TagCount{Tag: r.Tag, Users: int(r.Users)}
+	}
+	return res, nil
+}
+
+func (s *PostgresStore) GetCandidates(ctx context.Context, userIDs []string) ([]model.Candidate, error) {
+	tags, err := s.userTags(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	teams, primary, err := s.userTeams(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := s.q.CountOpenReviews(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	load := make(map[string]int, len(counts))
+	for _, c := range counts {
+		load[c.ReviewerID] = int(c.OpenReviews)
+	}
+	users, err := s.q.ListCandidateUsers(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]queries.ListCandidateUsersRow, len(users))
+	for _, u := range users {
+		byID[u.ID] = u
+	}
+	res := make([]model.Candidate, len(userIDs))
+	for i, id := range userIDs {
+		res[i] = model.Candidate{
+			UserID:      id,
+			TeamName:    primary[id],
+			Teams:       teams[id],
+			Tags:        tags[id],
+			OpenReviews: load[id],
+			Seniority:   model.Seniority(byID[id].Seniority),
+		}
+	}
+	return res, nil
+}
+
+// GetPairings returns when each of userIDs was assigned to review authorID's
+// pull requests since since, oldest first.
+func (s *PostgresStore) GetPairings(ctx context.Context, authorID string, userIDs []string, since time.Time) (map[string][]time.Time, error) {
+	rows, err := s.q.ListPairings(ctx, queries.ListPairingsParams{
+		AuthorID: authorID,
+		UserIds:  userIDs,
+		Since:    pgtype.Timestamptz{Time: since, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string][]time.Time)
+	for _, r := range rows {
+		res[r.ReviewerID] = append(res[r.ReviewerID], r.AssignedAt.Time)
+	}
+	return res, nil
+}
+
+func (s *PostgresStore) GetSeniorities(ctx context.Context, userIDs []string) (map[string]model.Seniority, error) {
+	rows, err := s.q.ListCandidateUsers(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]model.Seniority, len(rows))
+	for _, r := range rows {
+		res[r.ID] = model.Seniority(r.Seniority)
+	}
+	return res, nil
+}
+
+func (s *PostgresStore) SetTeamRules(ctx context.Context, r *model.TeamRules) error {
+	return s.q.UpsertTeamRules(ctx, queries.UpsertTeamRulesParams{TeamName: r.TeamName, Source: r.Source})
+}
+
+func (s *PostgresStore) GetTeamRules(ctx context.Context, teamName string) (*model.TeamRules, error) {
+	r, err := s.q.GetTeamRules(ctx, teamName)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return &model.TeamRules{
+		TeamName:      teamName,
+		Source:        r.Source,
+		UpdatedAt:     r.UpdatedAt.Time,
+		InheritedFrom: inheritedFrom(teamName, r.TeamName),
+	}, nil
+}
+
+// inheritedFrom returns the team a setting was found on, unless it is the
+// team itself.
+func inheritedFrom(teamName, owner string) string {
+	if owner == teamName {
+		return ""
+	}
+	return owner
+}
+
+func toRepository(r queries.Repository) model.Repository {
+	res := model.Repository{
+		ID:            r.ID,
+		Name:          r.Name,
+		TeamName:      r.TeamName,
+		Strategy:      model.AssignmentStrategy(r.Strategy.String),
+		RequireOwner:  r.RequireOwner,
+		ExcludedUsers: r.ExcludedUsers,
+		CreatedAt:     r.CreatedAt.Time,
+	}
+	if r.ReviewerCount.Valid {
+		n := int(r.ReviewerCount.Int32)
+		res.ReviewerCount = &n
+	}
+	return res
+}
+
+func repositoryPolicy(r *model.Repository) (count pgtype.Int4, strategy pgtype.Text, excluded []string) {
+	if r.ReviewerCount != nil {
+		count = pgtype.Int4{Int32: int32(*r.ReviewerCount), Valid: true}
+	}
+	strategy = pgtype.Text{String: string(r.Strategy), Valid: r.Strategy != ""}
+	excluded = r.ExcludedUsers
+	if excluded == nil {
+		excluded = []string{}
+	}
+	return
+}
+
+func (s *PostgresStore) CreateRepository(ctx context.Context, r *model.Repository) (bool, error) {
+	count, strategy, excluded := repositoryPolicy(r)
+	n, err := s.q.CreateRepository(ctx, queries.CreateRepositoryParams{
+		ID:            r.ID,
+		Name:          r.Name,
+		TeamName:      r.TeamName,
+		ReviewerCount: count,
+		Strategy:      strategy,
+		RequireOwner:  r.RequireOwner,
+		ExcludedUsers: excluded,
+	})
+	return n > 0, err
+}
+
+func (s *PostgresStore) GetRepository(ctx context.Context, id string) (*model.Repository, error) {
+	r, err := s.q.GetRepository(ctx, id)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	res := toRepository(r)
+	return &res, nil
+}
+
+func (s *PostgresStore) ListRepositories(ctx context.Context, teamName string) ([]model.Repository, error) {
+	rows, err := s.q.ListRepositories(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]model.Repository, len(rows))
+	for i, r := range rows {
+		res[i] = toRepository(r)
+	}
+	return res, nil
+}
+
+func (s *PostgresStore) UpdateRepository(ctx context.Context, r *model.Repository) (bool, error) {
+	count, strategy, excluded := repositoryPolicy(r)
+	n, err := s.q.UpdateRepository(ctx, queries.UpdateRepositoryParams{
+		ID:            r.ID,
+		Name:          r.Name,
+		TeamName:      r.TeamName,
+		ReviewerCount: count,
+		Strategy:      strategy,
+		RequireOwner:  r.RequireOwner,
+		ExcludedUsers: excluded,
+	})
+	return n > 0, err
+}
+
+func (s *PostgresStore) DeleteRepository(ctx context.Context, id string) (bool, error) {
+	n, err := s.q.DeleteRepository(ctx, id)
+	return n > 0, err
+}
@@ -8,6 +8,46 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type Codeowner struct {
+	TeamName     string             `json:"team_name"`
+	RepositoryID string             `json:"repository_id"`
+	Content      string             `json:"content"`
+	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+}
+
+type EmailDigest struct {
+	UserID string      `json:"user_id"`
+	SentOn pgtype.Date `json:"sent_on"`
+}
+
+type Event struct {
+	ID            int64              `json:"id"`
+	Type          string             `json:"type"`
+	PullRequestID string             `json:"pull_request_id"`
+	TeamName      string             `json:"team_name"`
+	UserIds       []string           `json:"user_ids"`
+	Payload       []byte             `json:"payload"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+}
+
+type IdempotencyKey struct {
+	Key          string             `json:"key"`
+	Path         string             `json:"path"`
+	RequestHash  string             `json:"request_hash"`
+	StatusCode   pgtype.Int4        `json:"status_code"`
+	ResponseBody []byte             `json:"response_body"`
+	Completed    bool               `json:"completed"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	ExpiresAt    pgtype.Timestamptz `json:"expires_at"`
+}
+
+type Pairing struct {
+	PullRequestID string             `json:"pull_request_id"`
+	AuthorID      string             `json:"author_id"`
+	ReviewerID    string             `json:"reviewer_id"`
+	AssignedAt    pgtype.Timestamptz `json:"assigned_at"`
+}
+
 type PullRequest struct {
 	ID                string             `json:"id"`
 	Name              string             `json:"name"`
@@ -16,15 +56,109 @@ type PullRequest struct {
 	AssignedReviewers []string           `json:"assigned_reviewers"`
 	CreatedAt         pgtype.Timestamptz `json:"created_at"`
 	MergedAt          pgtype.Timestamptz `json:"merged_at"`
+	Labels            []string           `json:"labels"`
+	RepositoryID      pgtype.Text        `json:"repository_id"`
+	AssignmentSeed    pgtype.Int8        `json:"assignment_seed"`
+	TeamName          pgtype.Text        `json:"team_name"`
+}
+
+type RateLimitBucket struct {
+	Key       string             `json:"key"`
+	Tokens    float64            `json:"tokens"`
+	Allowed   bool               `json:"allowed"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+type Reassignment struct {
+	ID            int64              `json:"id"`
+	PullRequestID string             `json:"pull_request_id"`
+	OldReviewerID string             `json:"old_reviewer_id"`
+	NewReviewerID string             `json:"new_reviewer_id"`
+	Reason        string             `json:"reason"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+}
+
+type Repository struct {
+	ID            string             `json:"id"`
+	Name          string             `json:"name"`
+	TeamName      string             `json:"team_name"`
+	ReviewerCount pgtype.Int4        `json:"reviewer_count"`
+	Strategy      pgtype.Text        `json:"strategy"`
+	RequireOwner  bool               `json:"require_owner"`
+	ExcludedUsers []string           `json:"excluded_users"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+}
+
+type ReviewAssignment struct {
+	PullRequestID string             `json:"pull_request_id"`
+	ReviewerID    string             `json:"reviewer_id"`
+	AssignedAt    pgtype.Timestamptz `json:"assigned_at"`
+	RespondedAt   pgtype.Timestamptz `json:"responded_at"`
+	EscalatedAt   pgtype.Timestamptz `json:"escalated_at"`
+}
+
+type SlackUser struct {
+	UserID      string `json:"user_id"`
+	SlackUserID string `json:"slack_user_id"`
+}
+
+type StalePolicy struct {
+	TeamName         string `json:"team_name"`
+	StaleAfterHours  int32  `json:"stale_after_hours"`
+	MaxReassignments int32  `json:"max_reassignments"`
+	DryRun           bool   `json:"dry_run"`
 }
 
 type Team struct {
-	Name string `json:"name"`
+	Name           string      `json:"name"`
+	Timezone       pgtype.Text `json:"timezone"`
+	WorkStart      pgtype.Text `json:"work_start"`
+	WorkEnd        pgtype.Text `json:"work_end"`
+	WorkDays       []string    `json:"work_days"`
+	ParentName     pgtype.Text `json:"parent_name"`
+	LeadAssignment string      `json:"lead_assignment"`
+}
+
+type TeamAncestor struct {
+	TeamName     string `json:"team_name"`
+	AncestorName string `json:"ancestor_name"`
+	Depth        int32  `json:"depth"`
+}
+
+type TeamMembership struct {
+	UserID    string             `json:"user_id"`
+	TeamName  string             `json:"team_name"`
+	IsPrimary bool               `json:"is_primary"`
+	JoinedAt  pgtype.Timestamptz `json:"joined_at"`
+	Role      string             `json:"role"`
+}
+
+type TeamRule struct {
+	TeamName  string             `json:"team_name"`
+	Source    string             `json:"source"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+type TeamSla struct {
+	TeamName           string `json:"team_name"`
+	FirstResponseHours int32  `json:"first_response_hours"`
+	AutoReassign       bool   `json:"auto_reassign"`
 }
 
 type User struct {
-	ID       string `json:"id"`
-	Username string `json:"username"`
-	TeamName string `json:"team_name"`
-	IsActive bool   `json:"is_active"`
+	ID          string      `json:"id"`
+	Username    string      `json:"username"`
+	IsActive    bool        `json:"is_active"`
+	Email       pgtype.Text `json:"email"`
+	EmailOptOut bool        `json:"email_opt_out"`
+	Timezone    pgtype.Text `json:"timezone"`
+	WorkStart   pgtype.Text `json:"work_start"`
+	WorkEnd     pgtype.Text `json:"work_end"`
+	WorkDays    []string    `json:"work_days"`
+	Seniority   string      `json:"seniority"`
+}
+
+type UserTag struct {
+	UserID string `json:"user_id"`
+	Tag    string `json:"tag"`
 }
@@ -7,18 +7,215 @@ package queries
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const addReviewAssignments = `-- name: AddReviewAssignments :exec
+INSERT INTO review_assignments (pull_request_id, reviewer_id)
+SELECT $1::text, unnest($2::text[])
+ON CONFLICT DO NOTHING
+`
+
+type AddReviewAssignmentsParams struct {
+	PullRequestID string   `json:"pull_request_id"`
+	ReviewerIds   []string `json:"reviewer_ids"`
+}
+
+func (q *Queries) AddReviewAssignments(ctx context.Context, arg AddReviewAssignmentsParams) error {
+	_, err := q.db.Exec(ctx, addReviewAssignments, arg.PullRequestID, arg.ReviewerIds)
+	return err
+}
+
+const addTeamMembership = `-- name: AddTeamMembership :exec
+INSERT INTO team_memberships (user_id, team_name, is_primary)
+VALUES ($1, $2, NOT EXISTS (
+    SELECT 1 FROM team_memberships WHERE user_id = $1 AND is_primary
+))
+ON CONFLICT (user_id, team_name) DO NOTHING
+`
+
+type AddTeamMembershipParams struct {
+	UserID   string `json:"user_id"`
+	TeamName string `json:"team_name"`
+}
+
+func (q *Queries) AddTeamMembership(ctx context.Context, arg AddTeamMembershipParams) error {
+	_, err := q.db.Exec(ctx, addTeamMembership, arg.UserID, arg.TeamName)
+	return err
+}
+
+const addUserTags = `-- name: AddUserTags :exec
+INSERT INTO user_tags (user_id, tag)
+SELECT $1::text, unnest($2::text[])
+ON CONFLICT DO NOTHING
+`
+
+type AddUserTagsParams struct {
+	UserID string   `json:"user_id"`
+	Tags   []string `json:"tags"`
+}
+
+func (q *Queries) AddUserTags(ctx context.Context, arg AddUserTagsParams) error {
+	_, err := q.db.Exec(ctx, addUserTags, arg.UserID, arg.Tags)
+	return err
+}
+
+const claimEmailDigest = `-- name: ClaimEmailDigest :execrows
+INSERT INTO email_digests (user_id, sent_on) VALUES ($1, $2::date)
+ON CONFLICT (user_id) DO UPDATE SET sent_on = EXCLUDED.sent_on
+WHERE email_digests.sent_on < EXCLUDED.sent_on
+`
+
+type ClaimEmailDigestParams struct {
+	UserID string      `json:"user_id"`
+	Today  pgtype.Date `json:"today"`
+}
+
+func (q *Queries) ClaimEmailDigest(ctx context.Context, arg ClaimEmailDigestParams) (int64, error) {
+	result, err := q.db.Exec(ctx, claimEmailDigest, arg.UserID, arg.Today)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const claimStaleReview = `-- name: ClaimStaleReview :execrows
+UPDATE review_assignments SET assigned_at = assigned_at
+WHERE pull_request_id = $1 AND reviewer_id = $2 AND assigned_at = $3 AND responded_at IS NULL
+`
+
+type ClaimStaleReviewParams struct {
+	PullRequestID string             `json:"pull_request_id"`
+	ReviewerID    string             `json:"reviewer_id"`
+	AssignedAt    pgtype.Timestamptz `json:"assigned_at"`
+}
+
+func (q *Queries) ClaimStaleReview(ctx context.Context, arg ClaimStaleReviewParams) (int64, error) {
+	result, err := q.db.Exec(ctx, claimStaleReview, arg.PullRequestID, arg.ReviewerID, arg.AssignedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const clearPrimaryTeam = `-- name: ClearPrimaryTeam :exec
+UPDATE team_memberships SET is_primary = false
+WHERE user_id = $1 AND team_name <> $2 AND is_primary
+`
+
+type ClearPrimaryTeamParams struct {
+	UserID   string `json:"user_id"`
+	TeamName string `json:"team_name"`
+}
+
+func (q *Queries) ClearPrimaryTeam(ctx context.Context, arg ClearPrimaryTeamParams) error {
+	_, err := q.db.Exec(ctx, clearPrimaryTeam, arg.UserID, arg.TeamName)
+	return err
+}
+
+const completeIdempotencyKey = `-- name: CompleteIdempotencyKey :exec
+UPDATE idempotency_keys
+SET status_code = $3, response_body = $4, completed = true
+WHERE key = $1 AND path = $2
+`
+
+type CompleteIdempotencyKeyParams struct {
+	Key          string      `json:"key"`
+	Path         string      `json:"path"`
+	StatusCode   pgtype.Int4 `json:"status_code"`
+	ResponseBody []byte      `json:"response_body"`
+}
+
+func (q *Queries) CompleteIdempotencyKey(ctx context.Context, arg CompleteIdempotencyKeyParams) error {
+	_, err := q.db.Exec(ctx, completeIdempotencyKey,
+		arg.Key,
+		arg.Path,
+		arg.StatusCode,
+		arg.ResponseBody,
+	)
+	return err
+}
+
+const countOpenReviews = `-- name: CountOpenReviews :many
+SELECT r.reviewer_id::text AS reviewer_id, COUNT(*) AS open_reviews
+FROM pull_requests pr, unnest(pr.assigned_reviewers) AS r(reviewer_id)
+WHERE pr.status = 'OPEN' AND r.reviewer_id = ANY($1::text[])
+GROUP BY r.reviewer_id
+`
+
+type CountOpenReviewsRow struct {
+	ReviewerID  string `json:"reviewer_id"`
+	OpenReviews int64  `json:"open_reviews"`
+}
+
+func (q *Queries) CountOpenReviews(ctx context.Context, userIds []string) ([]CountOpenReviewsRow, error) {
+	rows, err := q.db.Query(ctx, countOpenReviews, userIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountOpenReviewsRow{}
+	for rows.Next() {
+		var i CountOpenReviewsRow
+		if err := rows.Scan(&i.ReviewerID, &i.OpenReviews); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createEvent = `-- name: CreateEvent :one
+INSERT INTO events (type, pull_request_id, team_name, user_ids, payload)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, created_at
+`
+
+type CreateEventParams struct {
+	Type          string   `json:"type"`
+	PullRequestID string   `json:"pull_request_id"`
+	TeamName      string   `json:"team_name"`
+	UserIds       []string `json:"user_ids"`
+	Payload       []byte   `json:"payload"`
+}
+
+type CreateEventRow struct {
+	ID        int64              `json:"id"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) (CreateEventRow, error) {
+	row := q.db.QueryRow(ctx, createEvent,
+		arg.Type,
+		arg.PullRequestID,
+		arg.TeamName,
+		arg.UserIds,
+		arg.Payload,
+	)
+	var i CreateEventRow
+	err := row.Scan(&i.ID, &i.CreatedAt)
+	return i, err
+}
+
 const createPR = `-- name: CreatePR :exec
-INSERT INTO pull_requests (id, name, author_id, status, assigned_reviewers)
-VALUES ($1, $2, $3, 'OPEN', $4)
+INSERT INTO pull_requests (id, name, author_id, status, assigned_reviewers, labels, repository_id, assignment_seed, team_name, created_at)
+VALUES ($1, $2, $3, 'OPEN', $4, $5, $6, $7, $8, $9)
 `
 
 type CreatePRParams struct {
-	ID                string   `json:"id"`
-	Name              string   `json:"name"`
-	AuthorID          string   `json:"author_id"`
-	AssignedReviewers []string `json:"assigned_reviewers"`
+	ID                string             `json:"id"`
+	Name              string             `json:"name"`
+	AuthorID          string             `json:"author_id"`
+	AssignedReviewers []string           `json:"assigned_reviewers"`
+	Labels            []string           `json:"labels"`
+	RepositoryID      pgtype.Text        `json:"repository_id"`
+	AssignmentSeed    pgtype.Int8        `json:"assignment_seed"`
+	TeamName          pgtype.Text        `json:"team_name"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
 }
 
 func (q *Queries) CreatePR(ctx context.Context, arg CreatePRParams) error {
@@ -27,42 +224,99 @@ func (q *Queries) CreatePR(ctx context.Context, arg CreatePRParams) error {
 		arg.Name,
 		arg.AuthorID,
 		arg.AssignedReviewers,
+		arg.Labels,
+		arg.RepositoryID,
+		arg.AssignmentSeed,
+		arg.TeamName,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createReassignment = `-- name: CreateReassignment :exec
+INSERT INTO reassignments (pull_request_id, old_reviewer_id, new_reviewer_id, reason)
+VALUES ($1, $2, $3, $4)
+`
+
+type CreateReassignmentParams struct {
+	PullRequestID string `json:"pull_request_id"`
+	OldReviewerID string `json:"old_reviewer_id"`
+	NewReviewerID string `json:"new_reviewer_id"`
+	Reason        string `json:"reason"`
+}
+
+func (q *Queries) CreateReassignment(ctx context.Context, arg CreateReassignmentParams) error {
+	_, err := q.db.Exec(ctx, createReassignment,
+		arg.PullRequestID,
+		arg.OldReviewerID,
+		arg.NewReviewerID,
+		arg.Reason,
 	)
 	return err
 }
 
+const createRepository = `-- name: CreateRepository :execrows
+INSERT INTO repositories (id, name, team_name, reviewer_count, strategy, require_owner, excluded_users)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (id) DO NOTHING
+`
+
+type CreateRepositoryParams struct {
+	ID            string      `json:"id"`
+	Name          string      `json:"name"`
+	TeamName      string      `json:"team_name"`
+	ReviewerCount pgtype.Int4 `json:"reviewer_count"`
+	Strategy      pgtype.Text `json:"strategy"`
+	RequireOwner  bool        `json:"require_owner"`
+	ExcludedUsers []string    `json:"excluded_users"`
+}
+
+func (q *Queries) CreateRepository(ctx context.Context, arg CreateRepositoryParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createRepository,
+		arg.ID,
+		arg.Name,
+		arg.TeamName,
+		arg.ReviewerCount,
+		arg.Strategy,
+		arg.RequireOwner,
+		arg.ExcludedUsers,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const createTeam = `-- name: CreateTeam :exec
-INSERT INTO teams (name) VALUES ($1) ON CONFLICT (name) DO NOTHING
+INSERT INTO teams (name, parent_name) VALUES ($1, $2) ON CONFLICT (name) DO NOTHING
 `
 
-func (q *Queries) CreateTeam(ctx context.Context, name string) error {
-	_, err := q.db.Exec(ctx, createTeam, name)
+type CreateTeamParams struct {
+	Name       string      `json:"name"`
+	ParentName pgtype.Text `json:"parent_name"`
+}
+
+func (q *Queries) CreateTeam(ctx context.Context, arg CreateTeamParams) error {
+	_, err := q.db.Exec(ctx, createTeam, arg.Name, arg.ParentName)
 	return err
 }
 
 const createUser = `-- name: CreateUser :exec
-INSERT INTO users (id, username, team_name, is_active)
-VALUES ($1, $2, $3, $4)
+INSERT INTO users (id, username, is_active)
+VALUES ($1, $2, $3)
     ON CONFLICT (id) DO UPDATE SET
     username = EXCLUDED.username,
-                            team_name = EXCLUDED.team_name,
                             is_active = EXCLUDED.is_active
 `
 
 type CreateUserParams struct {
 	ID       string `json:"id"`
 	Username string `json:"username"`
-	TeamName string `json:"team_name"`
 	IsActive bool   `json:"is_active"`
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
-	_, err := q.db.Exec(ctx, createUser,
-		arg.ID,
-		arg.Username,
-		arg.TeamName,
-		arg.IsActive,
-	)
+	_, err := q.db.Exec(ctx, createUser, arg.ID, arg.Username, arg.IsActive)
 	return err
 }
 
@@ -75,9 +329,117 @@ func (q *Queries) DeactivateUsers(ctx context.Context, dollar_1 []string) error
 	return err
 }
 
+const deleteEventsBefore = `-- name: DeleteEventsBefore :execrows
+DELETE FROM events WHERE created_at < $1
+`
+
+func (q *Queries) DeleteEventsBefore(ctx context.Context, createdAt pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteEventsBefore, createdAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteExpiredIdempotencyKeys = `-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM idempotency_keys WHERE expires_at <= NOW()
+`
+
+func (q *Queries) DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExpiredIdempotencyKeys)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteIdempotencyKey = `-- name: DeleteIdempotencyKey :exec
+DELETE FROM idempotency_keys WHERE key = $1 AND path = $2
+`
+
+type DeleteIdempotencyKeyParams struct {
+	Key  string `json:"key"`
+	Path string `json:"path"`
+}
+
+func (q *Queries) DeleteIdempotencyKey(ctx context.Context, arg DeleteIdempotencyKeyParams) error {
+	_, err := q.db.Exec(ctx, deleteIdempotencyKey, arg.Key, arg.Path)
+	return err
+}
+
+const deleteRepository = `-- name: DeleteRepository :execrows
+DELETE FROM repositories WHERE id = $1
+`
+
+func (q *Queries) DeleteRepository(ctx context.Context, id string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteRepository, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteReviewAssignment = `-- name: DeleteReviewAssignment :exec
+DELETE FROM review_assignments WHERE pull_request_id = $1 AND reviewer_id = $2
+`
+
+type DeleteReviewAssignmentParams struct {
+	PullRequestID string `json:"pull_request_id"`
+	ReviewerID    string `json:"reviewer_id"`
+}
+
+func (q *Queries) DeleteReviewAssignment(ctx context.Context, arg DeleteReviewAssignmentParams) error {
+	_, err := q.db.Exec(ctx, deleteReviewAssignment, arg.PullRequestID, arg.ReviewerID)
+	return err
+}
+
+const deleteStaleRateLimitBuckets = `-- name: DeleteStaleRateLimitBuckets :execrows
+DELETE FROM rate_limit_buckets WHERE updated_at < $1
+`
+
+func (q *Queries) DeleteStaleRateLimitBuckets(ctx context.Context, updatedAt pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteStaleRateLimitBuckets, updatedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTeam = `-- name: DeleteTeam :execrows
+DELETE FROM teams WHERE name = $1
+`
+
+func (q *Queries) DeleteTeam(ctx context.Context, name string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTeam, name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const escalateReview = `-- name: EscalateReview :execrows
+UPDATE review_assignments SET escalated_at = NOW()
+WHERE pull_request_id = $1 AND reviewer_id = $2 AND escalated_at IS NULL
+`
+
+type EscalateReviewParams struct {
+	PullRequestID string `json:"pull_request_id"`
+	ReviewerID    string `json:"reviewer_id"`
+}
+
+func (q *Queries) EscalateReview(ctx context.Context, arg EscalateReviewParams) (int64, error) {
+	result, err := q.db.Exec(ctx, escalateReview, arg.PullRequestID, arg.ReviewerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getActiveUsersInTeamExcluding = `-- name: GetActiveUsersInTeamExcluding :many
-SELECT id FROM users
-WHERE team_name = $1 AND is_active = true AND id != $2
+SELECT u.id FROM users u
+JOIN team_memberships m ON m.user_id = u.id
+WHERE m.team_name = $1 AND u.is_active = true AND u.id != $2
+ORDER BY u.id
 `
 
 type GetActiveUsersInTeamExcludingParams struct {
@@ -105,6 +467,75 @@ func (q *Queries) GetActiveUsersInTeamExcluding(ctx context.Context, arg GetActi
 	return items, nil
 }
 
+const getCodeowners = `-- name: GetCodeowners :one
+SELECT team_name, repository_id, content, updated_at FROM codeowners
+WHERE team_name = $1 AND repository_id = $2
+`
+
+type GetCodeownersParams struct {
+	TeamName     string `json:"team_name"`
+	RepositoryID string `json:"repository_id"`
+}
+
+func (q *Queries) GetCodeowners(ctx context.Context, arg GetCodeownersParams) (Codeowner, error) {
+	row := q.db.QueryRow(ctx, getCodeowners, arg.TeamName, arg.RepositoryID)
+	var i Codeowner
+	err := row.Scan(
+		&i.TeamName,
+		&i.RepositoryID,
+		&i.Content,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getEvent = `-- name: GetEvent :one
+SELECT id, type, pull_request_id, team_name, user_ids, payload, created_at
+FROM events WHERE id = $1
+`
+
+func (q *Queries) GetEvent(ctx context.Context, id int64) (Event, error) {
+	row := q.db.QueryRow(ctx, getEvent, id)
+	var i Event
+	err := row.Scan(
+		&i.ID,
+		&i.Type,
+		&i.PullRequestID,
+		&i.TeamName,
+		&i.UserIds,
+		&i.Payload,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getIdempotencyKey = `-- name: GetIdempotencyKey :one
+SELECT key, path, request_hash, status_code, response_body, completed, created_at, expires_at
+FROM idempotency_keys
+WHERE key = $1 AND path = $2 AND expires_at > NOW()
+`
+
+type GetIdempotencyKeyParams struct {
+	Key  string `json:"key"`
+	Path string `json:"path"`
+}
+
+func (q *Queries) GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error) {
+	row := q.db.QueryRow(ctx, getIdempotencyKey, arg.Key, arg.Path)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.Key,
+		&i.Path,
+		&i.RequestHash,
+		&i.StatusCode,
+		&i.ResponseBody,
+		&i.Completed,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
 const getOpenPRsByReviewers = `-- name: GetOpenPRsByReviewers :many
 SELECT id, assigned_reviewers FROM pull_requests
 WHERE status = 'OPEN' AND $1 && assigned_reviewers
@@ -136,7 +567,7 @@ func (q *Queries) GetOpenPRsByReviewers(ctx context.Context, assignedReviewers [
 }
 
 const getPR = `-- name: GetPR :one
-SELECT id, name, author_id, status, assigned_reviewers, created_at, merged_at
+SELECT id, name, author_id, status, assigned_reviewers, created_at, merged_at, labels, repository_id, assignment_seed, team_name
 FROM pull_requests WHERE id = $1
 `
 
@@ -151,6 +582,10 @@ func (q *Queries) GetPR(ctx context.Context, id string) (PullRequest, error) {
 		&i.AssignedReviewers,
 		&i.CreatedAt,
 		&i.MergedAt,
+		&i.Labels,
+		&i.RepositoryID,
+		&i.AssignmentSeed,
+		&i.TeamName,
 	)
 	return i, err
 }
@@ -158,7 +593,8 @@ func (q *Queries) GetPR(ctx context.Context, id string) (PullRequest, error) {
 const getPRCountByReviewer = `-- name: GetPRCountByReviewer :many
 SELECT unnest(assigned_reviewers) as reviewer_id, COUNT(*) as cnt
 FROM pull_requests
-WHERE status = 'OPEN'
+WHERE status = 'OPEN' AND ($1::text = ''
+    OR team_name IN (SELECT team_name FROM team_ancestors WHERE ancestor_name = $1))
 GROUP BY reviewer_id
 `
 
@@ -167,8 +603,8 @@ type GetPRCountByReviewerRow struct {
 	Cnt        int64       `json:"cnt"`
 }
 
-func (q *Queries) GetPRCountByReviewer(ctx context.Context) ([]GetPRCountByReviewerRow, error) {
-	rows, err := q.db.Query(ctx, getPRCountByReviewer)
+func (q *Queries) GetPRCountByReviewer(ctx context.Context, teamName string) ([]GetPRCountByReviewerRow, error) {
+	rows, err := q.db.Query(ctx, getPRCountByReviewer, teamName)
 	if err != nil {
 		return nil, err
 	}
@@ -225,50 +661,149 @@ func (q *Queries) GetPRsByReviewer(ctx context.Context, assignedReviewers []stri
 	return items, nil
 }
 
-const getTeam = `-- name: GetTeam :one
-SELECT name FROM teams WHERE name = $1
+const getRepository = `-- name: GetRepository :one
+SELECT id, name, team_name, reviewer_count, strategy, require_owner, excluded_users, created_at FROM repositories WHERE id = $1
 `
 
-func (q *Queries) GetTeam(ctx context.Context, name string) (string, error) {
-	row := q.db.QueryRow(ctx, getTeam, name)
-	err := row.Scan(&name)
-	return name, err
+func (q *Queries) GetRepository(ctx context.Context, id string) (Repository, error) {
+	row := q.db.QueryRow(ctx, getRepository, id)
+	var i Repository
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.TeamName,
+		&i.ReviewerCount,
+		&i.Strategy,
+		&i.RequireOwner,
+		&i.ExcludedUsers,
+		&i.CreatedAt,
+	)
+	return i, err
 }
 
-const getUser = `-- name: GetUser :one
-SELECT id, username, team_name, is_active FROM users WHERE id = $1
+const getSlackUserID = `-- name: GetSlackUserID :one
+SELECT slack_user_id FROM slack_users WHERE user_id = $1
 `
 
-func (q *Queries) GetUser(ctx context.Context, id string) (User, error) {
-	row := q.db.QueryRow(ctx, getUser, id)
-	var i User
+func (q *Queries) GetSlackUserID(ctx context.Context, userID string) (string, error) {
+	row := q.db.QueryRow(ctx, getSlackUserID, userID)
+	var slack_user_id string
+	err := row.Scan(&slack_user_id)
+	return slack_user_id, err
+}
+
+const getStalePolicy = `-- name: GetStalePolicy :one
+SELECT p.team_name, p.stale_after_hours, p.max_reassignments, p.dry_run
+FROM team_ancestors a JOIN stale_policies p ON p.team_name = a.ancestor_name
+WHERE a.team_name = $1
+ORDER BY a.depth LIMIT 1
+`
+
+func (q *Queries) GetStalePolicy(ctx context.Context, teamName string) (StalePolicy, error) {
+	row := q.db.QueryRow(ctx, getStalePolicy, teamName)
+	var i StalePolicy
 	err := row.Scan(
-		&i.ID,
-		&i.Username,
 		&i.TeamName,
-		&i.IsActive,
+		&i.StaleAfterHours,
+		&i.MaxReassignments,
+		&i.DryRun,
 	)
 	return i, err
 }
 
-const getUsersByTeam = `-- name: GetUsersByTeam :many
-SELECT id, username, team_name, is_active FROM users WHERE team_name = $1
+const getTeam = `-- name: GetTeam :one
+SELECT name, parent_name, lead_assignment FROM teams WHERE name = $1
 `
 
-func (q *Queries) GetUsersByTeam(ctx context.Context, teamName string) ([]User, error) {
-	rows, err := q.db.Query(ctx, getUsersByTeam, teamName)
+type GetTeamRow struct {
+	Name           string      `json:"name"`
+	ParentName     pgtype.Text `json:"parent_name"`
+	LeadAssignment string      `json:"lead_assignment"`
+}
+
+func (q *Queries) GetTeam(ctx context.Context, name string) (GetTeamRow, error) {
+	row := q.db.QueryRow(ctx, getTeam, name)
+	var i GetTeamRow
+	err := row.Scan(&i.Name, &i.ParentName, &i.LeadAssignment)
+	return i, err
+}
+
+const getTeamRules = `-- name: GetTeamRules :one
+SELECT r.team_name, r.source, r.updated_at
+FROM team_ancestors a JOIN team_rules r ON r.team_name = a.ancestor_name
+WHERE a.team_name = $1
+ORDER BY a.depth LIMIT 1
+`
+
+func (q *Queries) GetTeamRules(ctx context.Context, teamName string) (TeamRule, error) {
+	row := q.db.QueryRow(ctx, getTeamRules, teamName)
+	var i TeamRule
+	err := row.Scan(&i.TeamName, &i.Source, &i.UpdatedAt)
+	return i, err
+}
+
+const getTeamSLA = `-- name: GetTeamSLA :one
+SELECT sla.team_name, sla.first_response_hours, sla.auto_reassign
+FROM team_ancestors a JOIN team_slas sla ON sla.team_name = a.ancestor_name
+WHERE a.team_name = $1
+ORDER BY a.depth LIMIT 1
+`
+
+func (q *Queries) GetTeamSLA(ctx context.Context, teamName string) (TeamSla, error) {
+	row := q.db.QueryRow(ctx, getTeamSLA, teamName)
+	var i TeamSla
+	err := row.Scan(&i.TeamName, &i.FirstResponseHours, &i.AutoReassign)
+	return i, err
+}
+
+const getTeamSubtree = `-- name: GetTeamSubtree :many
+WITH RECURSIVE subtree (name, parent_name, depth) AS (
+    SELECT name, parent_name, 0 FROM teams WHERE name = $1
+    UNION ALL
+    SELECT t.name, t.parent_name, s.depth + 1
+    FROM teams t JOIN subtree s ON t.parent_name = s.name
+) CYCLE name SET is_cycle USING path
+SELECT s.name, s.parent_name, s.depth,
+       (SELECT COUNT(*) FROM team_memberships m WHERE m.team_name = s.name) AS members,
+       (SELECT COUNT(*) FROM pull_requests pr WHERE pr.team_name = s.name AND pr.status = 'OPEN') AS open_prs,
+       (SELECT COUNT(DISTINCT m.user_id) FROM team_ancestors d
+        JOIN team_memberships m ON m.team_name = d.team_name
+        WHERE d.ancestor_name = s.name) AS total_members,
+       (SELECT COUNT(*) FROM team_ancestors d
+        JOIN pull_requests pr ON pr.team_name = d.team_name AND pr.status = 'OPEN'
+        WHERE d.ancestor_name = s.name) AS total_open_prs
+FROM subtree s
+WHERE NOT s.is_cycle
+ORDER BY s.depth, s.name
+`
+
+type GetTeamSubtreeRow struct {
+	Name         string      `json:"name"`
+	ParentName   pgtype.Text `json:"parent_name"`
+	Depth        int32       `json:"depth"`
+	Members      int64       `json:"members"`
+	OpenPrs      int64       `json:"open_prs"`
+	TotalMembers int64       `json:"total_members"`
+	TotalOpenPrs int64       `json:"total_open_prs"`
+}
+
+func (q *Queries) GetTeamSubtree(ctx context.Context, name string) ([]GetTeamSubtreeRow, error) {
+	rows, err := q.db.Query(ctx, getTeamSubtree, name)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []User{}
+	items := []GetTeamSubtreeRow{}
 	for rows.Next() {
-		var i User
+		var i GetTeamSubtreeRow
 		if err := rows.Scan(
-			&i.ID,
-			&i.Username,
-			&i.TeamName,
-			&i.IsActive,
+			&i.Name,
+			&i.ParentName,
+			&i.Depth,
+			&i.Members,
+			&i.OpenPrs,
+			&i.TotalMembers,
+			&i.TotalOpenPrs,
 		); err != nil {
 			return nil, err
 		}
@@ -280,17 +815,1261 @@ func (q *Queries) GetUsersByTeam(ctx context.Context, teamName string) ([]User,
 	return items, nil
 }
 
-const mergePR = `-- name: MergePR :exec
-UPDATE pull_requests
-SET status = 'MERGED', merged_at = NOW()
-WHERE id = $1 AND status = 'OPEN'
+const getTeamWorkingHours = `-- name: GetTeamWorkingHours :one
+SELECT t.timezone, t.work_start, t.work_end, t.work_days
+FROM team_ancestors a JOIN teams t ON t.name = a.ancestor_name
+WHERE a.team_name = $1
+ORDER BY t.timezone IS NULL, a.depth LIMIT 1
 `
 
-func (q *Queries) MergePR(ctx context.Context, id string) error {
+type GetTeamWorkingHoursRow struct {
+	Timezone  pgtype.Text `json:"timezone"`
+	WorkStart pgtype.Text `json:"work_start"`
+	WorkEnd   pgtype.Text `json:"work_end"`
+	WorkDays  []string    `json:"work_days"`
+}
+
+func (q *Queries) GetTeamWorkingHours(ctx context.Context, name string) (GetTeamWorkingHoursRow, error) {
+	row := q.db.QueryRow(ctx, getTeamWorkingHours, name)
+	var i GetTeamWorkingHoursRow
+	err := row.Scan(
+		&i.Timezone,
+		&i.WorkStart,
+		&i.WorkEnd,
+		&i.WorkDays,
+	)
+	return i, err
+}
+
+const getUser = `-- name: GetUser :one
+SELECT id, username, is_active, email, email_opt_out, timezone, work_start, work_end, work_days, seniority
+FROM users WHERE id = $1
+`
+
+func (q *Queries) GetUser(ctx context.Context, id string) (User, error) {
+	row := q.db.QueryRow(ctx, getUser, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.IsActive,
+		&i.Email,
+		&i.EmailOptOut,
+		&i.Timezone,
+		&i.WorkStart,
+		&i.WorkEnd,
+		&i.WorkDays,
+		&i.Seniority,
+	)
+	return i, err
+}
+
+const getUsers = `-- name: GetUsers :many
+SELECT id, username, is_active, email, email_opt_out, timezone, work_start, work_end, work_days, seniority
+FROM users WHERE id = ANY($1::text[])
+`
+
+func (q *Queries) GetUsers(ctx context.Context, ids []string) ([]User, error) {
+	rows, err := q.db.Query(ctx, getUsers, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.IsActive,
+			&i.Email,
+			&i.EmailOptOut,
+			&i.Timezone,
+			&i.WorkStart,
+			&i.WorkEnd,
+			&i.WorkDays,
+			&i.Seniority,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUsersByTeam = `-- name: GetUsersByTeam :many
+SELECT u.id, u.username, u.is_active, u.email, u.email_opt_out, u.timezone, u.work_start, u.work_end, u.work_days, u.seniority, m.role
+FROM users u
+JOIN team_memberships m ON m.user_id = u.id
+WHERE m.team_name = $1
+ORDER BY m.joined_at, u.id
+`
+
+type GetUsersByTeamRow struct {
+	ID          string      `json:"id"`
+	Username    string      `json:"username"`
+	IsActive    bool        `json:"is_active"`
+	Email       pgtype.Text `json:"email"`
+	EmailOptOut bool        `json:"email_opt_out"`
+	Timezone    pgtype.Text `json:"timezone"`
+	WorkStart   pgtype.Text `json:"work_start"`
+	WorkEnd     pgtype.Text `json:"work_end"`
+	WorkDays    []string    `json:"work_days"`
+	Seniority   string      `json:"seniority"`
+	Role        string      `json:"role"`
+}
+
+func (q *Queries) GetUsersByTeam(ctx context.Context, teamName string) ([]GetUsersByTeamRow, error) {
+	rows, err := q.db.Query(ctx, getUsersByTeam, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetUsersByTeamRow{}
+	for rows.Next() {
+		var i GetUsersByTeamRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.IsActive,
+			&i.Email,
+			&i.EmailOptOut,
+			&i.Timezone,
+			&i.WorkStart,
+			&i.WorkEnd,
+			&i.WorkDays,
+			&i.Seniority,
+			&i.Role,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listActiveOwners = `-- name: ListActiveOwners :many
+SELECT id FROM users
+WHERE is_active AND (id = ANY($1::text[])
+    OR ((username = ANY($1::text[]) OR email = ANY($1::text[]))
+        AND id IN (SELECT user_id FROM team_memberships
+                   WHERE team_name = $2 OR team_name = ANY($3::text[])))
+    OR id IN (SELECT user_id FROM team_memberships WHERE team_name = ANY($3::text[])))
+ORDER BY id
+`
+
+type ListActiveOwnersParams struct {
+	Handles  []string `json:"handles"`
+	TeamName string   `json:"team_name"`
+	Teams    []string `json:"teams"`
+}
+
+func (q *Queries) ListActiveOwners(ctx context.Context, arg ListActiveOwnersParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, listActiveOwners, arg.Handles, arg.TeamName, arg.Teams)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCandidateUsers = `-- name: ListCandidateUsers :many
+SELECT id, seniority FROM users WHERE id = ANY($1::text[])
+`
+
+type ListCandidateUsersRow struct {
+	ID        string `json:"id"`
+	Seniority string `json:"seniority"`
+}
+
+func (q *Queries) ListCandidateUsers(ctx context.Context, userIds []string) ([]ListCandidateUsersRow, error) {
+	rows, err := q.db.Query(ctx, listCandidateUsers, userIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListCandidateUsersRow{}
+	for rows.Next() {
+		var i ListCandidateUsersRow
+		if err := rows.Scan(&i.ID, &i.Seniority); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDigestRecipients = `-- name: ListDigestRecipients :many
+SELECT u.id, u.username, u.email::text AS email,
+       COUNT(pr.id) AS open_reviews,
+       MIN(pr.created_at)::timestamptz AS oldest_created_at,
+       d.sent_on,
+       CASE WHEN u.timezone IS NULL THEN t.timezone ELSE u.timezone END AS timezone,
+       CASE WHEN u.timezone IS NULL THEN t.work_start ELSE u.work_start END AS work_start,
+       CASE WHEN u.timezone IS NULL THEN t.work_end ELSE u.work_end END AS work_end,
+       CASE WHEN u.timezone IS NULL THEN t.work_days ELSE u.work_days END::text[] AS work_days
+FROM users u
+JOIN team_memberships m ON m.user_id = u.id AND m.is_primary
+JOIN teams t ON t.name = m.team_name
+JOIN pull_requests pr ON u.id = ANY(pr.assigned_reviewers) AND pr.status = 'OPEN'
+LEFT JOIN email_digests d ON d.user_id = u.id
+WHERE u.is_active AND NOT u.email_opt_out AND u.email IS NOT NULL
+GROUP BY u.id, t.name, d.user_id
+ORDER BY u.id
+`
+
+type ListDigestRecipientsRow struct {
+	ID              string             `json:"id"`
+	Username        string             `json:"username"`
+	Email           string             `json:"email"`
+	OpenReviews     int64              `json:"open_reviews"`
+	OldestCreatedAt pgtype.Timestamptz `json:"oldest_created_at"`
+	SentOn          pgtype.Date        `json:"sent_on"`
+	Timezone        pgtype.Text        `json:"timezone"`
+	WorkStart       pgtype.Text        `json:"work_start"`
+	WorkEnd         pgtype.Text        `json:"work_end"`
+	WorkDays        []string           `json:"work_days"`
+}
+
+func (q *Queries) ListDigestRecipients(ctx context.Context) ([]ListDigestRecipientsRow, error) {
+	rows, err := q.db.Query(ctx, listDigestRecipients)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListDigestRecipientsRow{}
+	for rows.Next() {
+		var i ListDigestRecipientsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.Email,
+			&i.OpenReviews,
+			&i.OldestCreatedAt,
+			&i.SentOn,
+			&i.Timezone,
+			&i.WorkStart,
+			&i.WorkEnd,
+			&i.WorkDays,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventsForTeam = `-- name: ListEventsForTeam :many
+SELECT id, type, pull_request_id, team_name, user_ids, payload, created_at
+FROM events
+WHERE id > $1 AND team_name = $2
+ORDER BY id
+LIMIT $3
+`
+
+type ListEventsForTeamParams struct {
+	AfterID   int64  `json:"after_id"`
+	TeamName  string `json:"team_name"`
+	MaxEvents int32  `json:"max_events"`
+}
+
+func (q *Queries) ListEventsForTeam(ctx context.Context, arg ListEventsForTeamParams) ([]Event, error) {
+	rows, err := q.db.Query(ctx, listEventsForTeam, arg.AfterID, arg.TeamName, arg.MaxEvents)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Event{}
+	for rows.Next() {
+		var i Event
+		if err := rows.Scan(
+			&i.ID,
+			&i.Type,
+			&i.PullRequestID,
+			&i.TeamName,
+			&i.UserIds,
+			&i.Payload,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventsForUser = `-- name: ListEventsForUser :many
+SELECT id, type, pull_request_id, team_name, user_ids, payload, created_at
+FROM events
+WHERE id > $1 AND $2::text = ANY(user_ids)
+ORDER BY id
+LIMIT $3
+`
+
+type ListEventsForUserParams struct {
+	AfterID   int64  `json:"after_id"`
+	UserID    string `json:"user_id"`
+	MaxEvents int32  `json:"max_events"`
+}
+
+func (q *Queries) ListEventsForUser(ctx context.Context, arg ListEventsForUserParams) ([]Event, error) {
+	rows, err := q.db.Query(ctx, listEventsForUser, arg.AfterID, arg.UserID, arg.MaxEvents)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Event{}
+	for rows.Next() {
+		var i Event
+		if err := rows.Scan(
+			&i.ID,
+			&i.Type,
+			&i.PullRequestID,
+			&i.TeamName,
+			&i.UserIds,
+			&i.Payload,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOpenReviewsInTeam = `-- name: ListOpenReviewsInTeam :many
+SELECT pr.id FROM pull_requests pr
+WHERE pr.status = 'OPEN' AND $1::text = ANY(pr.assigned_reviewers) AND pr.team_name = $2
+ORDER BY pr.id
+`
+
+type ListOpenReviewsInTeamParams struct {
+	UserID   string `json:"user_id"`
+	TeamName string `json:"team_name"`
+}
+
+func (q *Queries) ListOpenReviewsInTeam(ctx context.Context, arg ListOpenReviewsInTeamParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, listOpenReviewsInTeam, arg.UserID, arg.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPairings = `-- name: ListPairings :many
+SELECT reviewer_id, assigned_at
+FROM pairings
+WHERE author_id = $1 AND reviewer_id = ANY($2::text[]) AND assigned_at >= $3
+ORDER BY assigned_at
+`
+
+type ListPairingsParams struct {
+	AuthorID string             `json:"author_id"`
+	UserIds  []string           `json:"user_ids"`
+	Since    pgtype.Timestamptz `json:"since"`
+}
+
+type ListPairingsRow struct {
+	ReviewerID string             `json:"reviewer_id"`
+	AssignedAt pgtype.Timestamptz `json:"assigned_at"`
+}
+
+func (q *Queries) ListPairings(ctx context.Context, arg ListPairingsParams) ([]ListPairingsRow, error) {
+	rows, err := q.db.Query(ctx, listPairings, arg.AuthorID, arg.UserIds, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPairingsRow{}
+	for rows.Next() {
+		var i ListPairingsRow
+		if err := rows.Scan(&i.ReviewerID, &i.AssignedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPendingReviews = `-- name: ListPendingReviews :many
+WITH effective AS (
+    SELECT DISTINCT ON (a.team_name) a.team_name, s.first_response_hours, s.auto_reassign
+    FROM team_ancestors a JOIN team_slas s ON s.team_name = a.ancestor_name
+    ORDER BY a.team_name, a.depth
+)
+SELECT ra.pull_request_id, pr.name AS pull_request_name, sla.team_name,
+       ra.reviewer_id, ra.assigned_at, ra.escalated_at,
+       sla.first_response_hours, sla.auto_reassign
+FROM review_assignments ra
+JOIN pull_requests pr ON pr.id = ra.pull_request_id AND pr.status = 'OPEN'
+JOIN effective sla ON sla.team_name = pr.team_name
+WHERE ra.responded_at IS NULL AND ($1::text = ''
+    OR sla.team_name IN (SELECT team_name FROM team_ancestors WHERE ancestor_name = $1))
+ORDER BY ra.assigned_at
+`
+
+type ListPendingReviewsRow struct {
+	PullRequestID      string             `json:"pull_request_id"`
+	PullRequestName    string             `json:"pull_request_name"`
+	TeamName           string             `json:"team_name"`
+	ReviewerID         string             `json:"reviewer_id"`
+	AssignedAt         pgtype.Timestamptz `json:"assigned_at"`
+	EscalatedAt        pgtype.Timestamptz `json:"escalated_at"`
+	FirstResponseHours int32              `json:"first_response_hours"`
+	AutoReassign       bool               `json:"auto_reassign"`
+}
+
+func (q *Queries) ListPendingReviews(ctx context.Context, teamName string) ([]ListPendingReviewsRow, error) {
+	rows, err := q.db.Query(ctx, listPendingReviews, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPendingReviewsRow{}
+	for rows.Next() {
+		var i ListPendingReviewsRow
+		if err := rows.Scan(
+			&i.PullRequestID,
+			&i.PullRequestName,
+			&i.TeamName,
+			&i.ReviewerID,
+			&i.AssignedAt,
+			&i.EscalatedAt,
+			&i.FirstResponseHours,
+			&i.AutoReassign,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPullRequestsSince = `-- name: ListPullRequestsSince :many
+SELECT id, name, author_id, status, assigned_reviewers, created_at, merged_at, labels, repository_id, assignment_seed, team_name
+FROM pull_requests WHERE created_at >= $1
+ORDER BY created_at, id
+`
+
+func (q *Queries) ListPullRequestsSince(ctx context.Context, createdAt pgtype.Timestamptz) ([]PullRequest, error) {
+	rows, err := q.db.Query(ctx, listPullRequestsSince, createdAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PullRequest{}
+	for rows.Next() {
+		var i PullRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.AuthorID,
+			&i.Status,
+			&i.AssignedReviewers,
+			&i.CreatedAt,
+			&i.MergedAt,
+			&i.Labels,
+			&i.RepositoryID,
+			&i.AssignmentSeed,
+			&i.TeamName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReassignments = `-- name: ListReassignments :many
+SELECT id, pull_request_id, old_reviewer_id, new_reviewer_id, reason, created_at
+FROM reassignments WHERE pull_request_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListReassignments(ctx context.Context, pullRequestID string) ([]Reassignment, error) {
+	rows, err := q.db.Query(ctx, listReassignments, pullRequestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Reassignment{}
+	for rows.Next() {
+		var i Reassignment
+		if err := rows.Scan(
+			&i.ID,
+			&i.PullRequestID,
+			&i.OldReviewerID,
+			&i.NewReviewerID,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReassignmentsSince = `-- name: ListReassignmentsSince :many
+SELECT r.id, r.pull_request_id, r.old_reviewer_id, r.new_reviewer_id, r.reason, r.created_at
+FROM reassignments r
+JOIN pull_requests pr ON pr.id = r.pull_request_id
+WHERE pr.created_at >= $1
+ORDER BY r.id
+`
+
+func (q *Queries) ListReassignmentsSince(ctx context.Context, createdAt pgtype.Timestamptz) ([]Reassignment, error) {
+	rows, err := q.db.Query(ctx, listReassignmentsSince, createdAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Reassignment{}
+	for rows.Next() {
+		var i Reassignment
+		if err := rows.Scan(
+			&i.ID,
+			&i.PullRequestID,
+			&i.OldReviewerID,
+			&i.NewReviewerID,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRepositories = `-- name: ListRepositories :many
+SELECT id, name, team_name, reviewer_count, strategy, require_owner, excluded_users, created_at FROM repositories
+WHERE $1::text = '' OR team_name = $1
+ORDER BY id
+`
+
+func (q *Queries) ListRepositories(ctx context.Context, teamName string) ([]Repository, error) {
+	rows, err := q.db.Query(ctx, listRepositories, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Repository{}
+	for rows.Next() {
+		var i Repository
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.TeamName,
+			&i.ReviewerCount,
+			&i.Strategy,
+			&i.RequireOwner,
+			&i.ExcludedUsers,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSiblingTeams = `-- name: ListSiblingTeams :many
+SELECT s.name FROM teams t
+JOIN teams s ON s.parent_name = t.parent_name AND s.name <> t.name
+WHERE t.name = $1
+ORDER BY s.name
+`
+
+func (q *Queries) ListSiblingTeams(ctx context.Context, name string) ([]string, error) {
+	rows, err := q.db.Query(ctx, listSiblingTeams, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStaleCandidates = `-- name: ListStaleCandidates :many
+WITH effective AS (
+    SELECT DISTINCT ON (a.team_name) a.team_name, s.stale_after_hours, s.max_reassignments, s.dry_run
+    FROM team_ancestors a JOIN stale_policies s ON s.team_name = a.ancestor_name
+    ORDER BY a.team_name, a.depth
+)
+SELECT ra.pull_request_id, ra.reviewer_id, ra.assigned_at,
+       p.team_name, p.stale_after_hours, p.max_reassignments, p.dry_run,
+       (SELECT COUNT(*) FROM reassignments r
+        WHERE r.pull_request_id = ra.pull_request_id AND r.reason = 'stale') AS stale_reassignments
+FROM review_assignments ra
+JOIN pull_requests pr ON pr.id = ra.pull_request_id AND pr.status = 'OPEN'
+JOIN effective p ON p.team_name = pr.team_name
+WHERE ra.responded_at IS NULL AND ($1::text = ''
+    OR p.team_name IN (SELECT team_name FROM team_ancestors WHERE ancestor_name = $1))
+ORDER BY ra.assigned_at
+`
+
+type ListStaleCandidatesRow struct {
+	PullRequestID      string             `json:"pull_request_id"`
+	ReviewerID         string             `json:"reviewer_id"`
+	AssignedAt         pgtype.Timestamptz `json:"assigned_at"`
+	TeamName           string             `json:"team_name"`
+	StaleAfterHours    int32              `json:"stale_after_hours"`
+	MaxReassignments   int32              `json:"max_reassignments"`
+	DryRun             bool               `json:"dry_run"`
+	StaleReassignments int64              `json:"stale_reassignments"`
+}
+
+func (q *Queries) ListStaleCandidates(ctx context.Context, teamName string) ([]ListStaleCandidatesRow, error) {
+	rows, err := q.db.Query(ctx, listStaleCandidates, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListStaleCandidatesRow{}
+	for rows.Next() {
+		var i ListStaleCandidatesRow
+		if err := rows.Scan(
+			&i.PullRequestID,
+			&i.ReviewerID,
+			&i.AssignedAt,
+			&i.TeamName,
+			&i.StaleAfterHours,
+			&i.MaxReassignments,
+			&i.DryRun,
+			&i.StaleReassignments,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTags = `-- name: ListTags :many
+SELECT ut.tag, COUNT(*) AS users
+FROM user_tags ut
+WHERE $1::text = ''
+    OR ut.user_id IN (SELECT user_id FROM team_memberships WHERE team_name = $1)
+GROUP BY ut.tag
+ORDER BY ut.tag
+`
+
+type ListTagsRow struct {
+	Tag   string `json:"tag"`
+	Users int64  `json:"users"`
+}
+
+func (q *Queries) ListTags(ctx context.Context, teamName string) ([]ListTagsRow, error) {
+	rows, err := q.db.Query(ctx, listTags, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTagsRow{}
+	for rows.Next() {
+		var i ListTagsRow
+		if err := rows.Scan(&i.Tag, &i.Users); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamAncestors = `-- name: ListTeamAncestors :many
+SELECT ancestor_name FROM team_ancestors WHERE team_name = $1 ORDER BY depth
+`
+
+func (q *Queries) ListTeamAncestors(ctx context.Context, teamName string) ([]string, error) {
+	rows, err := q.db.Query(ctx, listTeamAncestors, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var ancestor_name string
+		if err := rows.Scan(&ancestor_name); err != nil {
+			return nil, err
+		}
+		items = append(items, ancestor_name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamLeads = `-- name: ListTeamLeads :many
+SELECT user_id FROM team_memberships
+WHERE team_name = $1 AND role = 'lead'
+ORDER BY user_id
+`
+
+func (q *Queries) ListTeamLeads(ctx context.Context, teamName string) ([]string, error) {
+	rows, err := q.db.Query(ctx, listTeamLeads, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var user_id string
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamMemberIDs = `-- name: ListTeamMemberIDs :many
+SELECT user_id FROM team_memberships WHERE team_name = $1 ORDER BY user_id
+`
+
+func (q *Queries) ListTeamMemberIDs(ctx context.Context, teamName string) ([]string, error) {
+	rows, err := q.db.Query(ctx, listTeamMemberIDs, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var user_id string
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamMemberships = `-- name: ListTeamMemberships :many
+SELECT user_id, team_name, is_primary FROM team_memberships
+WHERE user_id = ANY($1::text[])
+ORDER BY user_id, is_primary DESC, joined_at, team_name
+`
+
+type ListTeamMembershipsRow struct {
+	UserID    string `json:"user_id"`
+	TeamName  string `json:"team_name"`
+	IsPrimary bool   `json:"is_primary"`
+}
+
+func (q *Queries) ListTeamMemberships(ctx context.Context, userIds []string) ([]ListTeamMembershipsRow, error) {
+	rows, err := q.db.Query(ctx, listTeamMemberships, userIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTeamMembershipsRow{}
+	for rows.Next() {
+		var i ListTeamMembershipsRow
+		if err := rows.Scan(&i.UserID, &i.TeamName, &i.IsPrimary); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamOpenPRs = `-- name: ListTeamOpenPRs :many
+SELECT pr.id, pr.author_id, pr.assigned_reviewers FROM pull_requests pr
+WHERE pr.status = 'OPEN' AND pr.team_name = $1
+ORDER BY pr.id
+`
+
+type ListTeamOpenPRsRow struct {
+	ID                string   `json:"id"`
+	AuthorID          string   `json:"author_id"`
+	AssignedReviewers []string `json:"assigned_reviewers"`
+}
+
+func (q *Queries) ListTeamOpenPRs(ctx context.Context, teamName string) ([]ListTeamOpenPRsRow, error) {
+	rows, err := q.db.Query(ctx, listTeamOpenPRs, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTeamOpenPRsRow{}
+	for rows.Next() {
+		var i ListTeamOpenPRsRow
+		if err := rows.Scan(&i.ID, &i.AuthorID, &i.AssignedReviewers); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamRoles = `-- name: ListTeamRoles :many
+SELECT DISTINCT m.role FROM team_ancestors a
+JOIN team_memberships m ON m.team_name = a.ancestor_name
+WHERE a.team_name = $1 AND m.user_id = $2
+ORDER BY m.role
+`
+
+type ListTeamRolesParams struct {
+	TeamName string `json:"team_name"`
+	UserID   string `json:"user_id"`
+}
+
+func (q *Queries) ListTeamRoles(ctx context.Context, arg ListTeamRolesParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, listTeamRoles, arg.TeamName, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var role string
+		if err := rows.Scan(&role); err != nil {
+			return nil, err
+		}
+		items = append(items, role)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeams = `-- name: ListTeams :many
+SELECT name FROM teams ORDER BY name
+`
+
+func (q *Queries) ListTeams(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, listTeams)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserTags = `-- name: ListUserTags :many
+SELECT user_id, tag FROM user_tags
+WHERE user_id = ANY($1::text[])
+ORDER BY user_id, tag
+`
+
+func (q *Queries) ListUserTags(ctx context.Context, userIds []string) ([]UserTag, error) {
+	rows, err := q.db.Query(ctx, listUserTags, userIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []UserTag{}
+	for rows.Next() {
+		var i UserTag
+		if err := rows.Scan(&i.UserID, &i.Tag); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsers = `-- name: ListUsers :many
+SELECT id, username, is_active, email, email_opt_out, timezone, work_start, work_end, work_days, seniority
+FROM users ORDER BY id
+`
+
+func (q *Queries) ListUsers(ctx context.Context) ([]User, error) {
+	rows, err := q.db.Query(ctx, listUsers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.IsActive,
+			&i.Email,
+			&i.EmailOptOut,
+			&i.Timezone,
+			&i.WorkStart,
+			&i.WorkEnd,
+			&i.WorkDays,
+			&i.Seniority,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const mergePR = `-- name: MergePR :exec
+UPDATE pull_requests
+SET status = 'MERGED', merged_at = NOW()
+WHERE id = $1 AND status = 'OPEN'
+`
+
+func (q *Queries) MergePR(ctx context.Context, id string) error {
 	_, err := q.db.Exec(ctx, mergePR, id)
 	return err
 }
 
+const promotePrimaryTeams = `-- name: PromotePrimaryTeams :exec
+UPDATE team_memberships m SET is_primary = true
+FROM (
+    SELECT DISTINCT ON (user_id) user_id, team_name FROM team_memberships
+    WHERE user_id = ANY($1::text[])
+    ORDER BY user_id, joined_at, team_name
+) oldest
+WHERE m.user_id = oldest.user_id AND m.team_name = oldest.team_name
+    AND NOT EXISTS (SELECT 1 FROM team_memberships p WHERE p.user_id = m.user_id AND p.is_primary)
+`
+
+func (q *Queries) PromotePrimaryTeams(ctx context.Context, userIds []string) error {
+	_, err := q.db.Exec(ctx, promotePrimaryTeams, userIds)
+	return err
+}
+
+const releaseEmailDigest = `-- name: ReleaseEmailDigest :exec
+DELETE FROM email_digests WHERE user_id = $1 AND sent_on = $2
+`
+
+type ReleaseEmailDigestParams struct {
+	UserID string      `json:"user_id"`
+	SentOn pgtype.Date `json:"sent_on"`
+}
+
+func (q *Queries) ReleaseEmailDigest(ctx context.Context, arg ReleaseEmailDigestParams) error {
+	_, err := q.db.Exec(ctx, releaseEmailDigest, arg.UserID, arg.SentOn)
+	return err
+}
+
+const removeTeamMember = `-- name: RemoveTeamMember :execrows
+DELETE FROM team_memberships WHERE user_id = $1 AND team_name = $2
+`
+
+type RemoveTeamMemberParams struct {
+	ID       string `json:"id"`
+	TeamName string `json:"team_name"`
+}
+
+func (q *Queries) RemoveTeamMember(ctx context.Context, arg RemoveTeamMemberParams) (int64, error) {
+	result, err := q.db.Exec(ctx, removeTeamMember, arg.ID, arg.TeamName)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const removeUserTags = `-- name: RemoveUserTags :exec
+DELETE FROM user_tags WHERE user_id = $1 AND tag = ANY($2::text[])
+`
+
+type RemoveUserTagsParams struct {
+	UserID string   `json:"user_id"`
+	Tags   []string `json:"tags"`
+}
+
+func (q *Queries) RemoveUserTags(ctx context.Context, arg RemoveUserTagsParams) error {
+	_, err := q.db.Exec(ctx, removeUserTags, arg.UserID, arg.Tags)
+	return err
+}
+
+const removeUserTagsExcept = `-- name: RemoveUserTagsExcept :exec
+DELETE FROM user_tags WHERE user_id = $1 AND NOT (tag = ANY($2::text[]))
+`
+
+type RemoveUserTagsExceptParams struct {
+	UserID string   `json:"user_id"`
+	Tags   []string `json:"tags"`
+}
+
+func (q *Queries) RemoveUserTagsExcept(ctx context.Context, arg RemoveUserTagsExceptParams) error {
+	_, err := q.db.Exec(ctx, removeUserTagsExcept, arg.UserID, arg.Tags)
+	return err
+}
+
+const renameTeam = `-- name: RenameTeam :execrows
+WITH moved_events AS (
+    UPDATE events SET team_name = $1 WHERE team_name = $2
+)
+UPDATE teams SET name = $1 WHERE name = $2
+`
+
+type RenameTeamParams struct {
+	NewName string `json:"new_name"`
+	Name    string `json:"name"`
+}
+
+func (q *Queries) RenameTeam(ctx context.Context, arg RenameTeamParams) (int64, error) {
+	result, err := q.db.Exec(ctx, renameTeam, arg.NewName, arg.Name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reserveIdempotencyKey = `-- name: ReserveIdempotencyKey :execrows
+INSERT INTO idempotency_keys (key, path, request_hash, expires_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (key, path) DO UPDATE SET
+    request_hash = EXCLUDED.request_hash,
+    status_code = NULL,
+    response_body = NULL,
+    completed = false,
+    created_at = NOW(),
+    expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at <= NOW()
+`
+
+type ReserveIdempotencyKeyParams struct {
+	Key         string             `json:"key"`
+	Path        string             `json:"path"`
+	RequestHash string             `json:"request_hash"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+}
+
+func (q *Queries) ReserveIdempotencyKey(ctx context.Context, arg ReserveIdempotencyKeyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reserveIdempotencyKey,
+		arg.Key,
+		arg.Path,
+		arg.RequestHash,
+		arg.ExpiresAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const respondToReview = `-- name: RespondToReview :execrows
+UPDATE review_assignments SET responded_at = NOW()
+WHERE pull_request_id = $1 AND reviewer_id = $2 AND responded_at IS NULL
+`
+
+type RespondToReviewParams struct {
+	PullRequestID string `json:"pull_request_id"`
+	ReviewerID    string `json:"reviewer_id"`
+}
+
+func (q *Queries) RespondToReview(ctx context.Context, arg RespondToReviewParams) (int64, error) {
+	result, err := q.db.Exec(ctx, respondToReview, arg.PullRequestID, arg.ReviewerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setPrimaryTeam = `-- name: SetPrimaryTeam :exec
+UPDATE team_memberships SET is_primary = true WHERE user_id = $1 AND team_name = $2
+`
+
+type SetPrimaryTeamParams struct {
+	UserID   string `json:"user_id"`
+	TeamName string `json:"team_name"`
+}
+
+func (q *Queries) SetPrimaryTeam(ctx context.Context, arg SetPrimaryTeamParams) error {
+	_, err := q.db.Exec(ctx, setPrimaryTeam, arg.UserID, arg.TeamName)
+	return err
+}
+
+const setSlackUserID = `-- name: SetSlackUserID :exec
+INSERT INTO slack_users (user_id, slack_user_id)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE SET slack_user_id = EXCLUDED.slack_user_id
+`
+
+type SetSlackUserIDParams struct {
+	UserID      string `json:"user_id"`
+	SlackUserID string `json:"slack_user_id"`
+}
+
+func (q *Queries) SetSlackUserID(ctx context.Context, arg SetSlackUserIDParams) error {
+	_, err := q.db.Exec(ctx, setSlackUserID, arg.UserID, arg.SlackUserID)
+	return err
+}
+
+const setTeamLeadAssignment = `-- name: SetTeamLeadAssignment :execrows
+UPDATE teams SET lead_assignment = $2 WHERE name = $1
+`
+
+type SetTeamLeadAssignmentParams struct {
+	Name           string `json:"name"`
+	LeadAssignment string `json:"lead_assignment"`
+}
+
+func (q *Queries) SetTeamLeadAssignment(ctx context.Context, arg SetTeamLeadAssignmentParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setTeamLeadAssignment, arg.Name, arg.LeadAssignment)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setTeamMemberRole = `-- name: SetTeamMemberRole :execrows
+UPDATE team_memberships SET role = $3 WHERE team_name = $1 AND user_id = $2
+`
+
+type SetTeamMemberRoleParams struct {
+	TeamName string `json:"team_name"`
+	UserID   string `json:"user_id"`
+	Role     string `json:"role"`
+}
+
+func (q *Queries) SetTeamMemberRole(ctx context.Context, arg SetTeamMemberRoleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setTeamMemberRole, arg.TeamName, arg.UserID, arg.Role)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setTeamParent = `-- name: SetTeamParent :execrows
+UPDATE teams SET parent_name = $2 WHERE name = $1
+`
+
+type SetTeamParentParams struct {
+	Name       string      `json:"name"`
+	ParentName pgtype.Text `json:"parent_name"`
+}
+
+func (q *Queries) SetTeamParent(ctx context.Context, arg SetTeamParentParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setTeamParent, arg.Name, arg.ParentName)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setTeamWorkingHours = `-- name: SetTeamWorkingHours :exec
+UPDATE teams SET timezone = $2, work_start = $3, work_end = $4, work_days = $5 WHERE name = $1
+`
+
+type SetTeamWorkingHoursParams struct {
+	Name      string      `json:"name"`
+	Timezone  pgtype.Text `json:"timezone"`
+	WorkStart pgtype.Text `json:"work_start"`
+	WorkEnd   pgtype.Text `json:"work_end"`
+	WorkDays  []string    `json:"work_days"`
+}
+
+func (q *Queries) SetTeamWorkingHours(ctx context.Context, arg SetTeamWorkingHoursParams) error {
+	_, err := q.db.Exec(ctx, setTeamWorkingHours,
+		arg.Name,
+		arg.Timezone,
+		arg.WorkStart,
+		arg.WorkEnd,
+		arg.WorkDays,
+	)
+	return err
+}
+
 const setUserActive = `-- name: SetUserActive :exec
 UPDATE users SET is_active = $2 WHERE id = $1
 `
@@ -305,6 +2084,87 @@ func (q *Queries) SetUserActive(ctx context.Context, arg SetUserActiveParams) er
 	return err
 }
 
+const setUserEmail = `-- name: SetUserEmail :exec
+UPDATE users SET email = $2, email_opt_out = $3 WHERE id = $1
+`
+
+type SetUserEmailParams struct {
+	ID          string      `json:"id"`
+	Email       pgtype.Text `json:"email"`
+	EmailOptOut bool        `json:"email_opt_out"`
+}
+
+func (q *Queries) SetUserEmail(ctx context.Context, arg SetUserEmailParams) error {
+	_, err := q.db.Exec(ctx, setUserEmail, arg.ID, arg.Email, arg.EmailOptOut)
+	return err
+}
+
+const setUserSeniority = `-- name: SetUserSeniority :exec
+UPDATE users SET seniority = $2 WHERE id = $1
+`
+
+type SetUserSeniorityParams struct {
+	ID        string `json:"id"`
+	Seniority string `json:"seniority"`
+}
+
+func (q *Queries) SetUserSeniority(ctx context.Context, arg SetUserSeniorityParams) error {
+	_, err := q.db.Exec(ctx, setUserSeniority, arg.ID, arg.Seniority)
+	return err
+}
+
+const setUserWorkingHours = `-- name: SetUserWorkingHours :exec
+UPDATE users SET timezone = $2, work_start = $3, work_end = $4, work_days = $5 WHERE id = $1
+`
+
+type SetUserWorkingHoursParams struct {
+	ID        string      `json:"id"`
+	Timezone  pgtype.Text `json:"timezone"`
+	WorkStart pgtype.Text `json:"work_start"`
+	WorkEnd   pgtype.Text `json:"work_end"`
+	WorkDays  []string    `json:"work_days"`
+}
+
+func (q *Queries) SetUserWorkingHours(ctx context.Context, arg SetUserWorkingHoursParams) error {
+	_, err := q.db.Exec(ctx, setUserWorkingHours,
+		arg.ID,
+		arg.Timezone,
+		arg.WorkStart,
+		arg.WorkEnd,
+		arg.WorkDays,
+	)
+	return err
+}
+
+const takeRateLimitToken = `-- name: TakeRateLimitToken :one
+INSERT INTO rate_limit_buckets AS b (key, tokens, allowed, updated_at)
+VALUES ($1, $2::float8 - 1, true, NOW())
+ON CONFLICT (key) DO UPDATE SET
+    allowed = LEAST($2::float8, b.tokens + EXTRACT(EPOCH FROM NOW() - b.updated_at) * $3::float8) >= 1,
+    tokens = LEAST($2::float8, b.tokens + EXTRACT(EPOCH FROM NOW() - b.updated_at) * $3::float8)
+        - CASE WHEN LEAST($2::float8, b.tokens + EXTRACT(EPOCH FROM NOW() - b.updated_at) * $3::float8) >= 1 THEN 1 ELSE 0 END,
+    updated_at = NOW()
+RETURNING tokens, allowed
+`
+
+type TakeRateLimitTokenParams struct {
+	Key   string  `json:"key"`
+	Burst float64 `json:"burst"`
+	Rate  float64 `json:"rate"`
+}
+
+type TakeRateLimitTokenRow struct {
+	Tokens  float64 `json:"tokens"`
+	Allowed bool    `json:"allowed"`
+}
+
+func (q *Queries) TakeRateLimitToken(ctx context.Context, arg TakeRateLimitTokenParams) (TakeRateLimitTokenRow, error) {
+	row := q.db.QueryRow(ctx, takeRateLimitToken, arg.Key, arg.Burst, arg.Rate)
+	var i TakeRateLimitTokenRow
+	err := row.Scan(&i.Tokens, &i.Allowed)
+	return i, err
+}
+
 const updatePRReviewers = `-- name: UpdatePRReviewers :exec
 UPDATE pull_requests
 SET assigned_reviewers = $2
@@ -320,3 +2180,122 @@ func (q *Queries) UpdatePRReviewers(ctx context.Context, arg UpdatePRReviewersPa
 	_, err := q.db.Exec(ctx, updatePRReviewers, arg.ID, arg.AssignedReviewers)
 	return err
 }
+
+const updateRepository = `-- name: UpdateRepository :execrows
+UPDATE repositories SET
+    name = $2,
+    team_name = $3,
+    reviewer_count = $4,
+    strategy = $5,
+    require_owner = $6,
+    excluded_users = $7
+WHERE id = $1
+`
+
+type UpdateRepositoryParams struct {
+	ID            string      `json:"id"`
+	Name          string      `json:"name"`
+	TeamName      string      `json:"team_name"`
+	ReviewerCount pgtype.Int4 `json:"reviewer_count"`
+	Strategy      pgtype.Text `json:"strategy"`
+	RequireOwner  bool        `json:"require_owner"`
+	ExcludedUsers []string    `json:"excluded_users"`
+}
+
+func (q *Queries) UpdateRepository(ctx context.Context, arg UpdateRepositoryParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateRepository,
+		arg.ID,
+		arg.Name,
+		arg.TeamName,
+		arg.ReviewerCount,
+		arg.Strategy,
+		arg.RequireOwner,
+		arg.ExcludedUsers,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const upsertCodeowners = `-- name: UpsertCodeowners :exec
+INSERT INTO codeowners (team_name, repository_id, content)
+VALUES ($1, $2, $3)
+ON CONFLICT (team_name, repository_id) DO UPDATE SET
+    content = EXCLUDED.content,
+    updated_at = NOW()
+`
+
+type UpsertCodeownersParams struct {
+	TeamName     string `json:"team_name"`
+	RepositoryID string `json:"repository_id"`
+	Content      string `json:"content"`
+}
+
+func (q *Queries) UpsertCodeowners(ctx context.Context, arg UpsertCodeownersParams) error {
+	_, err := q.db.Exec(ctx, upsertCodeowners, arg.TeamName, arg.RepositoryID, arg.Content)
+	return err
+}
+
+const upsertStalePolicy = `-- name: UpsertStalePolicy :exec
+INSERT INTO stale_policies (team_name, stale_after_hours, max_reassignments, dry_run)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (team_name) DO UPDATE SET
+    stale_after_hours = EXCLUDED.stale_after_hours,
+    max_reassignments = EXCLUDED.max_reassignments,
+    dry_run = EXCLUDED.dry_run
+`
+
+type UpsertStalePolicyParams struct {
+	TeamName         string `json:"team_name"`
+	StaleAfterHours  int32  `json:"stale_after_hours"`
+	MaxReassignments int32  `json:"max_reassignments"`
+	DryRun           bool   `json:"dry_run"`
+}
+
+func (q *Queries) UpsertStalePolicy(ctx context.Context, arg UpsertStalePolicyParams) error {
+	_, err := q.db.Exec(ctx, upsertStalePolicy,
+		arg.TeamName,
+		arg.StaleAfterHours,
+		arg.MaxReassignments,
+		arg.DryRun,
+	)
+	return err
+}
+
+const upsertTeamRules = `-- name: UpsertTeamRules :exec
+INSERT INTO team_rules (team_name, source)
+VALUES ($1, $2)
+ON CONFLICT (team_name) DO UPDATE SET
+    source = EXCLUDED.source,
+    updated_at = NOW()
+`
+
+type UpsertTeamRulesParams struct {
+	TeamName string `json:"team_name"`
+	Source   string `json:"source"`
+}
+
+func (q *Queries) UpsertTeamRules(ctx context.Context, arg UpsertTeamRulesParams) error {
+	_, err := q.db.Exec(ctx, upsertTeamRules, arg.TeamName, arg.Source)
+	return err
+}
+
+const upsertTeamSLA = `-- name: UpsertTeamSLA :exec
+INSERT INTO team_slas (team_name, first_response_hours, auto_reassign)
+VALUES ($1, $2, $3)
+ON CONFLICT (team_name) DO UPDATE SET
+    first_response_hours = EXCLUDED.first_response_hours,
+    auto_reassign = EXCLUDED.auto_reassign
+`
+
+type UpsertTeamSLAParams struct {
+	TeamName           string `json:"team_name"`
+	FirstResponseHours int32  `json:"first_response_hours"`
+	AutoReassign       bool   `json:"auto_reassign"`
+}
+
+func (q *Queries) UpsertTeamSLA(ctx context.Context, arg UpsertTeamSLAParams) error {
+	_, err := q.db.Exec(ctx, upsertTeamSLA, arg.TeamName, arg.FirstResponseHours, arg.AutoReassign)
+	return err
+}
@@ -1,33 +1,47 @@
 -- name: CreateTeam :exec
-INSERT INTO teams (name) VALUES ($1) ON CONFLICT (name) DO NOTHING;
+INSERT INTO teams (name, parent_name) VALUES ($1, $2) ON CONFLICT (name) DO NOTHING;
 
 -- name: GetTeam :one
-SELECT name FROM teams WHERE name = $1;
+SELECT name, parent_name, lead_assignment FROM teams WHERE name = $1;
 
 -- name: GetUsersByTeam :many
-SELECT id, username, team_name, is_active FROM users WHERE team_name = $1;
+SELECT u.id, u.username, u.is_active, u.email, u.email_opt_out, u.timezone, u.work_start, u.work_end, u.work_days, u.seniority, m.role
+FROM users u
+JOIN team_memberships m ON m.user_id = u.id
+WHERE m.team_name = $1
+ORDER BY m.joined_at, u.id;
 
 -- name: CreateUser :exec
-INSERT INTO users (id, username, team_name, is_active)
-VALUES ($1, $2, $3, $4)
+INSERT INTO users (id, username, is_active)
+VALUES ($1, $2, $3)
     ON CONFLICT (id) DO UPDATE SET
     username = EXCLUDED.username,
-                            team_name = EXCLUDED.team_name,
                             is_active = EXCLUDED.is_active;
 
 -- name: GetUser :one
-SELECT id, username, team_name, is_active FROM users WHERE id = $1;
+SELECT id, username, is_active, email, email_opt_out, timezone, work_start, work_end, work_days, seniority
+FROM users WHERE id = $1;
+
+-- name: GetUsers :many
+SELECT id, username, is_active, email, email_opt_out, timezone, work_start, work_end, work_days, seniority
+FROM users WHERE id = ANY($1::text[]);
+
+-- name: GetUsers :many
+SELECT id, username, is_active, email, email_opt_out, timezone, work_start, work_end, work_days, seniority
+FROM users WHERE id = ANY($1::text[]);
 
 -- name: GetActiveUsersInTeamExcluding :many
-SELECT id FROM users
-WHERE team_name = $1 AND is_active = true AND id != $2;
+SELECT u.id FROM users u
+JOIN team_memberships m ON m.user_id = u.id
+WHERE m.team_name = $1 AND u.is_active = true AND u.id != $2
+ORDER BY u.id;
 
 -- name: CreatePR :exec
-INSERT INTO pull_requests (id, name, author_id, status, assigned_reviewers)
-VALUES ($1, $2, $3, 'OPEN', $4);
+INSERT INTO pull_requests (id, name, author_id, status, assigned_reviewers, labels, repository_id, assignment_seed, team_name, created_at)
+VALUES ($1, $2, $3, 'OPEN', $4, $5, $6, $7, $8, $9);
 
 -- name: GetPR :one
-SELECT id, name, author_id, status, assigned_reviewers, created_at, merged_at
+SELECT id, name, author_id, status, assigned_reviewers, created_at, merged_at, labels, repository_id, assignment_seed, team_name
 FROM pull_requests WHERE id = $1;
 
 -- name: MergePR :exec
@@ -48,7 +62,8 @@ WHERE $1 = ANY(assigned_reviewers);
 -- name: GetPRCountByReviewer :many
 SELECT unnest(assigned_reviewers) as reviewer_id, COUNT(*) as cnt
 FROM pull_requests
-WHERE status = 'OPEN'
+WHERE status = 'OPEN' AND (@team_name::text = ''
+    OR team_name IN (SELECT team_name FROM team_ancestors WHERE ancestor_name = @team_name))
 GROUP BY reviewer_id;
 
 -- name: DeactivateUsers :exec
@@ -59,4 +74,432 @@ SELECT id, assigned_reviewers FROM pull_requests
 WHERE status = 'OPEN' AND $1 && assigned_reviewers;
 
 -- name: SetUserActive :exec
-UPDATE users SET is_active = $2 WHERE id = $1;
\ No newline at end of file
+UPDATE users SET is_active = $2 WHERE id = $1;
+
+-- name: SetUserSeniority :exec
+UPDATE users SET seniority = $2 WHERE id = $1;
+
+-- name: ReserveIdempotencyKey :execrows
+INSERT INTO idempotency_keys (key, path, request_hash, expires_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (key, path) DO UPDATE SET
+    request_hash = EXCLUDED.request_hash,
+    status_code = NULL,
+    response_body = NULL,
+    completed = false,
+    created_at = NOW(),
+    expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at <= NOW();
+
+-- name: GetIdempotencyKey :one
+SELECT key, path, request_hash, status_code, response_body, completed, created_at, expires_at
+FROM idempotency_keys
+WHERE key = $1 AND path = $2 AND expires_at > NOW();
+
+-- name: CompleteIdempotencyKey :exec
+UPDATE idempotency_keys
+SET status_code = $3, response_body = $4, completed = true
+WHERE key = $1 AND path = $2;
+
+-- name: DeleteIdempotencyKey :exec
+DELETE FROM idempotency_keys WHERE key = $1 AND path = $2;
+
+-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM idempotency_keys WHERE expires_at <= NOW();
+
+-- name: TakeRateLimitToken :one
+INSERT INTO rate_limit_buckets AS b (key, tokens, allowed, updated_at)
+VALUES (@key, @burst::float8 - 1, true, NOW())
+ON CONFLICT (key) DO UPDATE SET
+    allowed = LEAST(@burst::float8, b.tokens + EXTRACT(EPOCH FROM NOW() - b.updated_at) * @rate::float8) >= 1,
+    tokens = LEAST(@burst::float8, b.tokens + EXTRACT(EPOCH FROM NOW() - b.updated_at) * @rate::float8)
+        - CASE WHEN LEAST(@burst::float8, b.tokens + EXTRACT(EPOCH FROM NOW() - b.updated_at) * @rate::float8) >= 1 THEN 1 ELSE 0 END,
+    updated_at = NOW()
+RETURNING tokens, allowed;
+
+-- name: DeleteStaleRateLimitBuckets :execrows
+DELETE FROM rate_limit_buckets WHERE updated_at < $1;
+
+-- name: CreateEvent :one
+INSERT INTO events (type, pull_request_id, team_name, user_ids, payload)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, created_at;
+
+-- name: GetEvent :one
+SELECT id, type, pull_request_id, team_name, user_ids, payload, created_at
+FROM events WHERE id = $1;
+
+-- name: ListEventsForUser :many
+SELECT id, type, pull_request_id, team_name, user_ids, payload, created_at
+FROM events
+WHERE id > @after_id AND @user_id::text = ANY(user_ids)
+ORDER BY id
+LIMIT @max_events;
+
+-- name: ListEventsForTeam :many
+SELECT id, type, pull_request_id, team_name, user_ids, payload, created_at
+FROM events
+WHERE id > @after_id AND team_name = @team_name
+ORDER BY id
+LIMIT @max_events;
+
+-- name: DeleteEventsBefore :execrows
+DELETE FROM events WHERE created_at < $1;
+
+-- name: GetSlackUserID :one
+SELECT slack_user_id FROM slack_users WHERE user_id = $1;
+
+-- name: SetSlackUserID :exec
+INSERT INTO slack_users (user_id, slack_user_id)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE SET slack_user_id = EXCLUDED.slack_user_id;
+
+-- name: SetUserEmail :exec
+UPDATE users SET email = $2, email_opt_out = $3 WHERE id = $1;
+
+-- name: ListDigestRecipients :many
+SELECT u.id, u.username, u.email::text AS email,
+       COUNT(pr.id) AS open_reviews,
+       MIN(pr.created_at)::timestamptz AS oldest_created_at,
+       d.sent_on,
+       CASE WHEN u.timezone IS NULL THEN t.timezone ELSE u.timezone END AS timezone,
+       CASE WHEN u.timezone IS NULL THEN t.work_start ELSE u.work_start END AS work_start,
+       CASE WHEN u.timezone IS NULL THEN t.work_end ELSE u.work_end END AS work_end,
+       CASE WHEN u.timezone IS NULL THEN t.work_days ELSE u.work_days END::text[] AS work_days
+FROM users u
+JOIN team_memberships m ON m.user_id = u.id AND m.is_primary
+JOIN teams t ON t.name = m.team_name
+JOIN pull_requests pr ON u.id = ANY(pr.assigned_reviewers) AND pr.status = 'OPEN'
+LEFT JOIN email_digests d ON d.user_id = u.id
+WHERE u.is_active AND NOT u.email_opt_out AND u.email IS NOT NULL
+GROUP BY u.id, t.name, d.user_id
+ORDER BY u.id;
+
+-- name: ClaimEmailDigest :execrows
+INSERT INTO email_digests (user_id, sent_on) VALUES (@user_id, @today::date)
+ON CONFLICT (user_id) DO UPDATE SET sent_on = EXCLUDED.sent_on
+WHERE email_digests.sent_on < EXCLUDED.sent_on;
+
+-- name: ReleaseEmailDigest :exec
+DELETE FROM email_digests WHERE user_id = $1 AND sent_on = $2;
+
+-- name: UpsertTeamSLA :exec
+INSERT INTO team_slas (team_name, first_response_hours, auto_reassign)
+VALUES ($1, $2, $3)
+ON CONFLICT (team_name) DO UPDATE SET
+    first_response_hours = EXCLUDED.first_response_hours,
+    auto_reassign = EXCLUDED.auto_reassign;
+
+-- name: GetTeamSLA :one
+SELECT sla.team_name, sla.first_response_hours, sla.auto_reassign
+FROM team_ancestors a JOIN team_slas sla ON sla.team_name = a.ancestor_name
+WHERE a.team_name = $1
+ORDER BY a.depth LIMIT 1;
+
+-- name: AddReviewAssignments :exec
+INSERT INTO review_assignments (pull_request_id, reviewer_id)
+SELECT @pull_request_id::text, unnest(@reviewer_ids::text[])
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteReviewAssignment :exec
+DELETE FROM review_assignments WHERE pull_request_id = $1 AND reviewer_id = $2;
+
+-- name: RespondToReview :execrows
+UPDATE review_assignments SET responded_at = NOW()
+WHERE pull_request_id = $1 AND reviewer_id = $2 AND responded_at IS NULL;
+
+-- name: EscalateReview :execrows
+UPDATE review_assignments SET escalated_at = NOW()
+WHERE pull_request_id = $1 AND reviewer_id = $2 AND escalated_at IS NULL;
+
+-- name: ClaimStaleReview :execrows
+UPDATE review_assignments SET assigned_at = assigned_at
+WHERE pull_request_id = $1 AND reviewer_id = $2 AND assigned_at = $3 AND responded_at IS NULL;
+
+-- name: ListPendingReviews :many
+WITH effective AS (
+    SELECT DISTINCT ON (a.team_name) a.team_name, s.first_response_hours, s.auto_reassign
+    FROM team_ancestors a JOIN team_slas s ON s.team_name = a.ancestor_name
+    ORDER BY a.team_name, a.depth
+)
+SELECT ra.pull_request_id, pr.name AS pull_request_name, sla.team_name,
+       ra.reviewer_id, ra.assigned_at, ra.escalated_at,
+       sla.first_response_hours, sla.auto_reassign
+FROM review_assignments ra
+JOIN pull_requests pr ON pr.id = ra.pull_request_id AND pr.status = 'OPEN'
+JOIN effective sla ON sla.team_name = pr.team_name
+WHERE ra.responded_at IS NULL AND (@team_name::text = ''
+    OR sla.team_name IN (SELECT team_name FROM team_ancestors WHERE ancestor_name = @team_name))
+ORDER BY ra.assigned_at;
+
+-- name: UpsertStalePolicy :exec
+INSERT INTO stale_policies (team_name, stale_after_hours, max_reassignments, dry_run)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (team_name) DO UPDATE SET
+    stale_after_hours = EXCLUDED.stale_after_hours,
+    max_reassignments = EXCLUDED.max_reassignments,
+    dry_run = EXCLUDED.dry_run;
+
+-- name: GetStalePolicy :one
+SELECT p.team_name, p.stale_after_hours, p.max_reassignments, p.dry_run
+FROM team_ancestors a JOIN stale_policies p ON p.team_name = a.ancestor_name
+WHERE a.team_name = $1
+ORDER BY a.depth LIMIT 1;
+
+-- name: ListStaleCandidates :many
+WITH effective AS (
+    SELECT DISTINCT ON (a.team_name) a.team_name, s.stale_after_hours, s.max_reassignments, s.dry_run
+    FROM team_ancestors a JOIN stale_policies s ON s.team_name = a.ancestor_name
+    ORDER BY a.team_name, a.depth
+)
+SELECT ra.pull_request_id, ra.reviewer_id, ra.assigned_at,
+       p.team_name, p.stale_after_hours, p.max_reassignments, p.dry_run,
+       (SELECT COUNT(*) FROM reassignments r
+        WHERE r.pull_request_id = ra.pull_request_id AND r.reason = 'stale') AS stale_reassignments
+FROM review_assignments ra
+JOIN pull_requests pr ON pr.id = ra.pull_request_id AND pr.status = 'OPEN'
+JOIN effective p ON p.team_name = pr.team_name
+WHERE ra.responded_at IS NULL AND (@team_name::text = ''
+    OR p.team_name IN (SELECT team_name FROM team_ancestors WHERE ancestor_name = @team_name))
+ORDER BY ra.assigned_at;
+
+-- name: CreateReassignment :exec
+INSERT INTO reassignments (pull_request_id, old_reviewer_id, new_reviewer_id, reason)
+VALUES ($1, $2, $3, $4);
+
+-- name: ListReassignments :many
+SELECT id, pull_request_id, old_reviewer_id, new_reviewer_id, reason, created_at
+FROM reassignments WHERE pull_request_id = $1
+ORDER BY id;
+
+-- name: SetUserWorkingHours :exec
+UPDATE users SET timezone = $2, work_start = $3, work_end = $4, work_days = $5 WHERE id = $1;
+
+-- name: SetTeamWorkingHours :exec
+UPDATE teams SET timezone = $2, work_start = $3, work_end = $4, work_days = $5 WHERE name = $1;
+
+-- name: GetTeamWorkingHours :one
+SELECT t.timezone, t.work_start, t.work_end, t.work_days
+FROM team_ancestors a JOIN teams t ON t.name = a.ancestor_name
+WHERE a.team_name = $1
+ORDER BY t.timezone IS NULL, a.depth LIMIT 1;
+
+-- name: UpsertCodeowners :exec
+INSERT INTO codeowners (team_name, repository_id, content)
+VALUES ($1, $2, $3)
+ON CONFLICT (team_name, repository_id) DO UPDATE SET
+    content = EXCLUDED.content,
+    updated_at = NOW();
+
+-- name: GetCodeowners :one
+SELECT team_name, repository_id, content, updated_at FROM codeowners
+WHERE team_name = $1 AND repository_id = $2;
+
+-- name: ListActiveOwners :many
+SELECT id FROM users
+WHERE is_active AND (id = ANY(@handles::text[])
+    OR ((username = ANY(@handles::text[]) OR email = ANY(@handles::text[]))
+        AND id IN (SELECT user_id FROM team_memberships
+                   WHERE team_name = @team_name OR team_name = ANY(@teams::text[])))
+    OR id IN (SELECT user_id FROM team_memberships WHERE team_name = ANY(@teams::text[])))
+ORDER BY id;
+
+-- name: ListUserTags :many
+SELECT user_id, tag FROM user_tags
+WHERE user_id = ANY(@user_ids::text[])
+ORDER BY user_id, tag;
+
+-- name: AddUserTags :exec
+INSERT INTO user_tags (user_id, tag)
+SELECT @user_id::text, unnest(@tags::text[])
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveUserTags :exec
+DELETE FROM user_tags WHERE user_id = @user_id AND tag = ANY(@tags::text[]);
+
+-- name: RemoveUserTagsExcept :exec
+DELETE FROM user_tags WHERE user_id = @user_id AND NOT (tag = ANY(@tags::text[]));
+
+-- name: ListTags :many
+SELECT ut.tag, COUNT(*) AS users
+FROM user_tags ut
+WHERE @team_name::text = ''
+    OR ut.user_id IN (SELECT user_id FROM team_memberships WHERE team_name = @team_name)
+GROUP BY ut.tag
+ORDER BY ut.tag;
+
+-- name: CountOpenReviews :many
+SELECT r.reviewer_id::text AS reviewer_id, COUNT(*) AS open_reviews
+FROM pull_requests pr, unnest(pr.assigned_reviewers) AS r(reviewer_id)
+WHERE pr.status = 'OPEN' AND r.reviewer_id = ANY(@user_ids::text[])
+GROUP BY r.reviewer_id;
+
+-- name: ListCandidateUsers :many
+SELECT id, seniority FROM users WHERE id = ANY(@user_ids::text[]);
+
+-- name: CreateRepository :execrows
+INSERT INTO repositories (id, name, team_name, reviewer_count, strategy, require_owner, excluded_users)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (id) DO NOTHING;
+
+-- name: GetRepository :one
+SELECT * FROM repositories WHERE id = $1;
+
+-- name: ListRepositories :many
+SELECT * FROM repositories
+WHERE @team_name::text = '' OR team_name = @team_name
+ORDER BY id;
+
+-- name: UpdateRepository :execrows
+UPDATE repositories SET
+    name = $2,
+    team_name = $3,
+    reviewer_count = $4,
+    strategy = $5,
+    require_owner = $6,
+    excluded_users = $7
+WHERE id = $1;
+
+-- name: DeleteRepository :execrows
+DELETE FROM repositories WHERE id = $1;
+
+-- name: UpsertTeamRules :exec
+INSERT INTO team_rules (team_name, source)
+VALUES ($1, $2)
+ON CONFLICT (team_name) DO UPDATE SET
+    source = EXCLUDED.source,
+    updated_at = NOW();
+
+-- name: GetTeamRules :one
+SELECT r.team_name, r.source, r.updated_at
+FROM team_ancestors a JOIN team_rules r ON r.team_name = a.ancestor_name
+WHERE a.team_name = $1
+ORDER BY a.depth LIMIT 1;
+
+-- name: ListUsers :many
+SELECT id, username, is_active, email, email_opt_out, timezone, work_start, work_end, work_days, seniority
+FROM users ORDER BY id;
+
+-- name: ListPullRequestsSince :many
+SELECT id, name, author_id, status, assigned_reviewers, created_at, merged_at, labels, repository_id, assignment_seed, team_name
+FROM pull_requests WHERE created_at >= $1
+ORDER BY created_at, id;
+
+-- name: ListReassignmentsSince :many
+SELECT r.id, r.pull_request_id, r.old_reviewer_id, r.new_reviewer_id, r.reason, r.created_at
+FROM reassignments r
+JOIN pull_requests pr ON pr.id = r.pull_request_id
+WHERE pr.created_at >= $1
+ORDER BY r.id;
+
+-- name: ListPairings :many
+SELECT reviewer_id, assigned_at
+FROM pairings
+WHERE author_id = @author_id AND reviewer_id = ANY(@user_ids::text[]) AND assigned_at >= @since
+ORDER BY assigned_at;
+
+-- name: RenameTeam :execrows
+WITH moved_events AS (
+    UPDATE events SET team_name = @new_name WHERE team_name = @name
+)
+UPDATE teams SET name = @new_name WHERE name = @name;
+
+-- name: ListTeamMemberIDs :many
+SELECT user_id FROM team_memberships WHERE team_name = $1 ORDER BY user_id;
+
+-- name: DeleteTeam :execrows
+DELETE FROM teams WHERE name = $1;
+
+-- name: RemoveTeamMember :execrows
+DELETE FROM team_memberships WHERE user_id = $1 AND team_name = $2;
+
+-- name: ListOpenReviewsInTeam :many
+SELECT pr.id FROM pull_requests pr
+WHERE pr.status = 'OPEN' AND @user_id::text = ANY(pr.assigned_reviewers) AND pr.team_name = @team_name
+ORDER BY pr.id;
+
+-- name: ListTeamOpenPRs :many
+SELECT pr.id, pr.author_id, pr.assigned_reviewers FROM pull_requests pr
+WHERE pr.status = 'OPEN' AND pr.team_name = $1
+ORDER BY pr.id;
+
+-- name: AddTeamMembership :exec
+INSERT INTO team_memberships (user_id, team_name, is_primary)
+VALUES (@user_id, @team_name, NOT EXISTS (
+    SELECT 1 FROM team_memberships WHERE user_id = @user_id AND is_primary
+))
+ON CONFLICT (user_id, team_name) DO NOTHING;
+
+-- name: ClearPrimaryTeam :exec
+UPDATE team_memberships SET is_primary = false
+WHERE user_id = $1 AND team_name <> $2 AND is_primary;
+
+-- name: SetPrimaryTeam :exec
+UPDATE team_memberships SET is_primary = true WHERE user_id = $1 AND team_name = $2;
+
+-- name: PromotePrimaryTeams :exec
+UPDATE team_memberships m SET is_primary = true
+FROM (
+    SELECT DISTINCT ON (user_id) user_id, team_name FROM team_memberships
+    WHERE user_id = ANY(@user_ids::text[])
+    ORDER BY user_id, joined_at, team_name
+) oldest
+WHERE m.user_id = oldest.user_id AND m.team_name = oldest.team_name
+    AND NOT EXISTS (SELECT 1 FROM team_memberships p WHERE p.user_id = m.user_id AND p.is_primary);
+
+-- name: ListTeamMemberships :many
+SELECT user_id, team_name, is_primary FROM team_memberships
+WHERE user_id = ANY(@user_ids::text[])
+ORDER BY user_id, is_primary DESC, joined_at, team_name;
+
+-- name: SetTeamParent :execrows
+UPDATE teams SET parent_name = $2 WHERE name = $1;
+
+-- name: ListTeamAncestors :many
+SELECT ancestor_name FROM team_ancestors WHERE team_name = $1 ORDER BY depth;
+
+-- name: ListSiblingTeams :many
+SELECT s.name FROM teams t
+JOIN teams s ON s.parent_name = t.parent_name AND s.name <> t.name
+WHERE t.name = $1
+ORDER BY s.name;
+
+-- name: GetTeamSubtree :many
+WITH RECURSIVE subtree (name, parent_name, depth) AS (
+    SELECT name, parent_name, 0 FROM teams WHERE name = $1
+    UNION ALL
+    SELECT t.name, t.parent_name, s.depth + 1
+    FROM teams t JOIN subtree s ON t.parent_name = s.name
+) CYCLE name SET is_cycle USING path
+SELECT s.name, s.parent_name, s.depth,
+       (SELECT COUNT(*) FROM team_memberships m WHERE m.team_name = s.name) AS members,
+       (SELECT COUNT(*) FROM pull_requests pr WHERE pr.team_name = s.name AND pr.status = 'OPEN') AS open_prs,
+       (SELECT COUNT(DISTINCT m.user_id) FROM team_ancestors d
+        JOIN team_memberships m ON m.team_name = d.team_name
+        WHERE d.ancestor_name = s.name) AS total_members,
+       (SELECT COUNT(*) FROM team_ancestors d
+        JOIN pull_requests pr ON pr.team_name = d.team_name AND pr.status = 'OPEN'
+        WHERE d.ancestor_name = s.name) AS total_open_prs
+FROM subtree s
+WHERE NOT s.is_cycle
+ORDER BY s.depth, s.name;
+
+-- name: SetTeamMemberRole :execrows
+UPDATE team_memberships SET role = $3 WHERE team_name = $1 AND user_id = $2;
+
+-- name: SetTeamLeadAssignment :execrows
+UPDATE teams SET lead_assignment = $2 WHERE name = $1;
+
+-- name: ListTeamLeads :many
+SELECT user_id FROM team_memberships
+WHERE team_name = $1 AND role = 'lead'
+ORDER BY user_id;
+
+-- name: ListTeamRoles :many
+SELECT DISTINCT m.role FROM team_ancestors a
+JOIN team_memberships m ON m.team_name = a.ancestor_name
+WHERE a.team_name = $1 AND m.user_id = $2
+ORDER BY m.role;
+
+-- name: ListTeams :many
+SELECT name FROM teams ORDER BY name;
@@ -3,24 +3,131 @@ package store
 import (
 	"avito-pr-reviewer/internal/model"
 	"context"
+	"time"
 )
 
 type Repository interface {
-	CreateTeam(ctx context.Context, name string) error
+	CreateTeam(ctx context.Context, name, parent string) error
 	GetTeam(ctx context.Context, name string) (*model.Team, error)
-	CreateUser(ctx context.Context, id, username, teamName string, isActive bool) error
+	ListTeams(ctx context.Context) ([]string, error)
+	SetTeamParent(ctx context.Context, name, parent string) (bool, error)
+	ListTeamAncestors(ctx context.Context, name string) ([]string, error)
+	ListSiblingTeams(ctx context.Context, name string) ([]string, error)
+	GetTeamTree(ctx context.Context, name string) (*model.TeamTree, error)
+	SetTeamLeadAssignment(ctx context.Context, name string, a model.LeadAssignment) (bool, error)
+	SetTeamMemberRole(ctx context.Context, teamName, userID string, role model.TeamRole) (bool, error)
+	ListTeamLeads(ctx context.Context, teamName string) ([]string, error)
+	ListTeamRoles(ctx context.Context, teamName, userID string) ([]model.TeamRole, error)
+	RenameTeam(ctx context.Context, name, newName string) (bool, error)
+	DeleteTeam(ctx context.Context, name string) (bool, error)
+	RemoveTeamMember(ctx context.Context, teamName, userID string) (bool, error)
+	ListOpenReviewsInTeam(ctx context.Context, userID, teamName string) ([]string, error)
+	ListTeamOpenPRs(ctx context.Context, teamName string) ([]model.PullRequest, error)
+	CreateUser(ctx context.Context, id, username string, isActive bool) error
+	AddTeamMember(ctx context.Context, teamName, userID string, primary bool) error
 	GetUser(ctx context.Context, id string) (*model.User, error)
+	// GetUsers returns those of ids that exist, in no particular order.
+	GetUsers(ctx context.Context, ids []string) ([]model.User, error)
 	GetActiveUsersInTeamExcluding(ctx context.Context, teamName, excludeUserID string) ([]string, error)
-	CreatePR(ctx context.Context, id, name, authorID string, reviewers []string) error
+	CreatePR(ctx context.Context, pr *model.PullRequest) error
 	GetPR(ctx context.Context, id string) (*model.PullRequest, error)
 	MergePR(ctx context.Context, id string) error
 	UpdatePRReviewers(ctx context.Context, id string, reviewers []string) error
 	GetPRsByReviewer(ctx context.Context, reviewerID string) ([]model.PullRequest, error)
-	GetPRCountByReviewer(ctx context.Context) (map[string]int64, error)
+	GetPRCountByReviewer(ctx context.Context, teamName string) (map[string]int64, error)
 	DeactivateUsers(ctx context.Context, ids []string) error
 	GetOpenPRsByReviewers(ctx context.Context, reviewerIDs []string) ([]struct {
 		ID                string
 		AssignedReviewers []string
 	}, error)
 	SetUserActive(ctx context.Context, userID string, isActive bool) error
+	SetUserSeniority(ctx context.Context, userID string, seniority model.Seniority) error
+	CreateEvent(ctx context.Context, e *model.Event) error
+	ListEventsForUser(ctx context.Context, userID string, afterID int64, limit int) ([]model.Event, error)
+	ListEventsForTeam(ctx context.Context, teamName string, afterID int64, limit int) ([]model.Event, error)
+	SetSlackUserID(ctx context.Context, userID, slackUserID string) error
+	SetUserEmail(ctx context.Context, userID, email string, optOut bool) error
+	SetTeamSLA(ctx context.Context, sla model.TeamSLA) error
+	GetTeamSLA(ctx context.Context, teamName string) (*model.TeamSLA, error)
+	AddReviewAssignments(ctx context.Context, prID string, reviewerIDs []string) error
+	DeleteReviewAssignment(ctx context.Context, prID, reviewerID string) error
+	RespondToReview(ctx context.Context, prID, reviewerID string) (bool, error)
+	EscalateReview(ctx context.Context, prID, reviewerID string) (bool, error)
+	// ClaimStaleReview locks the unanswered assignment of reviewerID made at
+	// assignedAt until the transaction ends. It reports false if there is
+	// none, e.g. because another replica has already reassigned it.
+	ClaimStaleReview(ctx context.Context, prID, reviewerID string, assignedAt time.Time) (bool, error)
+	ListPendingReviews(ctx context.Context, teamName string) ([]model.PendingReview, error)
+	SetStalePolicy(ctx context.Context, p model.StalePolicy) error
+	GetStalePolicy(ctx context.Context, teamName string) (*model.StalePolicy, error)
+	ListStaleCandidates(ctx context.Context, teamName string) ([]model.StaleCandidate, error)
+	CreateReassignment(ctx context.Context, r *model.Reassignment) error
+	ListReassignments(ctx context.Context, prID string) ([]model.Reassignment, error)
+	SetUserWorkingHours(ctx context.Context, userID string, wh *model.WorkingHours) error
+	SetTeamWorkingHours(ctx context.Context, teamName string, wh *model.WorkingHours) error
+	GetTeamWorkingHours(ctx context.Context, teamName string) (*model.WorkingHours, error)
+	SetCodeowners(ctx context.Context, c *model.Codeowners) error
+	GetCodeowners(ctx context.Context, teamName, repositoryID string) (*model.Codeowners, error)
+	ListActiveOwners(ctx context.Context, teamName string, handles, teams []string) ([]string, error)
+	AddUserTags(ctx context.Context, userID string, tags []string) error
+	RemoveUserTags(ctx context.Context, userID string, tags []string) error
+	SetUserTags(ctx context.Context, userID string, tags []string) error
+	ListTags(ctx context.Context, teamName string) ([]model.TagCount, error)
+	GetCandidates(ctx context.Context, userIDs []string) ([]model.Candidate, error)
+	GetSeniorities(ctx context.Context, userIDs []string) (map[string]model.Seniority, error)
+	GetPairings(ctx context.Context, authorID string, userIDs []string, since time.Time) (map[string][]time.Time, error)
+	SetTeamRules(ctx context.Context, r *model.TeamRules) error
+	GetTeamRules(ctx context.Context, teamName string) (*model.TeamRules, error)
+	CreateRepository(ctx context.Context, r *model.Repository) (bool, error)
+	GetRepository(ctx context.Context, id string) (*model.Repository, error)
+	ListRepositories(ctx context.Context, teamName string) ([]model.Repository, error)
+	UpdateRepository(ctx context.Context, r *model.Repository) (bool, error)
+	DeleteRepository(ctx context.Context, id string) (bool, error)
+	// WithinTx runs fn with a Repository whose changes are committed together
+	// when fn returns nil and rolled back otherwise. Called on such a
+	// Repository it nests, and only fn's own changes are rolled back.
+	WithinTx(ctx context.Context, fn func(Repository) error) error
+}
+
+type IdempotencyStore interface {
+	ReserveIdempotencyKey(ctx context.Context, key, path, requestHash string, expiresAt time.Time) (bool, error)
+	GetIdempotencyKey(ctx context.Context, key, path string) (*model.IdempotencyRecord, error)
+	CompleteIdempotencyKey(ctx context.Context, key, path string, statusCode int, body []byte) error
+	DeleteIdempotencyKey(ctx context.Context, key, path string) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error)
+}
+
+type RateLimitStore interface {
+	TakeRateLimitToken(ctx context.Context, key string, rate, burst float64) (tokens float64, allowed bool, err error)
+	DeleteStaleRateLimitBuckets(ctx context.Context, before time.Time) (int64, error)
+}
+
+// EventSource delivers events committed by any replica.
+type EventSource interface {
+	GetEvent(ctx context.Context, id int64) (*model.Event, error)
+	ListenEvents(ctx context.Context, handle func(id int64)) error
+	DeleteEventsBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// SlackUserStore maps users to their Slack member IDs.
+type SlackUserStore interface {
+	GetSlackUserID(ctx context.Context, userID string) (string, error)
+}
+
+// UserStore looks up users, e.g. for their contact details.
+type UserStore interface {
+	GetUser(ctx context.Context, id string) (*model.User, error)
+}
+
+// HistoryStore exports past pull request activity for simulations.
+type HistoryStore interface {
+	GetHistory(ctx context.Context, since time.Time) (*model.History, error)
+}
+
+// DigestStore finds users with open reviews who may get a digest. ClaimEmailDigest
+// marks the digest for day as sent and reports false if another replica already did.
+type DigestStore interface {
+	ListDigestRecipients(ctx context.Context) ([]model.DigestEntry, error)
+	ClaimEmailDigest(ctx context.Context, userID string, day time.Time) (bool, error)
+	ReleaseEmailDigest(ctx context.Context, userID string, day time.Time) error
 }
@@ -2,8 +2,8 @@ package util
 
 import "math/rand"
 
-func Shuffle(slice []string) {
-	rand.Shuffle(len(slice), func(i, j int) {
+func Shuffle(rng *rand.Rand, slice []string) {
+	rng.Shuffle(len(slice), func(i, j int) {
 		slice[i], slice[j] = slice[j], slice[i]
 	})
 }
@@ -0,0 +1,13 @@
+CREATE TABLE idempotency_keys (
+    key TEXT NOT NULL,
+    path TEXT NOT NULL,
+    request_hash TEXT NOT NULL,
+    status_code INT,
+    response_body BYTEA,
+    completed BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (key, path)
+);
+
+CREATE INDEX idx_idempotency_keys_expires ON idempotency_keys(expires_at);
@@ -0,0 +1,3 @@
+DROP TRIGGER events_notify ON events;
+DROP FUNCTION notify_review_event();
+DROP TABLE events;
@@ -0,0 +1,24 @@
+CREATE TABLE events (
+    id BIGSERIAL PRIMARY KEY,
+    type TEXT NOT NULL,
+    pull_request_id TEXT NOT NULL,
+    team_name TEXT NOT NULL,
+    user_ids TEXT[] NOT NULL DEFAULT '{}',
+    payload JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_events_user_ids ON events USING GIN(user_ids);
+CREATE INDEX idx_events_team ON events(team_name, id);
+CREATE INDEX idx_events_created ON events(created_at);
+
+CREATE FUNCTION notify_review_event() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('review_events', NEW.id::text);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER events_notify
+    AFTER INSERT ON events
+    FOR EACH ROW EXECUTE FUNCTION notify_review_event();
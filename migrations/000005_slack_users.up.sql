@@ -0,0 +1,4 @@
+CREATE TABLE slack_users (
+    user_id TEXT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    slack_user_id TEXT NOT NULL
+);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS email_digests;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS email_opt_out,
+    DROP COLUMN IF EXISTS email;
@@ -0,0 +1,8 @@
+ALTER TABLE users
+    ADD COLUMN email TEXT,
+    ADD COLUMN email_opt_out BOOLEAN NOT NULL DEFAULT false;
+
+CREATE TABLE email_digests (
+    user_id TEXT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    sent_on DATE NOT NULL
+);
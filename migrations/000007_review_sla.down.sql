@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS review_assignments;
+DROP TABLE IF EXISTS team_slas;
@@ -0,0 +1,23 @@
+CREATE TABLE team_slas (
+    team_name TEXT PRIMARY KEY REFERENCES teams(name) ON DELETE CASCADE,
+    first_response_hours INT NOT NULL CHECK (first_response_hours > 0),
+    auto_reassign BOOLEAN NOT NULL DEFAULT false
+);
+
+CREATE TABLE review_assignments (
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    reviewer_id TEXT NOT NULL REFERENCES users(id),
+    assigned_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    responded_at TIMESTAMPTZ,
+    escalated_at TIMESTAMPTZ,
+    PRIMARY KEY (pull_request_id, reviewer_id)
+);
+
+CREATE INDEX idx_review_assignments_pending ON review_assignments(assigned_at) WHERE responded_at IS NULL;
+
+-- Reviews assigned before SLAs were tracked start their clock now; counting
+-- from the pull requests' creation would escalate all of them at once.
+INSERT INTO review_assignments (pull_request_id, reviewer_id, assigned_at)
+SELECT pr.id, r.reviewer_id, NOW()
+FROM pull_requests pr, unnest(pr.assigned_reviewers) AS r(reviewer_id)
+WHERE pr.status = 'OPEN';
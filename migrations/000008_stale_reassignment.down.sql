@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS reassignments;
+DROP TABLE IF EXISTS stale_policies;
@@ -0,0 +1,17 @@
+CREATE TABLE stale_policies (
+    team_name TEXT PRIMARY KEY REFERENCES teams(name) ON DELETE CASCADE,
+    stale_after_hours INT NOT NULL CHECK (stale_after_hours > 0),
+    max_reassignments INT NOT NULL CHECK (max_reassignments >= 0),
+    dry_run BOOLEAN NOT NULL DEFAULT false
+);
+
+CREATE TABLE reassignments (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    old_reviewer_id TEXT NOT NULL,
+    new_reviewer_id TEXT NOT NULL,
+    reason TEXT NOT NULL CHECK (reason IN ('manual', 'sla', 'stale')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_reassignments_pr ON reassignments(pull_request_id, reason);
@@ -0,0 +1,11 @@
+ALTER TABLE teams
+    DROP COLUMN IF EXISTS work_days,
+    DROP COLUMN IF EXISTS work_end,
+    DROP COLUMN IF EXISTS work_start,
+    DROP COLUMN IF EXISTS timezone;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS work_days,
+    DROP COLUMN IF EXISTS work_end,
+    DROP COLUMN IF EXISTS work_start,
+    DROP COLUMN IF EXISTS timezone;
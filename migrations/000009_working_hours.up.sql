@@ -0,0 +1,11 @@
+ALTER TABLE users
+    ADD COLUMN timezone TEXT,
+    ADD COLUMN work_start TEXT,
+    ADD COLUMN work_end TEXT,
+    ADD COLUMN work_days TEXT[];
+
+ALTER TABLE teams
+    ADD COLUMN timezone TEXT,
+    ADD COLUMN work_start TEXT,
+    ADD COLUMN work_end TEXT,
+    ADD COLUMN work_days TEXT[];
@@ -0,0 +1,7 @@
+CREATE TABLE codeowners (
+    team_name TEXT NOT NULL REFERENCES teams(name) ON DELETE CASCADE,
+    repository TEXT NOT NULL DEFAULT '',
+    content TEXT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (team_name, repository)
+);
@@ -0,0 +1,5 @@
+ALTER TABLE codeowners RENAME COLUMN repository_id TO repository;
+
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS repository_id;
+
+DROP TABLE IF EXISTS repositories;
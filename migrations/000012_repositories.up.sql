@@ -0,0 +1,19 @@
+CREATE TABLE repositories (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    team_name TEXT NOT NULL REFERENCES teams(name) ON DELETE CASCADE,
+    reviewer_count INT CHECK (reviewer_count BETWEEN 0 AND 10),
+    strategy TEXT CHECK (strategy IN ('random', 'skill')),
+    require_owner BOOLEAN NOT NULL DEFAULT false,
+    excluded_users TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_repositories_team ON repositories(team_name);
+
+ALTER TABLE pull_requests
+    ADD COLUMN repository_id TEXT REFERENCES repositories(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_pull_requests_repository ON pull_requests(repository_id);
+
+ALTER TABLE codeowners RENAME COLUMN repository TO repository_id;
@@ -0,0 +1,5 @@
+CREATE TABLE team_rules (
+    team_name TEXT PRIMARY KEY REFERENCES teams(name) ON DELETE CASCADE,
+    source TEXT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
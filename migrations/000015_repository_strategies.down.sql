@@ -0,0 +1,4 @@
+UPDATE repositories SET strategy = NULL WHERE strategy NOT IN ('random', 'skill');
+ALTER TABLE repositories DROP CONSTRAINT repositories_strategy_check;
+ALTER TABLE repositories ADD CONSTRAINT repositories_strategy_check
+    CHECK (strategy IN ('random', 'skill'));
@@ -0,0 +1,3 @@
+ALTER TABLE repositories DROP CONSTRAINT repositories_strategy_check;
+ALTER TABLE repositories ADD CONSTRAINT repositories_strategy_check
+    CHECK (strategy IN ('random', 'skill', 'least_loaded'));
@@ -0,0 +1 @@
+ALTER TABLE pull_requests ADD COLUMN assignment_seed BIGINT;
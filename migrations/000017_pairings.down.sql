@@ -0,0 +1,6 @@
+UPDATE repositories SET strategy = NULL WHERE strategy = 'knowledge_spread';
+ALTER TABLE repositories DROP CONSTRAINT repositories_strategy_check;
+ALTER TABLE repositories ADD CONSTRAINT repositories_strategy_check
+    CHECK (strategy IN ('random', 'skill', 'least_loaded'));
+
+DROP INDEX IF EXISTS idx_pr_author;
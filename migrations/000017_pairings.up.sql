@@ -0,0 +1,5 @@
+CREATE INDEX idx_pr_author ON pull_requests(author_id);
+
+ALTER TABLE repositories DROP CONSTRAINT repositories_strategy_check;
+ALTER TABLE repositories ADD CONSTRAINT repositories_strategy_check
+    CHECK (strategy IN ('random', 'skill', 'least_loaded', 'knowledge_spread'));
@@ -0,0 +1,19 @@
+-- Users removed from a team, or left by a deleted one, have no team.
+ALTER TABLE users ALTER COLUMN team_name DROP NOT NULL;
+
+-- Renaming a team carries everything that belongs to it.
+ALTER TABLE users DROP CONSTRAINT users_team_name_fkey,
+    ADD CONSTRAINT users_team_name_fkey FOREIGN KEY (team_name) REFERENCES teams(name) ON UPDATE CASCADE;
+ALTER TABLE team_slas DROP CONSTRAINT team_slas_team_name_fkey,
+    ADD CONSTRAINT team_slas_team_name_fkey FOREIGN KEY (team_name) REFERENCES teams(name) ON DELETE CASCADE ON UPDATE CASCADE;
+ALTER TABLE stale_policies DROP CONSTRAINT stale_policies_team_name_fkey,
+    ADD CONSTRAINT stale_policies_team_name_fkey FOREIGN KEY (team_name) REFERENCES teams(name) ON DELETE CASCADE ON UPDATE CASCADE;
+ALTER TABLE codeowners DROP CONSTRAINT codeowners_team_name_fkey,
+    ADD CONSTRAINT codeowners_team_name_fkey FOREIGN KEY (team_name) REFERENCES teams(name) ON DELETE CASCADE ON UPDATE CASCADE;
+ALTER TABLE repositories DROP CONSTRAINT repositories_team_name_fkey,
+    ADD CONSTRAINT repositories_team_name_fkey FOREIGN KEY (team_name) REFERENCES teams(name) ON DELETE CASCADE ON UPDATE CASCADE;
+ALTER TABLE team_rules DROP CONSTRAINT team_rules_team_name_fkey,
+    ADD CONSTRAINT team_rules_team_name_fkey FOREIGN KEY (team_name) REFERENCES teams(name) ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE reassignments DROP CONSTRAINT reassignments_reason_check,
+    ADD CONSTRAINT reassignments_reason_check CHECK (reason IN ('manual', 'sla', 'stale', 'team_change'));
@@ -0,0 +1,6 @@
+ALTER TABLE users ADD COLUMN team_name TEXT REFERENCES teams(name) ON UPDATE CASCADE;
+UPDATE users u SET team_name = m.team_name FROM team_memberships m WHERE m.user_id = u.id AND m.is_primary;
+
+ALTER TABLE pull_requests DROP COLUMN team_name;
+
+DROP TABLE team_memberships;
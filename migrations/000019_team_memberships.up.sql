@@ -0,0 +1,19 @@
+CREATE TABLE team_memberships (
+    user_id    TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    team_name  TEXT NOT NULL REFERENCES teams(name) ON DELETE CASCADE ON UPDATE CASCADE,
+    is_primary BOOLEAN NOT NULL DEFAULT false,
+    joined_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, team_name)
+);
+CREATE UNIQUE INDEX team_memberships_primary_idx ON team_memberships (user_id) WHERE is_primary;
+CREATE INDEX team_memberships_team_name_idx ON team_memberships (team_name);
+
+INSERT INTO team_memberships (user_id, team_name, is_primary)
+SELECT id, team_name, true FROM users WHERE team_name IS NOT NULL;
+
+-- The team a pull request draws its reviewers from.
+ALTER TABLE pull_requests ADD COLUMN team_name TEXT REFERENCES teams(name) ON DELETE SET NULL ON UPDATE CASCADE;
+UPDATE pull_requests pr SET team_name = u.team_name FROM users u WHERE u.id = pr.author_id;
+CREATE INDEX pull_requests_team_name_idx ON pull_requests (team_name);
+
+ALTER TABLE users DROP COLUMN team_name;
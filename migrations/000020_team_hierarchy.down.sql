@@ -0,0 +1,2 @@
+DROP VIEW team_ancestors;
+ALTER TABLE teams DROP COLUMN parent_name;
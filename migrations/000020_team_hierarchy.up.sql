@@ -0,0 +1,16 @@
+-- A department is a team whose sub-teams name it as their parent.
+ALTER TABLE teams ADD COLUMN parent_name TEXT REFERENCES teams(name) ON DELETE SET NULL ON UPDATE CASCADE,
+    ADD CONSTRAINT teams_parent_name_check CHECK (parent_name <> name);
+CREATE INDEX teams_parent_name_idx ON teams (parent_name);
+
+-- Every team with itself and each of its ancestors, nearest first. Cycles
+-- are refused on update; the CYCLE clause only keeps a stray one finite.
+CREATE VIEW team_ancestors AS
+WITH RECURSIVE up (team_name, ancestor_name, depth) AS (
+    SELECT name, name, 0 FROM teams
+    UNION ALL
+    SELECT up.team_name, t.parent_name, up.depth + 1
+    FROM up JOIN teams t ON t.name = up.ancestor_name
+    WHERE t.parent_name IS NOT NULL
+) CYCLE ancestor_name SET is_cycle USING path
+SELECT team_name, ancestor_name, depth FROM up WHERE NOT is_cycle;
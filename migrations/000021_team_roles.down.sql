@@ -0,0 +1,4 @@
+ALTER TABLE teams DROP COLUMN lead_assignment;
+
+DROP INDEX team_memberships_leads_idx;
+ALTER TABLE team_memberships DROP COLUMN role;
@@ -0,0 +1,7 @@
+ALTER TABLE team_memberships ADD COLUMN role TEXT NOT NULL DEFAULT 'member'
+    CHECK (role IN ('lead', 'maintainer', 'member'));
+CREATE INDEX team_memberships_leads_idx ON team_memberships (team_name) WHERE role = 'lead';
+
+-- How the team's leads take part in automatic assignment.
+ALTER TABLE teams ADD COLUMN lead_assignment TEXT NOT NULL DEFAULT 'normal'
+    CHECK (lead_assignment IN ('normal', 'last_resort', 'excluded'));
@@ -0,0 +1,2 @@
+DROP TRIGGER events_order ON events;
+DROP FUNCTION order_review_event();
@@ -0,0 +1,14 @@
+-- Ids drawn from the sequence alone can commit out of order, and a client
+-- resuming after the higher one would never see the lower. Drawing them under
+-- a lock held until commit makes id order commit order.
+CREATE FUNCTION order_review_event() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_advisory_xact_lock(hashtext('review_events'));
+    NEW.id := nextval(pg_get_serial_sequence('events', 'id'));
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER events_order
+    BEFORE INSERT ON events
+    FOR EACH ROW EXECUTE FUNCTION order_review_event();
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS review_assignments_pairing ON review_assignments;
+DROP FUNCTION IF EXISTS log_pairing();
+DROP TABLE IF EXISTS pairings;
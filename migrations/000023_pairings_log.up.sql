@@ -0,0 +1,34 @@
+-- Reassigning or unassigning a reviewer deletes their assignment, and with it
+-- the pairing knowledge_spread counts. Pairings are logged apart and never
+-- deleted.
+CREATE TABLE pairings (
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    author_id TEXT NOT NULL,
+    reviewer_id TEXT NOT NULL,
+    assigned_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_pairings_author ON pairings(author_id, assigned_at);
+
+CREATE FUNCTION log_pairing() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO pairings (pull_request_id, author_id, reviewer_id, assigned_at)
+    SELECT NEW.pull_request_id, pr.author_id, NEW.reviewer_id, NEW.assigned_at
+    FROM pull_requests pr WHERE pr.id = NEW.pull_request_id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER review_assignments_pairing
+    AFTER INSERT ON review_assignments
+    FOR EACH ROW EXECUTE FUNCTION log_pairing();
+
+INSERT INTO pairings (pull_request_id, author_id, reviewer_id, assigned_at)
+SELECT ra.pull_request_id, pr.author_id, ra.reviewer_id, ra.assigned_at
+FROM review_assignments ra JOIN pull_requests pr ON pr.id = ra.pull_request_id;
+
+-- The assignments of replaced reviewers are gone; they were paired until
+-- their reassignment at the latest.
+INSERT INTO pairings (pull_request_id, author_id, reviewer_id, assigned_at)
+SELECT r.pull_request_id, pr.author_id, r.old_reviewer_id, r.created_at
+FROM reassignments r JOIN pull_requests pr ON pr.id = r.pull_request_id;
@@ -0,0 +1,191 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestCodeowners(t *testing.T) {
+	if os.Getenv("SKIP_E2E") == "1" {
+		t.Skip("SKIP_E2E=1")
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	teamName := "owners-" + uuid.NewString()
+	author, owner, repoOwner := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	members := []map[string]interface{}{
+		{"user_id": author, "username": "Alice", "is_active": true},
+		{"user_id": owner, "username": "Bob", "is_active": true},
+		{"user_id": repoOwner, "username": "Carol", "is_active": true},
+	}
+	for i := 0; i < 5; i++ {
+		members = append(members, map[string]interface{}{"user_id": uuid.NewString(), "username": "Other", "is_active": true})
+	}
+	resp := post(t, client, "/team/add", map[string]interface{}{"team_name": teamName, "members": members})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+
+	resp = post(t, client, "/team/setCodeowners", map[string]interface{}{
+		"team_name":     teamName,
+		"repository_id": "",
+		"content":       "!*.go @" + owner,
+	})
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 for negated pattern, got %d", resp.StatusCode)
+	}
+
+	resp = post(t, client, "/team/setCodeowners", map[string]interface{}{
+		"team_name":     teamName,
+		"repository_id": "",
+		"content":       "*.md @" + author + "\n/internal/db/ @" + owner + "\n",
+	})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	// The team default applies to PRs outside any registered repository.
+	for i := 0; i < 5; i++ {
+		resp = post(t, client, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   uuid.NewString(),
+			"pull_request_name": "feat: db",
+			"author_id":         author,
+			"changed_files":     []string{"README.md", "internal/db/conn.go"},
+		})
+		var created struct {
+			PR struct {
+				AssignedReviewers []string `json:"assigned_reviewers"`
+			} `json:"pr"`
+		}
+		json.NewDecoder(resp.Body).Decode(&created)
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("expected 201, got %d", resp.StatusCode)
+		}
+		reviewers := created.PR.AssignedReviewers
+		if len(reviewers) != 2 || (reviewers[0] != owner && reviewers[1] != owner) {
+			t.Fatalf("expected owner %s among reviewers, got %v", owner, reviewers)
+		}
+	}
+
+	resp = get(t, client, "/team/getCodeowners?team_name="+teamName+"&repository_id=search")
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404 for unregistered repository, got %d", resp.StatusCode)
+	}
+
+	// A registered repository's own CODEOWNERS replaces the team default.
+	repoID := "owners-" + uuid.NewString()
+	resp = post(t, client, "/repositories/create", map[string]interface{}{
+		"repository_id":  repoID,
+		"name":           "owners",
+		"team_name":      teamName,
+		"reviewer_count": 1,
+	})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+	resp = post(t, client, "/team/setCodeowners", map[string]interface{}{
+		"team_name":     teamName,
+		"repository_id": repoID,
+		"content":       "/internal/db/ @" + repoOwner + "\n",
+	})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	for i := 0; i < 5; i++ {
+		resp = post(t, client, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   uuid.NewString(),
+			"pull_request_name": "feat: db",
+			"author_id":         author,
+			"repository_id":     repoID,
+			"changed_files":     []string{"internal/db/conn.go"},
+		})
+		var created struct {
+			PR struct {
+				AssignedReviewers []string `json:"assigned_reviewers"`
+			} `json:"pr"`
+		}
+		json.NewDecoder(resp.Body).Decode(&created)
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("expected 201, got %d", resp.StatusCode)
+		}
+		if reviewers := created.PR.AssignedReviewers; len(reviewers) != 1 || reviewers[0] != repoOwner {
+			t.Fatalf("expected repository owner %s as the reviewer, got %v", repoOwner, reviewers)
+		}
+	}
+
+	resp = get(t, client, "/team/getCodeowners?team_name="+teamName+"&repository_id="+repoID)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+}
+
+func TestCodeownersUsernameInOtherTeam(t *testing.T) {
+	if os.Getenv("SKIP_E2E") == "1" {
+		t.Skip("SKIP_E2E=1")
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	// Both teams have a member with the same username; only the pull
+	// request's team is searched for it.
+	username := "Bob-" + uuid.NewString()
+	teamName, otherTeam := "owners-"+uuid.NewString(), "owners-"+uuid.NewString()
+	author, owner, namesake := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	members := []map[string]interface{}{
+		{"user_id": author, "username": "Alice", "is_active": true},
+		{"user_id": owner, "username": username, "is_active": true},
+	}
+	for i := 0; i < 5; i++ {
+		members = append(members, map[string]interface{}{"user_id": uuid.NewString(), "username": "Other", "is_active": true})
+	}
+	resp := post(t, client, "/team/add", map[string]interface{}{"team_name": teamName, "members": members})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+	resp = post(t, client, "/team/add", map[string]interface{}{"team_name": otherTeam, "members": []map[string]interface{}{
+		{"user_id": namesake, "username": username, "is_active": true},
+	}})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+
+	resp = post(t, client, "/team/setCodeowners", map[string]interface{}{
+		"team_name":     teamName,
+		"repository_id": "",
+		"content":       "/internal/db/ @" + username + "\n",
+	})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	for i := 0; i < 5; i++ {
+		resp = post(t, client, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   uuid.NewString(),
+			"pull_request_name": "feat: db",
+			"author_id":         author,
+			"changed_files":     []string{"internal/db/conn.go"},
+		})
+		var created struct {
+			PR struct {
+				AssignedReviewers []string `json:"assigned_reviewers"`
+			} `json:"pr"`
+		}
+		json.NewDecoder(resp.Body).Decode(&created)
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("expected 201, got %d", resp.StatusCode)
+		}
+		reviewers := created.PR.AssignedReviewers
+		if len(reviewers) != 2 || (reviewers[0] != owner && reviewers[1] != owner) {
+			t.Fatalf("expected owner %s among reviewers, got %v", owner, reviewers)
+		}
+		if reviewers[0] == namesake || reviewers[1] == namesake {
+			t.Fatalf("user %s of another team assigned as owner: %v", namesake, reviewers)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestSeniorityComposition(t *testing.T) {
+	if os.Getenv("SKIP_E2E") == "1" {
+		t.Skip("SKIP_E2E=1")
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	teamName := "seniority-" + uuid.NewString()
+	author, senior := uuid.NewString(), uuid.NewString()
+	juniors := []string{uuid.NewString(), uuid.NewString(), uuid.NewString()}
+	members := []map[string]interface{}{
+		{"user_id": author, "username": "Alice", "is_active": true, "seniority": "junior"},
+		{"user_id": senior, "username": "Bob", "is_active": true, "seniority": "senior"},
+	}
+	for _, j := range juniors {
+		members = append(members, map[string]interface{}{"user_id": j, "username": "Junior", "is_active": true, "seniority": "junior"})
+	}
+	resp := post(t, client, "/team/add", map[string]interface{}{"team_name": teamName, "members": members})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+
+	type prResponse struct {
+		PR struct {
+			ID                string   `json:"pull_request_id"`
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	createPR := func() (int, prResponse) {
+		t.Helper()
+		resp := post(t, client, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   uuid.NewString(),
+			"pull_request_name": "feat: onboarding",
+			"author_id":         author,
+		})
+		var pr prResponse
+		json.NewDecoder(resp.Body).Decode(&pr)
+		return resp.StatusCode, pr
+	}
+
+	// The only senior is always picked for the junior's pull requests.
+	var prID string
+	for i := 0; i < 5; i++ {
+		code, pr := createPR()
+		if code != http.StatusCreated {
+			t.Fatalf("expected 201, got %d", code)
+		}
+		reviewers := pr.PR.AssignedReviewers
+		if len(reviewers) != 2 || (reviewers[0] != senior && reviewers[1] != senior) {
+			t.Fatalf("expected senior %s among reviewers, got %v", senior, reviewers)
+		}
+		prID = pr.PR.ID
+	}
+
+	// Replacing the senior with a junior would leave two juniors.
+	resp = post(t, client, "/pullRequest/reassign", map[string]interface{}{"pull_request_id": prID, "old_reviewer_id": senior})
+	var reassigned prResponse
+	json.NewDecoder(resp.Body).Decode(&reassigned)
+	if resp.StatusCode != http.StatusConflict || reassigned.Error.Code != "COMPOSITION_UNSATISFIED" {
+		t.Fatalf("expected 409 COMPOSITION_UNSATISFIED, got %d %s", resp.StatusCode, reassigned.Error.Code)
+	}
+
+	resp = post(t, client, "/users/setSeniority", map[string]interface{}{"user_id": senior, "seniority": "middle"})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	code, pr := createPR()
+	if code != http.StatusConflict || pr.Error.Code != "COMPOSITION_UNSATISFIED" {
+		t.Fatalf("expected 409 COMPOSITION_UNSATISFIED without seniors, got %d %s", code, pr.Error.Code)
+	}
+
+	resp = post(t, client, "/users/setSeniority", map[string]interface{}{"user_id": senior, "seniority": "lead"})
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 for unknown seniority, got %d", resp.StatusCode)
+	}
+}
@@ -3,6 +3,7 @@ package tests
 import (
 	"bytes"
 	"encoding/json"
+	"io"
 	"net/http"
 	"os"
 	"testing"
@@ -13,6 +14,15 @@ import (
 
 const baseURL = "http://localhost:8080"
 
+// adminToken is the server's ADMIN_TOKEN in docker-compose.yaml.
+const adminToken = "dev-admin-token"
+
+// asActor sends a request on behalf of a user.
+func asActor(id string) map[string]string { return map[string]string{"X-Actor-Id": id} }
+
+// asAdmin sends a trusted request.
+func asAdmin() map[string]string { return map[string]string{"Authorization": "Bearer " + adminToken} }
+
 func TestE2E(t *testing.T) {
 	if os.Getenv("SKIP_E2E") == "1" {
 		t.Skip("SKIP_E2E=1")
@@ -91,10 +101,118 @@ func TestE2E(t *testing.T) {
 	}
 }
 
+func TestIdempotency(t *testing.T) {
+	if os.Getenv("SKIP_E2E") == "1" {
+		t.Skip("SKIP_E2E=1")
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	teamName := "idem-" + uuid.NewString()
+	author := uuid.NewString()
+	teamBody := map[string]interface{}{
+		"team_name": teamName,
+		"members": []map[string]interface{}{
+			{"user_id": author, "username": "Alice", "is_active": true},
+			{"user_id": uuid.NewString(), "username": "Bob", "is_active": true},
+			{"user_id": uuid.NewString(), "username": "Carol", "is_active": true},
+			{"user_id": uuid.NewString(), "username": "Dave", "is_active": true},
+		},
+	}
+	resp := post(t, client, "/team/add", teamBody)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+
+	key := uuid.NewString()
+	prBody := map[string]string{
+		"pull_request_id":   uuid.NewString(),
+		"pull_request_name": "feat: retried",
+		"author_id":         author,
+	}
+	headers := map[string]string{"Idempotency-Key": key}
+
+	first := postWithHeaders(t, client, "/pullRequest/create", prBody, headers)
+	if first.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", first.StatusCode)
+	}
+	var firstBody bytes.Buffer
+	firstBody.ReadFrom(first.Body)
+
+	// Retry with the same key and body → stored response is replayed
+	second := postWithHeaders(t, client, "/pullRequest/create", prBody, headers)
+	if second.StatusCode != http.StatusCreated {
+		t.Fatalf("expected replayed 201, got %d", second.StatusCode)
+	}
+	if second.Header.Get("Idempotent-Replayed") != "true" {
+		t.Fatalf("expected Idempotent-Replayed header on retry")
+	}
+	var secondBody bytes.Buffer
+	secondBody.ReadFrom(second.Body)
+	if firstBody.String() != secondBody.String() {
+		t.Fatalf("replayed body differs: %s vs %s", firstBody.String(), secondBody.String())
+	}
+
+	// Same key with a different body → 422
+	prBody["pull_request_name"] = "feat: something else"
+	resp = postWithHeaders(t, client, "/pullRequest/create", prBody, headers)
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("expected 422 on key reuse, got %d", resp.StatusCode)
+	}
+}
+
+func TestRequestValidation(t *testing.T) {
+	if os.Getenv("SKIP_E2E") == "1" {
+		t.Skip("SKIP_E2E=1")
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	resp := post(t, client, "/pullRequest/create", map[string]string{
+		"pull_request_id":   "",
+		"pull_request_name": "feat: no id",
+	})
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", resp.StatusCode)
+	}
+
+	var errResp struct {
+		Error struct {
+			Code    string `json:"code"`
+			Details []struct {
+				Field string `json:"field"`
+			} `json:"details"`
+		} `json:"error"`
+	}
+	json.NewDecoder(resp.Body).Decode(&errResp)
+	if errResp.Error.Code != "BAD_REQUEST" {
+		t.Fatalf("expected BAD_REQUEST, got %s", errResp.Error.Code)
+	}
+	fields := map[string]bool{}
+	for _, d := range errResp.Error.Details {
+		fields[d.Field] = true
+	}
+	if !fields["pull_request_id"] || !fields["author_id"] {
+		t.Fatalf("expected pull_request_id and author_id violations, got %+v", errResp.Error.Details)
+	}
+
+	resp = get(t, client, "/team/get?team_name=")
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 for empty team_name, got %d", resp.StatusCode)
+	}
+}
+
 func post(t *testing.T, client *http.Client, path string, body interface{}) *http.Response {
+	return postWithHeaders(t, client, path, body, nil)
+}
+
+func postWithHeaders(t *testing.T, client *http.Client, path string, body interface{}, headers map[string]string) *http.Response {
 	b, _ := json.Marshal(body)
 	req, _ := http.NewRequest("POST", baseURL+path, bytes.NewReader(b))
 	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
 	resp, err := client.Do(req)
 	if err != nil {
 		t.Fatal(err)
@@ -110,3 +228,20 @@ func get(t *testing.T, client *http.Client, path string) *http.Response {
 	}
 	return resp
 }
+
+func send(t *testing.T, client *http.Client, method, path string, body interface{}) *http.Response {
+	var r io.Reader
+	if body != nil {
+		b, _ := json.Marshal(body)
+		r = bytes.NewReader(b)
+	}
+	req, _ := http.NewRequest(method, baseURL+path, r)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp
+}
@@ -0,0 +1,133 @@
+package tests
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type sseEvent struct {
+	id, event, data string
+}
+
+func TestEventStream(t *testing.T) {
+	if os.Getenv("SKIP_E2E") == "1" {
+		t.Skip("SKIP_E2E=1")
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	author, reviewer := uuid.NewString(), uuid.NewString()
+	resp := post(t, client, "/team/add", map[string]interface{}{
+		"team_name": "events-" + uuid.NewString(),
+		"members": []map[string]interface{}{
+			{"user_id": author, "username": "Alice", "is_active": true},
+			{"user_id": reviewer, "username": "Bob", "is_active": true},
+		},
+	})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+
+	resp = get(t, client, "/events/stream?user_id="+uuid.NewString())
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404 for unknown user, got %d", resp.StatusCode)
+	}
+
+	events := openStream(t, "/events/stream?user_id="+reviewer, "")
+
+	prID := uuid.NewString()
+	resp = post(t, client, "/pullRequest/create", map[string]string{
+		"pull_request_id":   prID,
+		"pull_request_name": "feat: events",
+		"author_id":         author,
+	})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+
+	assigned := nextEvent(t, events)
+	if assigned.event != "review_assigned" || !strings.Contains(assigned.data, prID) {
+		t.Fatalf("unexpected event: %+v", assigned)
+	}
+
+	resp = post(t, client, "/pullRequest/merge", map[string]string{"pull_request_id": prID})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	// Reconnecting with Last-Event-ID replays what happened after it.
+	resumed := openStream(t, "/events/stream?user_id="+reviewer, assigned.id)
+	merged := nextEvent(t, resumed)
+	if merged.event != "pull_request_merged" || !strings.Contains(merged.data, prID) {
+		t.Fatalf("unexpected replayed event: %+v", merged)
+	}
+}
+
+// openStream connects to an SSE endpoint and delivers parsed events until the test ends.
+func openStream(t *testing.T, path, lastEventID string) <-chan sseEvent {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	req, _ := http.NewRequestWithContext(ctx, "GET", baseURL+path, nil)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("expected text/event-stream, got %q", ct)
+	}
+
+	ch := make(chan sseEvent)
+	go func() {
+		defer resp.Body.Close()
+		var e sseEvent
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case line == "":
+				if e.event != "" {
+					select {
+					case ch <- e:
+					case <-ctx.Done():
+						return
+					}
+				}
+				e = sseEvent{}
+			case strings.HasPrefix(line, "id: "):
+				e.id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "event: "):
+				e.event = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				e.data = strings.TrimPrefix(line, "data: ")
+			}
+		}
+	}()
+	return ch
+}
+
+func nextEvent(t *testing.T, ch <-chan sseEvent) sseEvent {
+	t.Helper()
+	select {
+	case e := <-ch:
+		return e
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+		return sseEvent{}
+	}
+}
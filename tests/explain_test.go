@@ -0,0 +1,119 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestCreatePRExplain(t *testing.T) {
+	if os.Getenv("SKIP_E2E") == "1" {
+		t.Skip("SKIP_E2E=1")
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	teamName := "explain-" + uuid.NewString()
+	author, inactive, onCall, excluded := uuid.NewString(), uuid.NewString(), uuid.NewString(), uuid.NewString()
+	eligible := []string{uuid.NewString(), uuid.NewString(), uuid.NewString()}
+	members := []map[string]interface{}{
+		{"user_id": author, "username": "Alice", "is_active": true},
+		{"user_id": inactive, "username": "Bob", "is_active": false},
+		{"user_id": onCall, "username": "Carol", "is_active": true},
+		{"user_id": excluded, "username": "Dave", "is_active": true},
+	}
+	for _, id := range eligible {
+		members = append(members, map[string]interface{}{"user_id": id, "username": "Other", "is_active": true})
+	}
+	resp := post(t, client, "/team/add", map[string]interface{}{"team_name": teamName, "members": members})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+	resp = post(t, client, "/users/setTags", map[string]interface{}{"user_id": onCall, "tags": []string{"on-call"}})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	resp = post(t, client, "/rules/set", map[string]interface{}{"team_name": teamName, "rules": "exclude tag=on-call"})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	repoID := "explain-" + uuid.NewString()
+	resp = post(t, client, "/repositories/create", map[string]interface{}{
+		"repository_id":  repoID,
+		"name":           "explain",
+		"team_name":      teamName,
+		"strategy":       "skill",
+		"excluded_users": []string{excluded},
+	})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+
+	body := map[string]interface{}{
+		"pull_request_id":   uuid.NewString(),
+		"pull_request_name": "feat: explain",
+		"author_id":         author,
+		"repository_id":     repoID,
+	}
+	type createResponse struct {
+		PR struct {
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+		Explanation *struct {
+			Strategy      string `json:"strategy"`
+			ReviewerCount int    `json:"reviewer_count"`
+			Candidates    []struct {
+				UserID   string `json:"user_id"`
+				Excluded string `json:"excluded"`
+				Rule     string `json:"rule"`
+				Rank     int    `json:"rank"`
+				Score    *int   `json:"score"`
+				Selected bool   `json:"selected"`
+			} `json:"candidates"`
+		} `json:"explanation"`
+	}
+
+	resp = post(t, client, "/pullRequest/create", body)
+	var plain createResponse
+	json.NewDecoder(resp.Body).Decode(&plain)
+	if resp.StatusCode != http.StatusCreated || plain.Explanation != nil {
+		t.Fatalf("expected 201 without explanation, got %d %+v", resp.StatusCode, plain.Explanation)
+	}
+
+	body["pull_request_id"] = uuid.NewString()
+	resp = post(t, client, "/pullRequest/create?explain=true", body)
+	var created createResponse
+	json.NewDecoder(resp.Body).Decode(&created)
+	if resp.StatusCode != http.StatusCreated || created.Explanation == nil {
+		t.Fatalf("expected 201 with explanation, got %d", resp.StatusCode)
+	}
+	e := created.Explanation
+	if e.Strategy != "skill" || e.ReviewerCount != 2 || len(e.Candidates) != len(members) {
+		t.Fatalf("unexpected explanation: %+v", e)
+	}
+
+	reasons := map[string]string{}
+	selected := 0
+	for _, c := range e.Candidates {
+		reasons[c.UserID] = c.Excluded
+		if c.Excluded == "" && (c.Rank == 0 || c.Score == nil) {
+			t.Errorf("eligible candidate %s without rank or score: %+v", c.UserID, c)
+		}
+		if c.Selected {
+			selected++
+		}
+	}
+	want := map[string]string{author: "author", inactive: "inactive", onCall: "rule", excluded: "repository_policy"}
+	for id, reason := range want {
+		if reasons[id] != reason {
+			t.Errorf("%s: expected %q, got %q", id, reason, reasons[id])
+		}
+	}
+	if selected != 2 || len(created.PR.AssignedReviewers) != 2 {
+		t.Errorf("expected 2 selected reviewers, got %d", selected)
+	}
+}
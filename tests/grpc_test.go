@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	reviewerv1 "avito-pr-reviewer/internal/pb/reviewer/v1"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const grpcAddr = "localhost:9090"
+
+func TestGRPC(t *testing.T) {
+	if os.Getenv("SKIP_E2E") == "1" {
+		t.Skip("SKIP_E2E=1")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, err := grpc.NewClient(grpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	hc, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hc.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("expected SERVING, got %s", hc.GetStatus())
+	}
+
+	client := reviewerv1.NewReviewerServiceClient(conn)
+
+	author := uuid.NewString()
+	_, err = client.CreateTeam(ctx, &reviewerv1.CreateTeamRequest{Team: &reviewerv1.Team{
+		TeamName: "grpc-" + uuid.NewString(),
+		Members: []*reviewerv1.TeamMember{
+			{UserId: author, Username: "Alice", IsActive: true},
+			{UserId: uuid.NewString(), Username: "Bob", IsActive: true},
+			{UserId: uuid.NewString(), Username: "Carol", IsActive: true},
+		},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	prID := uuid.NewString()
+	created, err := client.CreatePullRequest(ctx, &reviewerv1.CreatePullRequestRequest{
+		PullRequestId:   prID,
+		PullRequestName: "feat: grpc",
+		AuthorId:        author,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(created.GetPr().GetAssignedReviewers()) != 2 {
+		t.Fatalf("expected 2 reviewers, got %d", len(created.GetPr().GetAssignedReviewers()))
+	}
+
+	_, err = client.CreatePullRequest(ctx, &reviewerv1.CreatePullRequestRequest{
+		PullRequestId:   prID,
+		PullRequestName: "feat: grpc",
+		AuthorId:        author,
+	})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("expected AlreadyExists, got %v", err)
+	}
+
+	if _, err := client.MergePullRequest(ctx, &reviewerv1.MergePullRequestRequest{PullRequestId: prID}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.ReassignReviewer(ctx, &reviewerv1.ReassignReviewerRequest{
+		PullRequestId: prID,
+		OldReviewerId: created.GetPr().GetAssignedReviewers()[0],
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition after merge, got %v", err)
+	}
+
+	_, err = client.GetTeam(ctx, &reviewerv1.GetTeamRequest{TeamName: uuid.NewString()})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", err)
+	}
+}
@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// spreadRepository creates a team of an author and three teammates with a
+// repository that assigns one reviewer under knowledge_spread.
+func spreadRepository(t *testing.T, client *http.Client) (author, repoID string) {
+	t.Helper()
+	teamName := "spread-" + uuid.NewString()
+	author = uuid.NewString()
+	members := []map[string]interface{}{{"user_id": author, "username": "Alice", "is_active": true}}
+	for range 3 {
+		members = append(members, map[string]interface{}{"user_id": uuid.NewString(), "username": "Other", "is_active": true})
+	}
+	resp := post(t, client, "/team/add", map[string]interface{}{"team_name": teamName, "members": members})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+	repoID = "spread-" + uuid.NewString()
+	resp = post(t, client, "/repositories/create", map[string]interface{}{
+		"repository_id":  repoID,
+		"name":           "spread",
+		"team_name":      teamName,
+		"reviewer_count": 1,
+		"strategy":       "knowledge_spread",
+	})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+	return author, repoID
+}
+
+// createSpreadPR opens a pull request by author in repoID and returns its
+// reviewer.
+func createSpreadPR(t *testing.T, client *http.Client, author, repoID string) (prID, reviewer string) {
+	t.Helper()
+	prID = uuid.NewString()
+	resp := post(t, client, "/pullRequest/create", map[string]interface{}{
+		"pull_request_id":   prID,
+		"pull_request_name": "feat: spread",
+		"author_id":         author,
+		"repository_id":     repoID,
+	})
+	var created struct {
+		PR struct {
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+	}
+	json.NewDecoder(resp.Body).Decode(&created)
+	if resp.StatusCode != http.StatusCreated || len(created.PR.AssignedReviewers) != 1 {
+		t.Fatalf("expected 201 with one reviewer, got %d %v", resp.StatusCode, created.PR.AssignedReviewers)
+	}
+	return prID, created.PR.AssignedReviewers[0]
+}
+
+func TestKnowledgeSpreadStrategy(t *testing.T) {
+	if os.Getenv("SKIP_E2E") == "1" {
+		t.Skip("SKIP_E2E=1")
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+	author, repoID := spreadRepository(t, client)
+
+	// A fresh pairing outweighs the open review, so every teammate gets one
+	// pull request before anyone gets a second.
+	seen := map[string]bool{}
+	for range 3 {
+		_, r := createSpreadPR(t, client, author, repoID)
+		if seen[r] {
+			t.Errorf("%s reviewed the author twice before the others", r)
+		}
+		seen[r] = true
+	}
+}
+
+func TestKnowledgeSpreadKeepsReplacedPairings(t *testing.T) {
+	if os.Getenv("SKIP_E2E") == "1" {
+		t.Skip("SKIP_E2E=1")
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+	author, repoID := spreadRepository(t, client)
+
+	// The replaced reviewer was paired with the author all the same, so the
+	// next pull request goes to the third teammate.
+	prID, first := createSpreadPR(t, client, author, repoID)
+	resp := post(t, client, "/pullRequest/reassign", map[string]interface{}{"pull_request_id": prID, "old_reviewer_id": first})
+	var reassigned struct {
+		ReplacedBy string `json:"replaced_by"`
+	}
+	json.NewDecoder(resp.Body).Decode(&reassigned)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	if _, next := createSpreadPR(t, client, author, repoID); next == first || next == reassigned.ReplacedBy {
+		t.Errorf("%s reviewed the author again before the third teammate", next)
+	}
+}
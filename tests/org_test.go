@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestOrgImportExport(t *testing.T) {
+	if os.Getenv("SKIP_E2E") == "1" {
+		t.Skip("SKIP_E2E=1")
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	dept, team := "org-"+uuid.NewString(), "org-"+uuid.NewString()
+	alice, bob := uuid.NewString(), uuid.NewString()
+	content := fmt.Sprintf(`team_name,parent_team_name,lead_assignment,user_id,username,role,seniority
+%[1]s,,,,,,
+%[2]s,%[1]s,last_resort,%[3]s,Alice,lead,senior
+%[2]s,,,%[4]s,Bob,,junior
+`, dept, team, alice, bob)
+
+	type importResult struct {
+		DryRun  bool `json:"dry_run"`
+		Changes []struct {
+			Action string `json:"action"`
+		} `json:"changes"`
+	}
+	importOrg := func(content string, dryRun bool) (int, importResult) {
+		var res importResult
+		resp := postWithHeaders(t, client, "/org/import", map[string]interface{}{"format": "csv", "content": content, "dry_run": dryRun}, asAdmin())
+		json.NewDecoder(resp.Body).Decode(&res)
+		return resp.StatusCode, res
+	}
+
+	// A dry run reports the changes but makes none.
+	status, res := importOrg(content, true)
+	if status != http.StatusOK || !res.DryRun || len(res.Changes) != 11 {
+		t.Fatalf("dry run: %d %+v", status, res)
+	}
+	resp := get(t, client, "/team/get?team_name="+url.QueryEscape(team))
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("dry run created the team: %d", resp.StatusCode)
+	}
+
+	// Every bad row is reported, and nothing is applied.
+	var bad struct {
+		Error struct {
+			Details []struct {
+				Line  int    `json:"line"`
+				Field string `json:"field"`
+			} `json:"details"`
+		} `json:"error"`
+	}
+	resp = post(t, client, "/org/import", map[string]interface{}{
+		"format":  "csv",
+		"content": content + team + ",,,u-x,,boss,\n" + team + ",missing-" + dept + ",,,,,\n",
+	})
+	json.NewDecoder(resp.Body).Decode(&bad)
+	if resp.StatusCode != http.StatusBadRequest || len(bad.Error.Details) != 3 || bad.Error.Details[0].Line != 5 || bad.Error.Details[2].Line != 6 {
+		t.Fatalf("expected three violations on lines 5 and 6, got %d %+v", resp.StatusCode, bad.Error)
+	}
+
+	// Roles in new teams need the admin token.
+	resp = post(t, client, "/org/import", map[string]interface{}{"format": "csv", "content": content})
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected 403 without the admin token, got %d", resp.StatusCode)
+	}
+
+	status, res = importOrg(content, false)
+	if status != http.StatusOK || res.DryRun || len(res.Changes) != 11 {
+		t.Fatalf("import: %d %+v", status, res)
+	}
+	var got struct {
+		Team struct {
+			Parent         string `json:"parent_team_name"`
+			LeadAssignment string `json:"lead_assignment"`
+			Members        []struct {
+				UserID string `json:"user_id"`
+				Role   string `json:"role"`
+			} `json:"members"`
+		} `json:"team"`
+	}
+	resp = get(t, client, "/team/get?team_name="+url.QueryEscape(team))
+	json.NewDecoder(resp.Body).Decode(&got)
+	if got.Team.Parent != dept || got.Team.LeadAssignment != "last_resort" || len(got.Team.Members) != 2 || got.Team.Members[0].Role != "lead" {
+		t.Fatalf("unexpected team %+v", got.Team)
+	}
+
+	// The export reads back without changes.
+	var exported struct {
+		Content string `json:"content"`
+	}
+	resp = get(t, client, "/org/export?format=yaml")
+	json.NewDecoder(resp.Body).Decode(&exported)
+	if resp.StatusCode != http.StatusOK || !strings.Contains(exported.Content, "team_name: "+team) {
+		t.Fatalf("export: %d", resp.StatusCode)
+	}
+	resp = post(t, client, "/org/import", map[string]interface{}{"format": "yaml", "content": exported.Content, "dry_run": true})
+	json.NewDecoder(resp.Body).Decode(&res)
+	if resp.StatusCode != http.StatusOK || len(res.Changes) != 0 {
+		t.Fatalf("re-importing the export: %d %+v", resp.StatusCode, res)
+	}
+}
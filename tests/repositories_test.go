@@ -0,0 +1,125 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestRepositories(t *testing.T) {
+	if os.Getenv("SKIP_E2E") == "1" {
+		t.Skip("SKIP_E2E=1")
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	teamName := "repos-" + uuid.NewString()
+	author, excluded := uuid.NewString(), uuid.NewString()
+	members := []map[string]interface{}{
+		{"user_id": author, "username": "Alice", "is_active": true},
+		{"user_id": excluded, "username": "Bob", "is_active": true},
+	}
+	for i := 0; i < 4; i++ {
+		members = append(members, map[string]interface{}{"user_id": uuid.NewString(), "username": "Other", "is_active": true})
+	}
+	resp := post(t, client, "/team/add", map[string]interface{}{"team_name": teamName, "members": members})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+
+	repoID := "repo-" + uuid.NewString()
+	repo := map[string]interface{}{
+		"repository_id":  repoID,
+		"name":           "search",
+		"team_name":      teamName,
+		"reviewer_count": 3,
+		"excluded_users": []string{excluded},
+	}
+	resp = post(t, client, "/repositories/create", repo)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+	resp = post(t, client, "/repositories/create", repo)
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("expected 409 for duplicate repository, got %d", resp.StatusCode)
+	}
+	resp = post(t, client, "/repositories/create", map[string]interface{}{
+		"repository_id":  uuid.NewString(),
+		"name":           "too-many",
+		"team_name":      teamName,
+		"reviewer_count": 11,
+	})
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 for reviewer_count 11, got %d", resp.StatusCode)
+	}
+
+	type prResponse struct {
+		PR struct {
+			AssignedReviewers []string `json:"assigned_reviewers"`
+			RepositoryID      string   `json:"repository_id"`
+		} `json:"pr"`
+	}
+	createPR := func() (int, prResponse) {
+		t.Helper()
+		resp := post(t, client, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   uuid.NewString(),
+			"pull_request_name": "feat: search",
+			"author_id":         author,
+			"repository_id":     repoID,
+			"changed_files":     []string{"internal/search/index.go"},
+		})
+		var pr prResponse
+		json.NewDecoder(resp.Body).Decode(&pr)
+		return resp.StatusCode, pr
+	}
+
+	// The repository asks for three reviewers and never assigns the excluded user.
+	for i := 0; i < 5; i++ {
+		code, pr := createPR()
+		if code != http.StatusCreated {
+			t.Fatalf("expected 201, got %d", code)
+		}
+		if len(pr.PR.AssignedReviewers) != 3 || pr.PR.RepositoryID != repoID {
+			t.Fatalf("expected 3 reviewers in %s, got %+v", repoID, pr.PR)
+		}
+		for _, r := range pr.PR.AssignedReviewers {
+			if r == excluded {
+				t.Fatalf("excluded user %s was assigned", excluded)
+			}
+		}
+	}
+
+	repo["require_owner"] = true
+	resp = post(t, client, "/repositories/update", repo)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	if code, _ := createPR(); code != http.StatusConflict {
+		t.Fatalf("expected 409 without code owners, got %d", code)
+	}
+
+	resp = get(t, client, "/repositories/list?team_name="+teamName)
+	var list struct {
+		Repositories []struct {
+			RepositoryID string `json:"repository_id"`
+			RequireOwner bool   `json:"require_owner"`
+		} `json:"repositories"`
+	}
+	json.NewDecoder(resp.Body).Decode(&list)
+	if resp.StatusCode != http.StatusOK || len(list.Repositories) != 1 || !list.Repositories[0].RequireOwner {
+		t.Fatalf("unexpected repository list: %d %+v", resp.StatusCode, list.Repositories)
+	}
+
+	resp = post(t, client, "/repositories/delete", map[string]interface{}{"repository_id": repoID})
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", resp.StatusCode)
+	}
+	resp = get(t, client, "/repositories/get?repository_id="+repoID)
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404 after delete, got %d", resp.StatusCode)
+	}
+}
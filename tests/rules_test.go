@@ -0,0 +1,120 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestAssignmentRules(t *testing.T) {
+	if os.Getenv("SKIP_E2E") == "1" {
+		t.Skip("SKIP_E2E=1")
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	teamName := "rules-" + uuid.NewString()
+	paymentsTeam := "rules-payments-" + uuid.NewString()
+	author, onCall, other := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	payments := uuid.NewString()
+	resp := post(t, client, "/team/add", map[string]interface{}{
+		"team_name": teamName,
+		"members": []map[string]interface{}{
+			{"user_id": author, "username": "Alice", "is_active": true},
+			{"user_id": onCall, "username": "Bob", "is_active": true},
+			{"user_id": other, "username": "Carol", "is_active": true},
+		},
+	})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+	resp = post(t, client, "/team/add", map[string]interface{}{
+		"team_name": paymentsTeam,
+		"members":   []map[string]interface{}{{"user_id": payments, "username": "Dave", "is_active": true}},
+	})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+	resp = post(t, client, "/users/setTags", map[string]interface{}{"user_id": onCall, "tags": []string{"on-call"}})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	resp = post(t, client, "/rules/set", map[string]interface{}{"team_name": teamName, "rules": "exclude when label=x"})
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 for invalid rules, got %d", resp.StatusCode)
+	}
+	rules := "exclude tag=on-call\nrequire 1 from team=" + paymentsTeam + " when label=billing\n"
+	resp = post(t, client, "/rules/set", map[string]interface{}{"team_name": teamName, "rules": rules})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	resp = post(t, client, "/rules/evaluate", map[string]interface{}{"author_id": author, "labels": []string{"billing"}})
+	var eval struct {
+		Evaluation struct {
+			Rules []struct {
+				Line    int  `json:"line"`
+				Applied bool `json:"applied"`
+			} `json:"rules"`
+			Excluded []struct {
+				UserID string `json:"user_id"`
+				Line   int    `json:"line"`
+			} `json:"excluded"`
+			Required []struct {
+				UserIDs []string `json:"user_ids"`
+			} `json:"required"`
+			Reviewers []string `json:"reviewers"`
+		} `json:"evaluation"`
+	}
+	json.NewDecoder(resp.Body).Decode(&eval)
+	e := eval.Evaluation
+	if resp.StatusCode != http.StatusOK || len(e.Rules) != 2 || !e.Rules[1].Applied {
+		t.Fatalf("unexpected evaluation: %d %+v", resp.StatusCode, e)
+	}
+	if len(e.Excluded) != 1 || e.Excluded[0].UserID != onCall || e.Excluded[0].Line != 1 {
+		t.Fatalf("expected %s excluded by line 1, got %+v", onCall, e.Excluded)
+	}
+	if len(e.Required) != 1 || len(e.Required[0].UserIDs) != 1 || e.Required[0].UserIDs[0] != payments {
+		t.Fatalf("expected %s required, got %+v", payments, e.Required)
+	}
+
+	resp = post(t, client, "/pullRequest/create", map[string]interface{}{
+		"pull_request_id":   uuid.NewString(),
+		"pull_request_name": "feat: invoices",
+		"author_id":         author,
+		"labels":            []string{"billing"},
+	})
+	var created struct {
+		PR struct {
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+	}
+	json.NewDecoder(resp.Body).Decode(&created)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+	got := created.PR.AssignedReviewers
+	if len(got) != 2 || got[0] != payments || got[1] != other {
+		t.Fatalf("expected [%s %s], got %v", payments, other, got)
+	}
+
+	// A draft can be checked without saving it.
+	resp = post(t, client, "/rules/evaluate", map[string]interface{}{
+		"author_id": author,
+		"rules":     "require 2 from team=" + paymentsTeam,
+	})
+	var draft struct {
+		Evaluation struct {
+			Error string `json:"error"`
+		} `json:"evaluation"`
+	}
+	json.NewDecoder(resp.Body).Decode(&draft)
+	if resp.StatusCode != http.StatusOK || draft.Evaluation.Error == "" {
+		t.Fatalf("expected an unsatisfiable draft, got %d %+v", resp.StatusCode, draft.Evaluation)
+	}
+}
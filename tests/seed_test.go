@@ -0,0 +1,76 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestAssignmentSeedReplay(t *testing.T) {
+	if os.Getenv("SKIP_E2E") == "1" {
+		t.Skip("SKIP_E2E=1")
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	teamName := "seed-" + uuid.NewString()
+	author := uuid.NewString()
+	members := []map[string]interface{}{{"user_id": author, "username": "Alice", "is_active": true}}
+	for range 6 {
+		members = append(members, map[string]interface{}{"user_id": uuid.NewString(), "username": "Other", "is_active": true})
+	}
+	resp := post(t, client, "/team/add", map[string]interface{}{"team_name": teamName, "members": members})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+
+	type createResponse struct {
+		PR struct {
+			AssignedReviewers []string `json:"assigned_reviewers"`
+			AssignmentSeed    *int64   `json:"assignment_seed"`
+		} `json:"pr"`
+	}
+	// A seed from the client would let it pick the reviewers, so creation
+	// draws its own.
+	resp = post(t, client, "/pullRequest/create", map[string]interface{}{
+		"pull_request_id":   uuid.NewString(),
+		"pull_request_name": "feat: seeded",
+		"author_id":         author,
+		"seed":              42,
+	})
+	var created createResponse
+	json.NewDecoder(resp.Body).Decode(&created)
+	if resp.StatusCode != http.StatusCreated || created.PR.AssignmentSeed == nil {
+		t.Fatalf("expected 201 with a seed, got %d %+v", resp.StatusCode, created.PR)
+	}
+	if *created.PR.AssignmentSeed == 42 {
+		t.Errorf("expected the client's seed to be ignored")
+	}
+
+	// Nothing changed in the team, so the same seed picks the same reviewers.
+	resp = post(t, client, "/rules/evaluate", map[string]interface{}{
+		"author_id": author,
+		"seed":      *created.PR.AssignmentSeed,
+	})
+	var evaluated struct {
+		Evaluation struct {
+			Seed      int64    `json:"seed"`
+			Reviewers []string `json:"reviewers"`
+		} `json:"evaluation"`
+	}
+	json.NewDecoder(resp.Body).Decode(&evaluated)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	if evaluated.Evaluation.Seed != *created.PR.AssignmentSeed {
+		t.Errorf("expected seed %d, got %d", *created.PR.AssignmentSeed, evaluated.Evaluation.Seed)
+	}
+	if !reflect.DeepEqual(evaluated.Evaluation.Reviewers, created.PR.AssignedReviewers) {
+		t.Errorf("replay picked %v, created %v", evaluated.Evaluation.Reviewers, created.PR.AssignedReviewers)
+	}
+}
@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestSLA(t *testing.T) {
+	if os.Getenv("SKIP_E2E") == "1" {
+		t.Skip("SKIP_E2E=1")
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	teamName := "sla-" + uuid.NewString()
+	author, reviewer := uuid.NewString(), uuid.NewString()
+	resp := post(t, client, "/team/add", map[string]interface{}{
+		"team_name": teamName,
+		"members": []map[string]interface{}{
+			{"user_id": author, "username": "Alice", "is_active": true},
+			{"user_id": reviewer, "username": "Bob", "is_active": true},
+		},
+	})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+
+	resp = get(t, client, "/team/getSla?team_name="+teamName)
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404 before SLA is set, got %d", resp.StatusCode)
+	}
+
+	resp = post(t, client, "/team/setSla", map[string]interface{}{
+		"team_name":            teamName,
+		"first_response_hours": 0,
+		"auto_reassign":        false,
+	})
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 for zero hours, got %d", resp.StatusCode)
+	}
+
+	resp = post(t, client, "/team/setSla", map[string]interface{}{
+		"team_name":            teamName,
+		"first_response_hours": 24,
+		"auto_reassign":        true,
+	})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	resp = get(t, client, "/team/getSla?team_name="+teamName)
+	var sla struct {
+		Sla struct {
+			FirstResponseHours int  `json:"first_response_hours"`
+			AutoReassign       bool `json:"auto_reassign"`
+		} `json:"sla"`
+	}
+	json.NewDecoder(resp.Body).Decode(&sla)
+	if sla.Sla.FirstResponseHours != 24 || !sla.Sla.AutoReassign {
+		t.Fatalf("unexpected SLA: %+v", sla.Sla)
+	}
+
+	prID := uuid.NewString()
+	resp = post(t, client, "/pullRequest/create", map[string]string{
+		"pull_request_id":   prID,
+		"pull_request_name": "feat: sla",
+		"author_id":         author,
+	})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+
+	resp = post(t, client, "/pullRequest/respond", map[string]string{"pull_request_id": prID, "reviewer_id": author})
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("expected 409 for non-reviewer, got %d", resp.StatusCode)
+	}
+	resp = post(t, client, "/pullRequest/respond", map[string]string{"pull_request_id": prID, "reviewer_id": reviewer})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	// A fresh assignment is well within 24 hours.
+	resp = get(t, client, "/sla/breaches?team_name="+teamName)
+	var breaches struct {
+		Breaches []json.RawMessage `json:"breaches"`
+	}
+	json.NewDecoder(resp.Body).Decode(&breaches)
+	if resp.StatusCode != http.StatusOK || len(breaches.Breaches) != 0 {
+		t.Fatalf("expected no breaches, got %d %v", resp.StatusCode, breaches.Breaches)
+	}
+}
@@ -0,0 +1,88 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestStaleReassignment(t *testing.T) {
+	if os.Getenv("SKIP_E2E") == "1" {
+		t.Skip("SKIP_E2E=1")
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	teamName := "stale-" + uuid.NewString()
+	author := uuid.NewString()
+	resp := post(t, client, "/team/add", map[string]interface{}{
+		"team_name": teamName,
+		"members": []map[string]interface{}{
+			{"user_id": author, "username": "Alice", "is_active": true},
+			{"user_id": uuid.NewString(), "username": "Bob", "is_active": true},
+			{"user_id": uuid.NewString(), "username": "Carol", "is_active": true},
+			{"user_id": uuid.NewString(), "username": "Dave", "is_active": true},
+		},
+	})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+
+	resp = post(t, client, "/team/setStalePolicy", map[string]interface{}{
+		"team_name":         teamName,
+		"stale_after_hours": 48,
+		"max_reassignments": 2,
+		"dry_run":           true,
+	})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	prID := uuid.NewString()
+	resp = post(t, client, "/pullRequest/create", map[string]string{
+		"pull_request_id":   prID,
+		"pull_request_name": "feat: stale",
+		"author_id":         author,
+	})
+	var created struct {
+		PR struct {
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+	}
+	json.NewDecoder(resp.Body).Decode(&created)
+
+	// Nothing is stale yet.
+	resp = post(t, client, "/pullRequest/reassignStale", map[string]interface{}{"team_name": teamName, "dry_run": true})
+	var run struct {
+		Actions []json.RawMessage `json:"actions"`
+	}
+	json.NewDecoder(resp.Body).Decode(&run)
+	if resp.StatusCode != http.StatusOK || len(run.Actions) != 0 {
+		t.Fatalf("expected no actions, got %d %v", resp.StatusCode, run.Actions)
+	}
+
+	resp = post(t, client, "/pullRequest/reassign", map[string]string{
+		"pull_request_id": prID,
+		"old_reviewer_id": created.PR.AssignedReviewers[0],
+	})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	resp = get(t, client, "/pullRequest/reassignments?pull_request_id="+prID)
+	var history struct {
+		Reassignments []struct {
+			OldReviewerID string `json:"old_reviewer_id"`
+			Reason        string `json:"reason"`
+		} `json:"reassignments"`
+	}
+	json.NewDecoder(resp.Body).Decode(&history)
+	if len(history.Reassignments) != 1 || history.Reassignments[0].Reason != "manual" ||
+		history.Reassignments[0].OldReviewerID != created.PR.AssignedReviewers[0] {
+		t.Fatalf("unexpected history: %+v", history.Reassignments)
+	}
+}
@@ -0,0 +1,93 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestTags(t *testing.T) {
+	if os.Getenv("SKIP_E2E") == "1" {
+		t.Skip("SKIP_E2E=1")
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	teamName := "tags-" + uuid.NewString()
+	author, expert := uuid.NewString(), uuid.NewString()
+	resp := post(t, client, "/team/add", map[string]interface{}{
+		"team_name": teamName,
+		"members": []map[string]interface{}{
+			{"user_id": author, "username": "Alice", "is_active": true},
+			{"user_id": expert, "username": "Bob", "is_active": true},
+		},
+	})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+
+	type userResponse struct {
+		User struct {
+			Tags []string `json:"tags"`
+		} `json:"user"`
+	}
+	update := func(path string, tags ...string) []string {
+		t.Helper()
+		resp := post(t, client, path, map[string]interface{}{"user_id": expert, "tags": tags})
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("%s: expected 200, got %d", path, resp.StatusCode)
+		}
+		var u userResponse
+		json.NewDecoder(resp.Body).Decode(&u)
+		return u.User.Tags
+	}
+
+	if got := update("/users/setTags", "Postgres", "payments", "postgres"); !reflect.DeepEqual(got, []string{"payments", "postgres"}) {
+		t.Fatalf("setTags: got %v", got)
+	}
+	if got := update("/users/addTags", "frontend"); !reflect.DeepEqual(got, []string{"frontend", "payments", "postgres"}) {
+		t.Fatalf("addTags: got %v", got)
+	}
+	if got := update("/users/removeTags", "payments"); !reflect.DeepEqual(got, []string{"frontend", "postgres"}) {
+		t.Fatalf("removeTags: got %v", got)
+	}
+
+	resp = post(t, client, "/users/addTags", map[string]interface{}{"user_id": expert, "tags": []string{"no spaces"}})
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 for invalid tag, got %d", resp.StatusCode)
+	}
+
+	resp = get(t, client, "/tags/list?team_name="+teamName)
+	var list struct {
+		Tags []struct {
+			Tag   string `json:"tag"`
+			Users int    `json:"users"`
+		} `json:"tags"`
+	}
+	json.NewDecoder(resp.Body).Decode(&list)
+	if resp.StatusCode != http.StatusOK || len(list.Tags) != 2 || list.Tags[0].Tag != "frontend" || list.Tags[0].Users != 1 {
+		t.Fatalf("unexpected tag list: %d %+v", resp.StatusCode, list.Tags)
+	}
+
+	resp = post(t, client, "/pullRequest/create", map[string]interface{}{
+		"pull_request_id":   uuid.NewString(),
+		"pull_request_name": "feat: index",
+		"author_id":         author,
+		"labels":            []string{"Postgres"},
+	})
+	var created struct {
+		PR struct {
+			Labels            []string `json:"labels"`
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+	}
+	json.NewDecoder(resp.Body).Decode(&created)
+	if resp.StatusCode != http.StatusCreated || !reflect.DeepEqual(created.PR.Labels, []string{"postgres"}) {
+		t.Fatalf("unexpected PR: %d %+v", resp.StatusCode, created.PR)
+	}
+}
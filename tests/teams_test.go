@@ -0,0 +1,408 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestTeamManagement(t *testing.T) {
+	if os.Getenv("SKIP_E2E") == "1" {
+		t.Skip("SKIP_E2E=1")
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	teamName := "teams-" + uuid.NewString()
+	author, reviewer, other := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	resp := post(t, client, "/team/add", map[string]interface{}{
+		"team_name": teamName,
+		"members": []map[string]interface{}{
+			{"user_id": author, "username": "Alice", "is_active": true},
+			{"user_id": reviewer, "username": "Bob", "is_active": true},
+		},
+	})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+	prID := uuid.NewString()
+	resp = post(t, client, "/pullRequest/create", map[string]interface{}{
+		"pull_request_id":   prID,
+		"pull_request_name": "feat: teams",
+		"author_id":         author,
+	})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+
+	otherTeam := "teams-" + uuid.NewString()
+	resp = post(t, client, "/team/add", map[string]interface{}{
+		"team_name": otherTeam,
+		"members":   []map[string]interface{}{{"user_id": uuid.NewString(), "username": "Dave", "is_active": true}},
+	})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+
+	// Bob reviews Alice's pull request, so he cannot silently leave the team.
+	resp = post(t, client, "/team/removeMember", map[string]interface{}{"team_name": teamName, "user_id": reviewer})
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("expected 409 removing a reviewer, got %d", resp.StatusCode)
+	}
+
+	// Nobody else can take the review, so it is dropped.
+	resp = post(t, client, "/team/removeMember", map[string]interface{}{
+		"team_name": teamName, "user_id": reviewer, "open_reviews": "unassign",
+	})
+	var team struct {
+		Team struct {
+			TeamName string `json:"team_name"`
+			Members  []struct {
+				UserID string `json:"user_id"`
+			} `json:"members"`
+		} `json:"team"`
+	}
+	json.NewDecoder(resp.Body).Decode(&team)
+	if resp.StatusCode != http.StatusOK || len(team.Team.Members) != 1 {
+		t.Fatalf("expected 200 with one member, got %d %+v", resp.StatusCode, team.Team)
+	}
+	resp = get(t, client, "/users/getReview?user_id="+reviewer)
+	var reviews struct {
+		PullRequests []struct{} `json:"pull_requests"`
+	}
+	json.NewDecoder(resp.Body).Decode(&reviews)
+	if len(reviews.PullRequests) != 0 {
+		t.Errorf("expected no reviews left, got %d", len(reviews.PullRequests))
+	}
+
+	resp = post(t, client, "/team/addMember", map[string]interface{}{
+		"team_name": teamName,
+		"member":    map[string]interface{}{"user_id": other, "username": "Carol", "is_active": true},
+	})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	renamed := "teams-" + uuid.NewString()
+	resp = send(t, client, http.MethodPatch, "/team", map[string]interface{}{"team_name": teamName, "new_team_name": otherTeam})
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("expected 409 renaming to a taken name, got %d", resp.StatusCode)
+	}
+	resp = send(t, client, http.MethodPatch, "/team", map[string]interface{}{"team_name": teamName, "new_team_name": renamed})
+	json.NewDecoder(resp.Body).Decode(&team)
+	if resp.StatusCode != http.StatusOK || team.Team.TeamName != renamed || len(team.Team.Members) != 2 {
+		t.Fatalf("expected the renamed team with two members, got %d %+v", resp.StatusCode, team.Team)
+	}
+	resp = get(t, client, "/team/get?team_name="+url.QueryEscape(teamName))
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected the old name to be gone, got %d", resp.StatusCode)
+	}
+
+	// Alice's pull request is still open.
+	resp = send(t, client, http.MethodDelete, "/team?team_name="+url.QueryEscape(renamed), nil)
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("expected 409 deleting a team with open pull requests, got %d", resp.StatusCode)
+	}
+	resp = send(t, client, http.MethodDelete, "/team?open_prs=unassign&team_name="+url.QueryEscape(renamed), nil)
+	var deleted struct {
+		Deletion struct {
+			Members []string `json:"members"`
+		} `json:"deletion"`
+	}
+	json.NewDecoder(resp.Body).Decode(&deleted)
+	if resp.StatusCode != http.StatusOK || len(deleted.Deletion.Members) != 2 {
+		t.Fatalf("expected 200 with two former members, got %d %+v", resp.StatusCode, deleted.Deletion)
+	}
+	resp = get(t, client, "/users/getReview?user_id="+author)
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected former members to remain, got %d", resp.StatusCode)
+	}
+}
+
+func TestMultipleTeams(t *testing.T) {
+	if os.Getenv("SKIP_E2E") == "1" {
+		t.Skip("SKIP_E2E=1")
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	backend, mobile := "teams-"+uuid.NewString(), "teams-"+uuid.NewString()
+	author, backender, mobiler := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	for team, members := range map[string][]map[string]interface{}{
+		backend: {
+			{"user_id": author, "username": "Alice", "is_active": true},
+			{"user_id": backender, "username": "Bob", "is_active": true},
+		},
+		mobile: {{"user_id": mobiler, "username": "Mallory", "is_active": true}},
+	} {
+		resp := post(t, client, "/team/add", map[string]interface{}{"team_name": team, "members": members})
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("expected 201, got %d", resp.StatusCode)
+		}
+	}
+	resp := post(t, client, "/team/addMember", map[string]interface{}{
+		"team_name": mobile,
+		"member":    map[string]interface{}{"user_id": author, "username": "Alice", "is_active": true},
+	})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	var user struct {
+		User struct {
+			TeamName string   `json:"team_name"`
+			Teams    []string `json:"teams"`
+		} `json:"user"`
+	}
+	resp = post(t, client, "/users/setIsActive", map[string]interface{}{"user_id": author, "is_active": true})
+	json.NewDecoder(resp.Body).Decode(&user)
+	if user.User.TeamName != backend || len(user.User.Teams) != 2 || user.User.Teams[1] != mobile {
+		t.Fatalf("expected backend as primary and both teams, got %+v", user.User)
+	}
+
+	var created struct {
+		PR struct {
+			TeamName          string   `json:"team_name"`
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+	}
+	for team, reviewer := range map[string]string{"": backender, mobile: mobiler} {
+		resp = post(t, client, "/pullRequest/create", map[string]interface{}{
+			"pull_request_id":   uuid.NewString(),
+			"pull_request_name": "feat: teams",
+			"author_id":         author,
+			"team_name":         team,
+		})
+		json.NewDecoder(resp.Body).Decode(&created)
+		if resp.StatusCode != http.StatusCreated || len(created.PR.AssignedReviewers) != 1 || created.PR.AssignedReviewers[0] != reviewer {
+			t.Errorf("team %q: expected %s to review, got %d %+v", team, reviewer, resp.StatusCode, created.PR)
+		}
+	}
+
+	resp = post(t, client, "/team/addMember", map[string]interface{}{
+		"team_name": mobile,
+		"member":    map[string]interface{}{"user_id": author, "username": "Alice", "is_active": true, "is_primary": true},
+	})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	resp = post(t, client, "/users/setIsActive", map[string]interface{}{"user_id": author, "is_active": true})
+	json.NewDecoder(resp.Body).Decode(&user)
+	if user.User.TeamName != mobile {
+		t.Errorf("expected mobile to become primary, got %+v", user.User)
+	}
+}
+
+func TestTeamHierarchy(t *testing.T) {
+	if os.Getenv("SKIP_E2E") == "1" {
+		t.Skip("SKIP_E2E=1")
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	dept, payments, search := "dept-"+uuid.NewString(), "teams-"+uuid.NewString(), "teams-"+uuid.NewString()
+	author, teammate, neighbour := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	resp := post(t, client, "/team/add", map[string]interface{}{"team_name": dept, "members": []map[string]interface{}{}})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+	for team, members := range map[string][]map[string]interface{}{
+		payments: {
+			{"user_id": author, "username": "Alice", "is_active": true},
+			{"user_id": teammate, "username": "Bob", "is_active": true},
+		},
+		search: {{"user_id": neighbour, "username": "Carol", "is_active": true}},
+	} {
+		resp = post(t, client, "/team/add", map[string]interface{}{"team_name": team, "parent_team_name": dept, "members": members})
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("expected 201, got %d", resp.StatusCode)
+		}
+	}
+	resp = post(t, client, "/team/setParent", map[string]interface{}{"team_name": dept, "parent_team_name": payments})
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("expected 409 for a cycle, got %d", resp.StatusCode)
+	}
+
+	// The department's SLA applies to its sub-teams.
+	resp = post(t, client, "/team/setSla", map[string]interface{}{"team_name": dept, "first_response_hours": 8, "auto_reassign": false})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	var sla struct {
+		SLA struct {
+			FirstResponseHours int    `json:"first_response_hours"`
+			InheritedFrom      string `json:"inherited_from"`
+		} `json:"sla"`
+	}
+	resp = get(t, client, "/team/getSla?team_name="+url.QueryEscape(payments))
+	json.NewDecoder(resp.Body).Decode(&sla)
+	if resp.StatusCode != http.StatusOK || sla.SLA.FirstResponseHours != 8 || sla.SLA.InheritedFrom != dept {
+		t.Fatalf("expected the department's SLA, got %d %+v", resp.StatusCode, sla.SLA)
+	}
+
+	// Payments has one candidate besides the author and borrows the second.
+	var created struct {
+		PR struct {
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+	}
+	resp = post(t, client, "/pullRequest/create", map[string]interface{}{
+		"pull_request_id":   uuid.NewString(),
+		"pull_request_name": "feat: departments",
+		"author_id":         author,
+	})
+	json.NewDecoder(resp.Body).Decode(&created)
+	if resp.StatusCode != http.StatusCreated || len(created.PR.AssignedReviewers) != 2 {
+		t.Fatalf("expected two reviewers, got %d %+v", resp.StatusCode, created.PR)
+	}
+
+	var tree struct {
+		Tree struct {
+			TotalMembers int `json:"total_members"`
+			TotalOpenPRs int `json:"total_open_prs"`
+			SubTeams     []struct {
+				TeamName string `json:"team_name"`
+				OpenPRs  int    `json:"open_prs"`
+			} `json:"sub_teams"`
+		} `json:"tree"`
+	}
+	resp = get(t, client, "/team/tree?team_name="+url.QueryEscape(dept))
+	json.NewDecoder(resp.Body).Decode(&tree)
+	if resp.StatusCode != http.StatusOK || tree.Tree.TotalMembers != 3 || tree.Tree.TotalOpenPRs != 1 || len(tree.Tree.SubTeams) != 2 {
+		t.Fatalf("expected 3 members and 1 open PR in 2 sub-teams, got %d %+v", resp.StatusCode, tree.Tree)
+	}
+
+	var stats struct {
+		Stats map[string]int64 `json:"stats"`
+	}
+	resp = get(t, client, "/stats/reviewers?team_name="+url.QueryEscape(dept))
+	json.NewDecoder(resp.Body).Decode(&stats)
+	if resp.StatusCode != http.StatusOK || stats.Stats[teammate] != 1 || stats.Stats[neighbour] != 1 || len(stats.Stats) != 2 {
+		t.Errorf("expected one review each for the department, got %d %v", resp.StatusCode, stats.Stats)
+	}
+}
+
+func TestTeamRoles(t *testing.T) {
+	if os.Getenv("SKIP_E2E") == "1" {
+		t.Skip("SKIP_E2E=1")
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	team := "teams-" + uuid.NewString()
+	author, lead, alice, bob := uuid.NewString(), uuid.NewString(), uuid.NewString(), uuid.NewString()
+	newTeam := map[string]interface{}{
+		"team_name":       team,
+		"lead_assignment": "excluded",
+		"members": []map[string]interface{}{
+			{"user_id": author, "username": "Author", "is_active": true},
+			{"user_id": lead, "username": "Lead", "is_active": true, "role": "lead"},
+			{"user_id": alice, "username": "Alice", "is_active": true},
+			{"user_id": bob, "username": "Bob", "is_active": true},
+		},
+	}
+	// The first lead of a top-level team needs the admin token.
+	resp := postWithHeaders(t, client, "/team/add", newTeam, asActor(lead))
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected 403, got %d", resp.StatusCode)
+	}
+	resp = postWithHeaders(t, client, "/team/add", newTeam, asAdmin())
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+	resp = post(t, client, "/team/addMember", map[string]interface{}{
+		"team_name": team,
+		"member":    map[string]interface{}{"user_id": alice, "username": "Alice", "is_active": true, "role": "lead"},
+	})
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected 403 for an anonymous role change, got %d", resp.StatusCode)
+	}
+
+	// The lead is never picked, so Alice and Bob review.
+	var created struct {
+		PR struct {
+			ID                string   `json:"pull_request_id"`
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+	}
+	resp = post(t, client, "/pullRequest/create", map[string]interface{}{
+		"pull_request_id":   uuid.NewString(),
+		"pull_request_name": "feat: roles",
+		"author_id":         author,
+	})
+	json.NewDecoder(resp.Body).Decode(&created)
+	if resp.StatusCode != http.StatusCreated || len(created.PR.AssignedReviewers) != 2 {
+		t.Fatalf("expected two reviewers, got %d %+v", resp.StatusCode, created.PR)
+	}
+	for _, r := range created.PR.AssignedReviewers {
+		if r == lead {
+			t.Fatalf("the lead was assigned: %v", created.PR.AssignedReviewers)
+		}
+	}
+
+	// Members may not deactivate each other or hand out roles; the lead may.
+	resp = postWithHeaders(t, client, "/users/setIsActive", map[string]interface{}{"user_id": bob, "is_active": false}, asActor(alice))
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected 403, got %d", resp.StatusCode)
+	}
+	resp = postWithHeaders(t, client, "/team/setMemberRole", map[string]interface{}{"team_name": team, "user_id": alice, "role": "maintainer"}, asActor(bob))
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected 403, got %d", resp.StatusCode)
+	}
+	// Without X-Actor-Id only the admin token is trusted.
+	resp = post(t, client, "/team/setMemberRole", map[string]interface{}{"team_name": team, "user_id": alice, "role": "maintainer"})
+	if resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected 403 without an actor, got %d", resp.StatusCode)
+	}
+	resp = postWithHeaders(t, client, "/team/setMemberRole", map[string]interface{}{"team_name": team, "user_id": alice, "role": "member"}, asAdmin())
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 with the admin token, got %d", resp.StatusCode)
+	}
+	resp = postWithHeaders(t, client, "/team/setMemberRole", map[string]interface{}{"team_name": team, "user_id": alice, "role": "maintainer"}, asActor(lead))
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	// As a maintainer Alice may reassign Bob's review, which falls to the
+	// lead as the only one left once leads are a last resort.
+	resp = postWithHeaders(t, client, "/team/setLeadAssignment", map[string]interface{}{"team_name": team, "lead_assignment": "last_resort"}, asActor(lead))
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	var reassigned struct {
+		ReplacedBy string `json:"replaced_by"`
+	}
+	resp = postWithHeaders(t, client, "/pullRequest/reassign", map[string]interface{}{"pull_request_id": created.PR.ID, "old_reviewer_id": bob}, asActor(alice))
+	json.NewDecoder(resp.Body).Decode(&reassigned)
+	if resp.StatusCode != http.StatusOK || reassigned.ReplacedBy != lead {
+		t.Fatalf("expected the lead to replace Bob, got %d %q", resp.StatusCode, reassigned.ReplacedBy)
+	}
+	resp = postWithHeaders(t, client, "/users/setIsActive", map[string]interface{}{"user_id": bob, "is_active": false}, asActor(lead))
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	var got struct {
+		Team struct {
+			LeadAssignment string `json:"lead_assignment"`
+			Members        []struct {
+				UserID string `json:"user_id"`
+				Role   string `json:"role"`
+			} `json:"members"`
+		} `json:"team"`
+	}
+	resp = get(t, client, "/team/get?team_name="+url.QueryEscape(team))
+	json.NewDecoder(resp.Body).Decode(&got)
+	roles := make(map[string]string)
+	for _, m := range got.Team.Members {
+		roles[m.UserID] = m.Role
+	}
+	if got.Team.LeadAssignment != "last_resort" || roles[lead] != "lead" || roles[alice] != "maintainer" || roles[bob] != "member" {
+		t.Errorf("unexpected team %+v", got.Team)
+	}
+}
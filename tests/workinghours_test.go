@@ -0,0 +1,85 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestWorkingHours(t *testing.T) {
+	if os.Getenv("SKIP_E2E") == "1" {
+		t.Skip("SKIP_E2E=1")
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	teamName := "hours-" + uuid.NewString()
+	userID := uuid.NewString()
+	resp := post(t, client, "/team/add", map[string]interface{}{
+		"team_name": teamName,
+		"members": []map[string]interface{}{
+			{"user_id": userID, "username": "Alice", "is_active": true},
+		},
+	})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", resp.StatusCode)
+	}
+
+	// Teams start on the server default.
+	resp = get(t, client, "/team/getWorkingHours?team_name="+teamName)
+	var team struct {
+		WorkingHours *struct {
+			Timezone string   `json:"timezone"`
+			Days     []string `json:"days"`
+		} `json:"working_hours"`
+	}
+	json.NewDecoder(resp.Body).Decode(&team)
+	if resp.StatusCode != http.StatusOK || team.WorkingHours != nil {
+		t.Fatalf("expected no team working hours, got %d %+v", resp.StatusCode, team.WorkingHours)
+	}
+
+	hours := map[string]interface{}{
+		"timezone": "Europe/Moscow",
+		"start":    "10:00",
+		"end":      "19:00",
+		"days":     []string{"mon", "tue", "wed", "thu", "fri"},
+	}
+	resp = post(t, client, "/team/setWorkingHours", map[string]interface{}{"team_name": teamName, "working_hours": hours})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	resp = get(t, client, "/team/getWorkingHours?team_name="+teamName)
+	json.NewDecoder(resp.Body).Decode(&team)
+	if team.WorkingHours == nil || team.WorkingHours.Timezone != "Europe/Moscow" || len(team.WorkingHours.Days) != 5 {
+		t.Fatalf("unexpected team working hours %+v", team.WorkingHours)
+	}
+
+	hours["timezone"] = "Mars/Olympus_Mons"
+	resp = post(t, client, "/users/setWorkingHours", map[string]interface{}{"user_id": userID, "working_hours": hours})
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 for unknown time zone, got %d", resp.StatusCode)
+	}
+
+	hours["timezone"] = "Asia/Tokyo"
+	resp = post(t, client, "/users/setWorkingHours", map[string]interface{}{"user_id": userID, "working_hours": hours})
+	var user struct {
+		User struct {
+			WorkingHours *struct {
+				Timezone string `json:"timezone"`
+			} `json:"working_hours"`
+		} `json:"user"`
+	}
+	json.NewDecoder(resp.Body).Decode(&user)
+	if resp.StatusCode != http.StatusOK || user.User.WorkingHours == nil || user.User.WorkingHours.Timezone != "Asia/Tokyo" {
+		t.Fatalf("unexpected user working hours: %d %+v", resp.StatusCode, user.User.WorkingHours)
+	}
+
+	resp = post(t, client, "/users/setWorkingHours", map[string]interface{}{"user_id": uuid.NewString(), "working_hours": hours})
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", resp.StatusCode)
+	}
+}